@@ -0,0 +1,366 @@
+// Command catalogctl runs administrative tasks against the product catalog database.
+//
+// Usage:
+//
+//	catalogctl <command> [flags]
+//
+// Commands:
+//
+//	replay-views   Rebuild the product_views read model from the products table
+//	purge          Permanently delete products archived past the purge retention
+//	import         Create or update products from a CSV or NDJSON file
+//	export         Write the catalog, read at a single timestamp, as CSV or NDJSON
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/queries/export_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/import_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/purge_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/restore_product"
+	"github.com/product-catalog-service/internal/services"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		log.Fatalf("Fatal error: %v", err)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		usage()
+		return fmt.Errorf("missing command")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	command, args := args[0], args[1:]
+
+	switch command {
+	case "replay-views":
+		return withContainer(ctx, services.Options{}, func(c *services.Container) error {
+			return replayViews(ctx, c, args)
+		})
+	case "purge":
+		return purge(ctx, args)
+	case "import":
+		return importProducts(ctx, args)
+	case "export":
+		return exportProducts(ctx, args)
+	case "help", "-h", "--help":
+		usage()
+		return nil
+	default:
+		usage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `Usage: catalogctl <command> [flags]
+
+Commands:
+  replay-views   Rebuild the product_views read model from the products table
+  purge          Permanently delete products archived past the purge retention
+                 (-dry-run lists them, -retention overrides PURGE_RETENTION
+                 and may not be shorter than RESTORE_RETENTION)
+  import FILE    Create or update products from a CSV or NDJSON file, or - for
+                 stdin (-format csv|ndjson, guessed from the file extension
+                 otherwise; -validate-only checks the rows without writing)
+  export         Write every product to stdout, or -o FILE, all read at one
+                 timestamp (-format csv|ndjson; -category, -status,
+                 -active-only, -archived-mode, -min-price and -max-price
+                 filter as ListProducts does; -parallelism sets how many
+                 partitions are read at once)
+
+Spanner is selected with SPANNER_PROJECT, SPANNER_INSTANCE, SPANNER_DATABASE
+and SPANNER_EMULATOR_HOST, as for the server.`)
+}
+
+func replayViews(ctx context.Context, c *services.Container, args []string) error {
+	fs := flag.NewFlagSet("replay-views", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	log.Println("Replaying product_views from products...")
+	count, err := c.ProductViewProjection.Replay(ctx)
+	if err != nil {
+		return fmt.Errorf("replay failed after %d products: %w", count, err)
+	}
+
+	log.Printf("Replayed %d products", count)
+	return nil
+}
+
+func purge(ctx context.Context, args []string) error {
+	defaultRetention := purge_product.DefaultRetention
+	if value := getEnv("PURGE_RETENTION", ""); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("PURGE_RETENTION: %w", err)
+		}
+		defaultRetention = parsed
+	}
+
+	restoreRetention := restore_product.DefaultRetention
+	if value := getEnv("RESTORE_RETENTION", ""); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("RESTORE_RETENTION: %w", err)
+		}
+		restoreRetention = parsed
+	}
+
+	fs := flag.NewFlagSet("purge", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "list the products that would be purged without deleting them")
+	retention := fs.Duration("retention", defaultRetention, "minimum time since archiving")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *retention <= 0 {
+		return fmt.Errorf("retention must be positive, got %s", *retention)
+	}
+	// Purging inside the restore window would break the restore promise, as
+	// the server refuses too
+	if *retention < restoreRetention {
+		return fmt.Errorf("retention %s is shorter than RESTORE_RETENTION %s", *retention, restoreRetention)
+	}
+
+	opts := services.Options{
+		PurgeRetention:   *retention,
+		RestoreRetention: restoreRetention,
+	}
+	return withContainer(ctx, opts, func(c *services.Container) error {
+		log.Printf("Purging products archived more than %s ago (dry run: %t)...", *retention, *dryRun)
+		report, err := c.Purger.PurgeDue(ctx, *dryRun)
+		if report != nil {
+			for _, candidate := range report.Candidates {
+				outcome := "purged"
+				switch {
+				case report.DryRun:
+					outcome = "due"
+				case candidate.Skipped:
+					outcome = "skipped"
+				}
+				fmt.Printf("%s\t%s\t%s\t%s\t%q\n",
+					candidate.ProductID,
+					outcome,
+					candidate.ArchivedAt.Format(time.RFC3339),
+					candidate.Category,
+					candidate.Name,
+				)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("purge failed: %w", err)
+		}
+
+		if report.DryRun {
+			log.Printf("Dry run: %d products would be purged", len(report.Candidates))
+		} else {
+			log.Printf("%d products due: purged %d, skipped %d (restored or purged elsewhere)",
+				len(report.Candidates), report.Purged, report.Skipped)
+		}
+		return nil
+	})
+}
+
+func importProducts(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := fs.String("format", "", "csv or ndjson (default: from the file extension)")
+	validateOnly := fs.Bool("validate-only", false, "check every row without writing anything")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("import takes exactly one file, or - for stdin")
+	}
+	path := fs.Arg(0)
+
+	if *format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			*format = string(import_products.FormatCSV)
+		case ".ndjson", ".jsonl":
+			*format = string(import_products.FormatNDJSON)
+		default:
+			return fmt.Errorf("cannot guess the format of %q, set -format", path)
+		}
+	}
+
+	source := os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		source = file
+	}
+
+	return withContainer(ctx, services.Options{}, func(c *services.Container) error {
+		log.Printf("Importing %s as %s (validate only: %t)...", path, *format, *validateOnly)
+		report, err := c.ImportProductsUsecase.Execute(ctx, import_products.Request{
+			Source:       source,
+			Format:       import_products.Format(*format),
+			ValidateOnly: *validateOnly,
+		})
+		if report != nil {
+			for _, row := range report.Rows {
+				outcome := string(row.Action)
+				if row.Err != nil {
+					outcome = "error: " + row.Err.Error()
+				}
+				fmt.Printf("%d\t%s\t%s\t%s\n", row.Line, row.ExternalKey, row.ProductID, outcome)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("import failed: %w", err)
+		}
+
+		log.Printf("Created %d, updated %d, unchanged %d, failed %d",
+			report.Created, report.Updated, report.Unchanged, report.Failed)
+		if report.Truncated {
+			log.Printf("Only %d rows listed", len(report.Rows))
+		}
+		if report.Failed > 0 {
+			return fmt.Errorf("%d rows failed", report.Failed)
+		}
+		return nil
+	})
+}
+
+func exportProducts(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	output := fs.String("o", "-", "file to write, or - for stdout")
+	format := fs.String("format", "", "csv or ndjson (default: from the -o extension, else csv)")
+	category := fs.String("category", "", "only products in this category")
+	status := fs.String("status", "", "only products with this status")
+	activeOnly := fs.Bool("active-only", false, "only active products")
+	archivedMode := fs.String("archived-mode", "", "exclude, include or only (default: exclude)")
+	minPrice := fs.String("min-price", "", "minimum base price, e.g. 9.99")
+	maxPrice := fs.String("max-price", "", "maximum base price, e.g. 99")
+	parallelism := fs.Int("parallelism", export_products.DefaultParallelism, "partitions read at once")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("export takes no arguments, use -o to write to a file")
+	}
+
+	if *format == "" {
+		*format = string(export_products.FormatCSV)
+		if strings.EqualFold(filepath.Ext(*output), ".ndjson") || strings.EqualFold(filepath.Ext(*output), ".jsonl") {
+			*format = string(export_products.FormatNDJSON)
+		}
+	}
+
+	req := export_products.Request{
+		ActiveOnly:   *activeOnly,
+		ArchivedMode: contracts.ArchivedMode(*archivedMode),
+		Parallelism:  *parallelism,
+	}
+	if *category != "" {
+		req.Category = category
+	}
+	if *status != "" {
+		req.Status = status
+	}
+	var err error
+	if req.MinBasePrice, err = parsePrice("min-price", *minPrice); err != nil {
+		return err
+	}
+	if req.MaxBasePrice, err = parsePrice("max-price", *maxPrice); err != nil {
+		return err
+	}
+
+	destination := os.Stdout
+	if *output != "-" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		destination = file
+	}
+
+	writer := bufio.NewWriter(destination)
+	encoder, err := export_products.NewEncoder(writer, export_products.Format(*format))
+	if err != nil {
+		return err
+	}
+
+	return withContainer(ctx, services.Options{}, func(c *services.Container) error {
+		log.Printf("Exporting products to %s as %s...", *output, *format)
+		result, err := c.ExportProductsQuery.Execute(ctx, req, func(batch *export_products.BatchDTO) error {
+			return encoder.Encode(batch.Products)
+		})
+		if err != nil {
+			return fmt.Errorf("export failed: %w", err)
+		}
+		if err := encoder.Flush(); err != nil {
+			return err
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		log.Printf("Exported %d products read at %s",
+			result.Products, result.ReadTimestamp.Format(time.RFC3339Nano))
+		return nil
+	})
+}
+
+// parsePrice parses an optional decimal price flag.
+func parsePrice(name, value string) (*big.Rat, error) {
+	if value == "" {
+		return nil, nil
+	}
+	price, ok := new(big.Rat).SetString(value)
+	if !ok || price.Sign() < 0 {
+		return nil, fmt.Errorf("-%s: invalid price %q", name, value)
+	}
+	return price, nil
+}
+
+func withContainer(ctx context.Context, opts services.Options, fn func(c *services.Container) error) error {
+	database := fmt.Sprintf(
+		"projects/%s/instances/%s/databases/%s",
+		getEnv("SPANNER_PROJECT", "test-project"),
+		getEnv("SPANNER_INSTANCE", "test-instance"),
+		getEnv("SPANNER_DATABASE", "product-catalog"),
+	)
+
+	client, err := spanner.NewClient(ctx, database)
+	if err != nil {
+		return fmt.Errorf("failed to create spanner client: %w", err)
+	}
+	defer client.Close()
+
+	return fn(services.NewContainerWithOptions(client, opts))
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -2,18 +2,30 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	_ "expvar" // registers /debug/vars on the metrics endpoint
 	"fmt"
 	"log"
+	"math/big"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
 	"github.com/product-catalog-service/internal/services"
+	grpcHandler "github.com/product-catalog-service/internal/transport/grpc/product"
 	pb "github.com/product-catalog-service/proto/product/v1"
 )
 
@@ -25,7 +37,10 @@ func main() {
 
 func run() error {
 	// Load configuration from environment
-	config := loadConfig()
+	config, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
 
 	// Initialize context with cancellation
 	ctx, cancel := context.WithCancel(context.Background())
@@ -39,10 +54,42 @@ func run() error {
 	defer spannerClient.Close()
 
 	// Initialize dependency injection container
-	container := services.NewContainer(spannerClient)
+	container := services.NewContainerWithOptions(spannerClient, services.Options{
+		ReadModelSource:  config.ReadModelSource,
+		RestoreRetention: config.RestoreRetention,
+		PurgeRetention:   config.PurgeRetention,
+		Lifecycle:        config.Lifecycle,
+		ActivationPolicy: config.ActivationPolicy,
+	})
+
+	// Start background workers
+	if config.ProjectorEnabled {
+		log.Printf("Starting outbox projector (interval %s)", config.ProjectorInterval)
+		go container.ProjectionDispatcher.Run(ctx, config.ProjectorInterval)
+	}
+	if config.SchedulerEnabled {
+		log.Printf("Starting product scheduler (interval %s)", config.SchedulerInterval)
+		go container.Scheduler.Run(ctx, config.SchedulerInterval)
+	}
+	if config.BulkUpdaterEnabled {
+		log.Printf("Starting bulk updater (interval %s)", config.BulkUpdaterInterval)
+		go container.BulkUpdater.Run(ctx, config.BulkUpdaterInterval)
+	}
+	if config.PurgeEnabled {
+		log.Printf("Starting archived product purger (interval %s, retention %s)", config.PurgeInterval, config.PurgeRetention)
+		go container.Purger.Run(ctx, config.PurgeInterval)
+	}
+
+	// Expose metrics
+	if config.MetricsAddress != "" {
+		go serveMetrics(config.MetricsAddress)
+	}
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcHandler.RequestIDInterceptor),
+		grpc.StreamInterceptor(grpcHandler.StreamRequestIDInterceptor),
+	)
 
 	// Register services
 	pb.RegisterProductServiceServer(grpcServer, container.ProductHandler)
@@ -77,23 +124,205 @@ func run() error {
 
 // Config holds the application configuration.
 type Config struct {
-	GRPCAddress     string
-	SpannerProject  string
-	SpannerInstance string
-	SpannerDatabase string
-	UseEmulator     bool
+	GRPCAddress         string
+	MetricsAddress      string
+	SpannerProject      string
+	SpannerInstance     string
+	SpannerDatabase     string
+	UseEmulator         bool
+	ReadModelSource     repo.ReadModelSource
+	ProjectorEnabled    bool
+	ProjectorInterval   time.Duration
+	SchedulerEnabled    bool
+	SchedulerInterval   time.Duration
+	BulkUpdaterEnabled  bool
+	BulkUpdaterInterval time.Duration
+	RestoreRetention    time.Duration
+	PurgeEnabled        bool
+	PurgeInterval       time.Duration
+	PurgeRetention      time.Duration
+	Lifecycle           *domain.Lifecycle
+	ActivationPolicy    *domain.ActivationPolicy
 }
 
-func loadConfig() Config {
+func loadConfig() (Config, error) {
 	config := Config{
 		GRPCAddress:     getEnv("GRPC_ADDRESS", ":50051"),
+		MetricsAddress:  getEnv("METRICS_ADDRESS", ""),
 		SpannerProject:  getEnv("SPANNER_PROJECT", "test-project"),
 		SpannerInstance: getEnv("SPANNER_INSTANCE", "test-instance"),
 		SpannerDatabase: getEnv("SPANNER_DATABASE", "product-catalog"),
 		UseEmulator:     getEnv("SPANNER_EMULATOR_HOST", "") != "",
+		ReadModelSource: repo.ReadModelSource(getEnv("READ_MODEL_SOURCE", string(repo.ReadModelSourceProducts))),
+	}
+
+	if !config.ReadModelSource.IsValid() {
+		return Config{}, fmt.Errorf("READ_MODEL_SOURCE: unknown source %q", config.ReadModelSource)
 	}
 
-	return config
+	var err error
+	if config.ProjectorEnabled, err = strconv.ParseBool(getEnv("PROJECTOR_ENABLED", "true")); err != nil {
+		return Config{}, fmt.Errorf("PROJECTOR_ENABLED: %w", err)
+	}
+	if config.ProjectorInterval, err = time.ParseDuration(getEnv("PROJECTOR_INTERVAL", "1s")); err != nil {
+		return Config{}, fmt.Errorf("PROJECTOR_INTERVAL: %w", err)
+	}
+	if config.SchedulerEnabled, err = strconv.ParseBool(getEnv("SCHEDULER_ENABLED", "true")); err != nil {
+		return Config{}, fmt.Errorf("SCHEDULER_ENABLED: %w", err)
+	}
+	if config.SchedulerInterval, err = time.ParseDuration(getEnv("SCHEDULER_INTERVAL", "10s")); err != nil {
+		return Config{}, fmt.Errorf("SCHEDULER_INTERVAL: %w", err)
+	}
+	if config.BulkUpdaterEnabled, err = strconv.ParseBool(getEnv("BULK_UPDATER_ENABLED", "true")); err != nil {
+		return Config{}, fmt.Errorf("BULK_UPDATER_ENABLED: %w", err)
+	}
+	if config.BulkUpdaterInterval, err = time.ParseDuration(getEnv("BULK_UPDATER_INTERVAL", "2s")); err != nil {
+		return Config{}, fmt.Errorf("BULK_UPDATER_INTERVAL: %w", err)
+	}
+	if config.RestoreRetention, err = time.ParseDuration(getEnv("RESTORE_RETENTION", "720h")); err != nil {
+		return Config{}, fmt.Errorf("RESTORE_RETENTION: %w", err)
+	}
+	if config.RestoreRetention <= 0 {
+		return Config{}, fmt.Errorf("RESTORE_RETENTION: must be positive, got %s", config.RestoreRetention)
+	}
+	if config.PurgeEnabled, err = strconv.ParseBool(getEnv("PURGE_ENABLED", "false")); err != nil {
+		return Config{}, fmt.Errorf("PURGE_ENABLED: %w", err)
+	}
+	if config.PurgeInterval, err = time.ParseDuration(getEnv("PURGE_INTERVAL", "1h")); err != nil {
+		return Config{}, fmt.Errorf("PURGE_INTERVAL: %w", err)
+	}
+	if config.PurgeRetention, err = time.ParseDuration(getEnv("PURGE_RETENTION", "2160h")); err != nil {
+		return Config{}, fmt.Errorf("PURGE_RETENTION: %w", err)
+	}
+	// Purging inside the restore window would break the restore promise
+	if config.PurgeRetention < config.RestoreRetention {
+		return Config{}, fmt.Errorf("PURGE_RETENTION: %s is shorter than RESTORE_RETENTION %s", config.PurgeRetention, config.RestoreRetention)
+	}
+
+	if config.Lifecycle, err = parseLifecycle(getEnv("LIFECYCLE_CONFIG", "")); err != nil {
+		return Config{}, fmt.Errorf("LIFECYCLE_CONFIG: %w", err)
+	}
+	if config.ActivationPolicy, err = parseActivationPolicy(getEnv("ACTIVATION_POLICY_CONFIG", "")); err != nil {
+		return Config{}, fmt.Errorf("ACTIVATION_POLICY_CONFIG: %w", err)
+	}
+
+	return config, nil
+}
+
+// parseLifecycle reads a lifecycle given as a JSON object that maps each
+// status to the statuses it may move to, e.g.
+// {"draft":["pending_review"],"pending_review":["active","draft"],...}.
+// An empty spec selects the default lifecycle.
+func parseLifecycle(spec string) (*domain.Lifecycle, error) {
+	if spec == "" {
+		return domain.DefaultLifecycle(), nil
+	}
+
+	var table map[string][]string
+	if err := json.Unmarshal([]byte(spec), &table); err != nil {
+		return nil, err
+	}
+
+	var transitions []domain.Transition
+	for from, targets := range table {
+		for _, to := range targets {
+			transitions = append(transitions, domain.Transition{
+				From: domain.ProductStatus(from),
+				To:   domain.ProductStatus(to),
+			})
+		}
+	}
+
+	return domain.NewLifecycle(transitions)
+}
+
+// activationRulesConfig is the JSON form of a set of activation rules.
+type activationRulesConfig struct {
+	RequireDescription   bool     `json:"require_description"`
+	MinDescriptionLength int      `json:"min_description_length"`
+	MinBasePrice         string   `json:"min_base_price"`
+	RequiredAttributes   []string `json:"required_attributes"`
+}
+
+// activationPolicyConfig is the JSON form of the activation policy: rules for
+// every product plus extra rules per category.
+type activationPolicyConfig struct {
+	activationRulesConfig
+	Categories map[string]activationRulesConfig `json:"categories"`
+}
+
+// parseActivationPolicy reads an activation policy given as JSON, e.g.
+// {"require_description":true,"min_base_price":"0.99",
+// "categories":{"electronics":{"min_description_length":80,
+// "required_attributes":["brand"]}}}.
+// An empty spec means no rules.
+func parseActivationPolicy(spec string) (*domain.ActivationPolicy, error) {
+	if spec == "" {
+		return nil, nil
+	}
+
+	var cfg activationPolicyConfig
+	decoder := json.NewDecoder(strings.NewReader(spec))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&cfg); err != nil {
+		return nil, err
+	}
+
+	rules, err := activationRules(cfg.activationRulesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]string, 0, len(cfg.Categories))
+	for category := range cfg.Categories {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	for _, category := range categories {
+		categoryRules, err := activationRules(cfg.Categories[category])
+		if err != nil {
+			return nil, fmt.Errorf("category %q: %w", category, err)
+		}
+		rules = append(rules, domain.ForCategory(category, categoryRules...))
+	}
+
+	return domain.NewActivationPolicy(rules...), nil
+}
+
+func activationRules(cfg activationRulesConfig) ([]domain.ActivationRule, error) {
+	var rules []domain.ActivationRule
+
+	if cfg.RequireDescription {
+		rules = append(rules, domain.RequireDescription())
+	}
+	if cfg.MinDescriptionLength < 0 {
+		return nil, fmt.Errorf("min_description_length must not be negative, got %d", cfg.MinDescriptionLength)
+	}
+	if cfg.MinDescriptionLength > 0 {
+		rules = append(rules, domain.MinDescriptionLength(cfg.MinDescriptionLength))
+	}
+	if cfg.MinBasePrice != "" {
+		amount, ok := new(big.Rat).SetString(cfg.MinBasePrice)
+		if !ok {
+			return nil, fmt.Errorf("min_base_price: invalid amount %q", cfg.MinBasePrice)
+		}
+		floor, err := domain.NewMoneyFromRat(amount)
+		if err != nil {
+			return nil, fmt.Errorf("min_base_price: %w", err)
+		}
+		rules = append(rules, domain.MinBasePrice(floor))
+	}
+	for _, name := range cfg.RequiredAttributes {
+		if name == "" || len(name) > domain.MaxAttributeNameLength {
+			return nil, fmt.Errorf("required_attributes: invalid attribute name %q", name)
+		}
+	}
+	if len(cfg.RequiredAttributes) > 0 {
+		rules = append(rules, domain.RequireAttributes(cfg.RequiredAttributes...))
+	}
+
+	return rules, nil
 }
 
 func getEnv(key, defaultValue string) string {
@@ -103,6 +332,13 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func serveMetrics(address string) {
+	log.Printf("Serving metrics on %s/debug/vars", address)
+	if err := http.ListenAndServe(address, nil); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("Metrics server stopped: %v", err)
+	}
+}
+
 func createSpannerClient(ctx context.Context, config Config) (*spanner.Client, error) {
 	database := fmt.Sprintf(
 		"projects/%s/instances/%s/databases/%s",
@@ -0,0 +1,58 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+// BulkOperationStatus is the progress state of a bulk operation.
+type BulkOperationStatus string
+
+const (
+	BulkOperationRunning   BulkOperationStatus = "running"
+	BulkOperationCompleted BulkOperationStatus = "completed"
+)
+
+// BulkOperation is a bulk action running over the products matching a
+// filter set. Products are visited in ID order; LastProductID is the last
+// one processed, so a chunk resumes right after it.
+type BulkOperation struct {
+	ID      string
+	Action  *domain.BulkAction
+	Filters ProductListFilters
+	Status  BulkOperationStatus
+
+	LastProductID string
+
+	// MatchedCount is the number of products matching when the operation
+	// started. Products changing in or out of the filter afterwards make
+	// ProcessedCount end up above or below it.
+	MatchedCount   int64
+	ProcessedCount int64
+	SucceededCount int64
+	FailedCount    int64
+
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+
+	// Failures is only loaded by BulkOperationReadRepository.Get.
+	Failures []BulkOperationFailure
+}
+
+// BulkOperationFailure records a product the action could not be applied to.
+type BulkOperationFailure struct {
+	ProductID string
+	Message   string
+	FailedAt  time.Time
+}
+
+// BulkOperationReadRepository defines the interface for reading bulk operations.
+type BulkOperationReadRepository interface {
+	// Get retrieves a bulk operation with up to failureLimit of its
+	// failures, ordered by product ID.
+	// Returns domain.ErrBulkOperationNotFound if it does not exist.
+	Get(ctx context.Context, id string, failureLimit int) (*BulkOperation, error)
+}
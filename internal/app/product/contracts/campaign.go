@@ -0,0 +1,44 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// CampaignReadModel represents a campaign for read operations. Status is
+// evaluated when the campaign is read.
+type CampaignReadModel struct {
+	ID              string
+	Name            string
+	DiscountPercent int64
+	StartDate       time.Time
+	EndDate         time.Time
+	EndedAt         *time.Time
+	Status          string
+	ProductIDs      []string
+	Categories      []string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// CampaignListFilters defines filters for listing campaigns.
+type CampaignListFilters struct {
+	// Status is one of the domain.CampaignStatus values, evaluated at query time.
+	Status *string
+}
+
+// CampaignListResult contains a page of campaigns, newest first.
+type CampaignListResult struct {
+	Campaigns []*CampaignReadModel
+	HasMore   bool
+}
+
+// CampaignReadRepository defines the interface for reading campaigns.
+type CampaignReadRepository interface {
+	// Get retrieves a campaign by ID.
+	// Returns domain.ErrCampaignNotFound if it does not exist.
+	Get(ctx context.Context, id string) (*CampaignReadModel, error)
+
+	// List retrieves a page of campaigns, newest first.
+	List(ctx context.Context, filters CampaignListFilters, limit, offset int) (*CampaignListResult, error)
+}
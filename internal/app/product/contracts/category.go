@@ -0,0 +1,44 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// CategoryReadModel represents a category for read operations.
+type CategoryReadModel struct {
+	ID          string
+	Slug        string
+	ParentID    string
+	DisplayName string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CategoryListFilters defines filters for listing categories.
+type CategoryListFilters struct {
+	// ParentID restricts the list to the children of a category; an empty
+	// ParentID lists the roots. Nil lists the whole tree.
+	ParentID *string
+}
+
+// CategoryListResult contains a page of categories, ordered by slug.
+type CategoryListResult struct {
+	Categories []*CategoryReadModel
+	HasMore    bool
+}
+
+// CategoryReadRepository defines the interface for reading the category tree.
+type CategoryReadRepository interface {
+	// Get retrieves a category by ID.
+	// Returns domain.ErrCategoryNotFound if it does not exist.
+	Get(ctx context.Context, id string) (*CategoryReadModel, error)
+
+	// List retrieves a page of categories, ordered by slug.
+	List(ctx context.Context, filters CategoryListFilters, limit, offset int) (*CategoryListResult, error)
+
+	// SubtreeIDs returns the ID of the category followed by the IDs of all
+	// its descendants.
+	// Returns domain.ErrCategoryNotFound if the category does not exist.
+	SubtreeIDs(ctx context.Context, id string) ([]string, error)
+}
@@ -6,7 +6,11 @@
 // Interfaces:
 //   - ProductRepository: Persistence operations for the Product aggregate
 //   - OutboxRepository: Transactional outbox for reliable event publishing
+//   - OutboxReadRepository: Consumption of pending outbox events
 //   - ProductReadModelRepository: Optimized read queries for CQRS
+//   - ProductSearchRepository: Keyword search over the read model
+//   - BulkOperationReadRepository: Progress and failures of bulk operations
+//   - CampaignReadRepository: Promotion campaigns and their status
 //
 // Implementations of these interfaces reside in the repo package.
 package contracts
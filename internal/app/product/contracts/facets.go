@@ -0,0 +1,51 @@
+package contracts
+
+import (
+	"math/big"
+)
+
+// Discount buckets group products by the discount running at query time.
+const (
+	DiscountBucketNone    = "none"
+	DiscountBucket1To24   = "1-24"
+	DiscountBucket25To49  = "25-49"
+	DiscountBucket50To74  = "50-74"
+	DiscountBucket75To100 = "75-100"
+)
+
+// DiscountBuckets returns the discount buckets in ascending order.
+func DiscountBuckets() []string {
+	return []string{
+		DiscountBucketNone,
+		DiscountBucket1To24,
+		DiscountBucket25To49,
+		DiscountBucket50To74,
+		DiscountBucket75To100,
+	}
+}
+
+// FacetCount is the number of products sharing a facet value.
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// PriceBandCount is the number of products whose effective price falls in
+// [Min, Max). A nil bound is unbounded.
+type PriceBandCount struct {
+	Min   *big.Rat
+	Max   *big.Rat
+	Count int64
+}
+
+// ProductFacets contains aggregate counts over a filtered product set.
+// Categories and statuses are ordered by count, most frequent first, and only
+// include values that occur; discount buckets and price bands are listed in
+// ascending order including empty ones.
+type ProductFacets struct {
+	TotalCount      int64
+	Categories      []FacetCount
+	Statuses        []FacetCount
+	DiscountBuckets []FacetCount
+	PriceBands      []PriceBandCount
+}
@@ -0,0 +1,43 @@
+package contracts
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+var (
+	// ErrInvalidPriceRange is returned when a minimum price exceeds its maximum.
+	ErrInvalidPriceRange = errors.New("invalid price range: minimum exceeds maximum")
+
+	// ErrInvalidArchivedMode is returned for an unknown archived mode.
+	ErrInvalidArchivedMode = errors.New("invalid archived_mode: expected exclude, include or only")
+)
+
+// ResolveArchivedMode validates mode and applies the implicit "only" for an
+// archived status filter, which would otherwise never match.
+func ResolveArchivedMode(mode ArchivedMode, status *string) (ArchivedMode, error) {
+	if !mode.IsValid() {
+		return "", ErrInvalidArchivedMode
+	}
+	if mode == ArchivedModeUnspecified && status != nil &&
+		*status == string(domain.ProductStatusArchived) {
+		return ArchivedModeOnly, nil
+	}
+	return mode, nil
+}
+
+// ValidatePriceRanges returns ErrInvalidPriceRange when an optional minimum
+// base or effective price exceeds its maximum.
+func ValidatePriceRanges(minBase, maxBase, minEffective, maxEffective *big.Rat) error {
+	if !validRange(minBase, maxBase) || !validRange(minEffective, maxEffective) {
+		return ErrInvalidPriceRange
+	}
+	return nil
+}
+
+// validRange reports whether an optional min/max pair is not inverted.
+func validRange(min, max *big.Rat) bool {
+	return min == nil || max == nil || min.Cmp(max) <= 0
+}
@@ -1,6 +1,9 @@
 package contracts
 
 import (
+	"context"
+	"time"
+
 	"cloud.google.com/go/spanner"
 
 	"github.com/product-catalog-service/internal/app/product/domain"
@@ -13,6 +16,7 @@ type OutboxEvent struct {
 	AggregateID string
 	Payload     []byte
 	Status      string
+	CreatedAt   time.Time
 }
 
 // OutboxRepository defines the interface for outbox event persistence.
@@ -23,3 +27,18 @@ type OutboxRepository interface {
 	// InsertFromDomainEventMut creates an outbox event from a domain event and returns its mutation.
 	InsertFromDomainEventMut(event domain.DomainEvent) (*spanner.Mutation, error)
 }
+
+// OutboxReadRepository defines the interface the read model projections use
+// to consume outbox events. Projection progress is tracked apart from the
+// delivery status, which belongs to the publisher.
+type OutboxReadRepository interface {
+	// ListUnprojected returns up to limit events not yet projected, oldest first.
+	ListUnprojected(ctx context.Context, limit int) ([]*OutboxEvent, error)
+
+	// OldestUnprojectedAt returns the creation time of the oldest event not
+	// yet projected. Returns nil if every event has been projected.
+	OldestUnprojectedAt(ctx context.Context) (*time.Time, error)
+
+	// MarkProjectedMut returns a mutation recording that an event was projected.
+	MarkProjectedMut(eventID string, projectedAt time.Time) *spanner.Mutation
+}
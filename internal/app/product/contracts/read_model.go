@@ -2,9 +2,14 @@ package contracts
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"time"
 )
 
+// ErrInvalidCursor is returned when a cursor cannot be applied to a listing.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
 // ProductReadModel represents a product for read operations.
 // This is a DTO optimized for queries, not domain logic.
 type ProductReadModel struct {
@@ -23,26 +28,113 @@ type ProductReadModel struct {
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	ArchivedAt           *time.Time
+	PublishAt            *time.Time
+	UnpublishAt          *time.Time
+	Version              int64
+	ExternalKey          string
+	CategoryID           string
+	Attributes           map[string]string
+}
+
+// ProductSortField names a field product listings can be ordered by.
+type ProductSortField string
+
+const (
+	SortByCreatedAt      ProductSortField = "created_at"
+	SortByUpdatedAt      ProductSortField = "updated_at"
+	SortByName           ProductSortField = "name"
+	SortByCategory       ProductSortField = "category"
+	SortByBasePrice      ProductSortField = "base_price"
+	SortByEffectivePrice ProductSortField = "effective_price"
+)
+
+// IsValid checks if the field is a supported sort field.
+func (f ProductSortField) IsValid() bool {
+	switch f {
+	case SortByCreatedAt, SortByUpdatedAt, SortByName, SortByCategory,
+		SortByBasePrice, SortByEffectivePrice:
+		return true
+	}
+	return false
+}
+
+// ProductOrder defines the ordering of a product listing.
+// The zero value orders by creation time, newest first.
+// Ties are always broken by product ID in the same direction.
+type ProductOrder struct {
+	Field     ProductSortField
+	Ascending bool
+}
+
+// ArchivedMode controls whether listings include archived products.
+// The zero value is unspecified and behaves like ArchivedModeExclude.
+type ArchivedMode string
+
+const (
+	ArchivedModeUnspecified ArchivedMode = ""
+	ArchivedModeExclude     ArchivedMode = "exclude"
+	ArchivedModeInclude     ArchivedMode = "include"
+	ArchivedModeOnly        ArchivedMode = "only"
+)
+
+// IsValid checks if the mode is a known ArchivedMode.
+func (m ArchivedMode) IsValid() bool {
+	switch m {
+	case ArchivedModeUnspecified, ArchivedModeExclude, ArchivedModeInclude, ArchivedModeOnly:
+		return true
+	}
+	return false
 }
 
 // ProductListFilters defines filters for listing products.
+// Price bounds are inclusive; effective prices are evaluated at query time.
+// MinDiscountPercent only matches discounts running at OnSaleAt (or now).
+// CampaignID matches the products a campaign selects, whether or not it is
+// running. CategoryIDs matches products assigned to any of the categories.
 type ProductListFilters struct {
-	Category   *string
-	Status     *string
-	ActiveOnly bool
+	Category     *string
+	CategoryIDs  []string
+	Status       *string
+	ActiveOnly   bool
+	ArchivedMode ArchivedMode
+	OrderBy      ProductOrder
+	CampaignID   *string
+
+	MinBasePrice       *big.Rat
+	MaxBasePrice       *big.Rat
+	MinEffectivePrice  *big.Rat
+	MaxEffectivePrice  *big.Rat
+	OnSaleAt           *time.Time
+	MinDiscountPercent *int64
+}
+
+// ProductCursor is a keyset position in a product listing.
+// It identifies the last row of a page; the next page starts right after it.
+// SortKey is the repository's encoding of the row's sort value and is only
+// meaningful under the order the cursor was taken with.
+type ProductCursor struct {
+	SortKey   string
+	ProductID string
 }
 
 // Pagination defines pagination parameters.
+// When After is set, keyset pagination is used and Offset must be zero;
+// the API rejects page tokens combined with an offset.
 type Pagination struct {
-	Limit  int
-	Offset int
+	Limit          int
+	Offset         int
+	After          *ProductCursor
+	SkipTotalCount bool
 }
 
 // ProductListResult contains the result of a product list query.
+// TotalCount is zero when the count was skipped.
+// NextCursor is set when HasMore is true.
 type ProductListResult struct {
 	Products   []*ProductReadModel
 	TotalCount int64
 	HasMore    bool
+	NextCursor *ProductCursor
 }
 
 // ProductReadModelRepository defines the interface for product read operations.
@@ -51,9 +143,29 @@ type ProductReadModelRepository interface {
 	// GetByID retrieves a product read model by ID.
 	GetByID(ctx context.Context, id string) (*ProductReadModel, error)
 
+	// GetByIDs retrieves several product read models with a single read.
+	// Products that do not exist are absent from the returned map.
+	GetByIDs(ctx context.Context, ids []string) (map[string]*ProductReadModel, error)
+
 	// List retrieves a paginated list of products with optional filters.
 	List(ctx context.Context, filters ProductListFilters, pagination Pagination) (*ProductListResult, error)
 
+	// Facets aggregates the products matching filters by category, status,
+	// running discount and effective price band. bandBoundaries are ascending
+	// effective prices splitting the price range into len(bandBoundaries)+1 bands.
+	Facets(ctx context.Context, filters ProductListFilters, bandBoundaries []*big.Rat) (*ProductFacets, error)
+
+	// Export streams every product matching filters to fn in batches, all
+	// read at one timestamp, which it returns even if nothing matched.
+	// OrderBy is ignored: batches arrive in no particular order, read by up
+	// to parallelism workers, but fn is never called concurrently.
+	Export(
+		ctx context.Context,
+		filters ProductListFilters,
+		parallelism int,
+		fn func(readTimestamp time.Time, products []*ProductReadModel) error,
+	) (time.Time, error)
+
 	// CountByCategory counts products in a category.
 	CountByCategory(ctx context.Context, category string) (int64, error)
 }
@@ -0,0 +1,32 @@
+package contracts
+
+import (
+	"context"
+)
+
+// ProductSearchHit is a product matched by a search with its relevance score.
+type ProductSearchHit struct {
+	Product *ProductReadModel
+	Score   float64
+}
+
+// ProductSearchResult contains the result of a product search.
+// TotalCount is zero when the count was skipped.
+type ProductSearchResult struct {
+	Hits       []*ProductSearchHit
+	TotalCount int64
+	HasMore    bool
+}
+
+// ProductSearchRepository defines keyword search over products.
+// Results are ordered by relevance; pagination is offset-based and the
+// OrderBy filter is ignored.
+type ProductSearchRepository interface {
+	// Search finds products whose name, description or category match text.
+	Search(
+		ctx context.Context,
+		text string,
+		filters ProductListFilters,
+		pagination Pagination,
+	) (*ProductSearchResult, error)
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ActivationViolation describes one readiness rule a product fails.
+type ActivationViolation struct {
+	// Rule identifies the failed rule, e.g. "min_description_length".
+	Rule string
+	// Field is the product field at fault, e.g. FieldDescription.
+	Field string
+	// Description explains the violation to the caller.
+	Description string
+}
+
+// ActivationRule checks one readiness condition of a product.
+type ActivationRule interface {
+	Check(p *Product) []ActivationViolation
+}
+
+// ActivationRuleFunc adapts an ordinary function to ActivationRule.
+type ActivationRuleFunc func(p *Product) []ActivationViolation
+
+// Check calls f(p).
+func (f ActivationRuleFunc) Check(p *Product) []ActivationViolation {
+	return f(p)
+}
+
+// ActivationPolicy is the set of rules a product must meet before it is
+// activated. A nil policy has no rules.
+type ActivationPolicy struct {
+	rules []ActivationRule
+}
+
+// NewActivationPolicy creates a policy from rules.
+func NewActivationPolicy(rules ...ActivationRule) *ActivationPolicy {
+	return &ActivationPolicy{rules: rules}
+}
+
+// Check evaluates every rule against p and returns an *ActivationPolicyError
+// listing all violations, or nil when p is ready to be activated.
+func (ap *ActivationPolicy) Check(p *Product) error {
+	if ap == nil {
+		return nil
+	}
+
+	var violations []ActivationViolation
+	for _, rule := range ap.rules {
+		violations = append(violations, rule.Check(p)...)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ActivationPolicyError{Violations: violations}
+}
+
+// ActivationPolicyError is returned when a product fails the activation
+// policy. It matches ErrActivationPolicyViolated with errors.Is.
+type ActivationPolicyError struct {
+	Violations []ActivationViolation
+}
+
+func (e *ActivationPolicyError) Error() string {
+	descriptions := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		descriptions[i] = v.Description
+	}
+	return fmt.Sprintf("%s: %s", ErrActivationPolicyViolated, strings.Join(descriptions, "; "))
+}
+
+func (e *ActivationPolicyError) Unwrap() error {
+	return ErrActivationPolicyViolated
+}
+
+// RequireDescription requires a non-blank description.
+func RequireDescription() ActivationRule {
+	return ActivationRuleFunc(func(p *Product) []ActivationViolation {
+		if strings.TrimSpace(p.description) != "" {
+			return nil
+		}
+		return []ActivationViolation{{
+			Rule:        "description_required",
+			Field:       FieldDescription,
+			Description: "description is required",
+		}}
+	})
+}
+
+// MinDescriptionLength requires a description of at least n characters,
+// ignoring surrounding whitespace.
+func MinDescriptionLength(n int) ActivationRule {
+	return ActivationRuleFunc(func(p *Product) []ActivationViolation {
+		if utf8.RuneCountInString(strings.TrimSpace(p.description)) >= n {
+			return nil
+		}
+		return []ActivationViolation{{
+			Rule:        "min_description_length",
+			Field:       FieldDescription,
+			Description: fmt.Sprintf("description must be at least %d characters", n),
+		}}
+	})
+}
+
+// MinBasePrice requires a base price of at least floor.
+func MinBasePrice(floor *Money) ActivationRule {
+	return ActivationRuleFunc(func(p *Product) []ActivationViolation {
+		if !p.basePrice.LessThan(floor) {
+			return nil
+		}
+		return []ActivationViolation{{
+			Rule:        "min_base_price",
+			Field:       FieldBasePrice,
+			Description: fmt.Sprintf("base price must be at least %s", floor),
+		}}
+	})
+}
+
+// RequireAttributes requires a non-blank value for each of the named
+// attributes. It is usually scoped with ForCategory.
+func RequireAttributes(names ...string) ActivationRule {
+	return ActivationRuleFunc(func(p *Product) []ActivationViolation {
+		var violations []ActivationViolation
+		for _, name := range names {
+			if strings.TrimSpace(p.attributes[name]) != "" {
+				continue
+			}
+			violations = append(violations, ActivationViolation{
+				Rule:        "required_attribute",
+				Field:       FieldAttributes + "." + name,
+				Description: fmt.Sprintf("attribute %q is required", name),
+			})
+		}
+		return violations
+	})
+}
+
+// ForCategory applies rules only to products in category.
+func ForCategory(category string, rules ...ActivationRule) ActivationRule {
+	return ActivationRuleFunc(func(p *Product) []ActivationViolation {
+		if p.category != category {
+			return nil
+		}
+		var violations []ActivationViolation
+		for _, rule := range rules {
+			violations = append(violations, rule.Check(p)...)
+		}
+		return violations
+	})
+}
@@ -0,0 +1,171 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+func TestActivationPolicy_NilAllowsEverything(t *testing.T) {
+	var policy *domain.ActivationPolicy
+	product := createProductWith(t, "", "Category", 1)
+
+	assert.NoError(t, policy.Check(product))
+}
+
+func TestActivationPolicy_Check(t *testing.T) {
+	floor, _ := domain.NewMoney(500, 100)
+	policy := domain.NewActivationPolicy(
+		domain.RequireDescription(),
+		domain.MinDescriptionLength(10),
+		domain.MinBasePrice(floor),
+		domain.ForCategory("electronics", domain.MinDescriptionLength(20)),
+	)
+
+	tests := []struct {
+		name        string
+		description string
+		category    string
+		priceCents  int64
+		wantRules   []string
+	}{
+		{
+			name:        "ready",
+			description: "A sturdy oak table",
+			category:    "furniture",
+			priceCents:  500,
+		},
+		{
+			name:        "blank description",
+			description: "   ",
+			category:    "furniture",
+			priceCents:  999,
+			wantRules:   []string{"description_required", "min_description_length"},
+		},
+		{
+			name:        "below price floor",
+			description: "A sturdy oak table",
+			category:    "furniture",
+			priceCents:  499,
+			wantRules:   []string{"min_base_price"},
+		},
+		{
+			name:        "category rule",
+			description: "A fast laptop",
+			category:    "electronics",
+			priceCents:  99900,
+			wantRules:   []string{"min_description_length"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := createProductWith(t, tt.description, tt.category, tt.priceCents)
+
+			err := policy.Check(product)
+			if tt.wantRules == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, domain.ErrActivationPolicyViolated)
+			var policyErr *domain.ActivationPolicyError
+			require.True(t, errors.As(err, &policyErr))
+
+			rules := make([]string, len(policyErr.Violations))
+			for i, v := range policyErr.Violations {
+				rules[i] = v.Rule
+				assert.NotEmpty(t, v.Field)
+				assert.NotEmpty(t, v.Description)
+			}
+			assert.Equal(t, tt.wantRules, rules)
+		})
+	}
+}
+
+func TestActivationPolicy_RequireAttributes(t *testing.T) {
+	now := time.Now()
+	policy := domain.NewActivationPolicy(
+		domain.ForCategory("electronics", domain.RequireAttributes("brand", "warranty")),
+	)
+
+	// Other categories need no attributes
+	assert.NoError(t, policy.Check(createProductWith(t, "A sturdy oak table", "furniture", 999)))
+
+	product := createProductWith(t, "A fast laptop", "electronics", 99900)
+	err := policy.Check(product)
+	var policyErr *domain.ActivationPolicyError
+	require.True(t, errors.As(err, &policyErr))
+	require.Len(t, policyErr.Violations, 2)
+	assert.Equal(t, "required_attribute", policyErr.Violations[0].Rule)
+	assert.Equal(t, "attributes.brand", policyErr.Violations[0].Field)
+	assert.Equal(t, "attributes.warranty", policyErr.Violations[1].Field)
+
+	// A blank value doesn't count
+	require.NoError(t, product.Update(domain.ProductUpdate{
+		Attributes: map[string]string{"brand": "Acme", "warranty": " "},
+	}, now))
+	require.True(t, errors.As(policy.Check(product), &policyErr))
+	require.Len(t, policyErr.Violations, 1)
+	assert.Equal(t, "attributes.warranty", policyErr.Violations[0].Field)
+
+	require.NoError(t, product.Update(domain.ProductUpdate{
+		Attributes: map[string]string{"brand": "Acme", "warranty": "2 years"},
+	}, now))
+	assert.NoError(t, policy.Check(product))
+}
+
+func TestProduct_ActivateEnforcesPolicy(t *testing.T) {
+	now := time.Now()
+	policy := domain.NewActivationPolicy(domain.RequireDescription())
+
+	product := createProductWith(t, "", "Category", 1999)
+	err := product.Activate(lifecycle, policy, now)
+	assert.ErrorIs(t, err, domain.ErrActivationPolicyViolated)
+	assert.Equal(t, domain.ProductStatusDraft, product.Status())
+
+	err = product.Transition(lifecycle, policy, domain.ProductStatusActive, now)
+	assert.ErrorIs(t, err, domain.ErrActivationPolicyViolated)
+
+	product = createProductWith(t, "Ready to sell", "Category", 1999)
+	require.NoError(t, product.Activate(lifecycle, policy, now))
+	assert.ErrorIs(t, product.Activate(lifecycle, policy, now), domain.ErrProductAlreadyActive)
+}
+
+func TestProduct_RunScheduleSkipsUnreadyPublish(t *testing.T) {
+	now := time.Now()
+	product := createProductWith(t, "", "Category", 1999)
+	policy := domain.NewActivationPolicy(domain.RequireDescription())
+
+	publishAt := now.Add(time.Hour)
+	require.NoError(t, product.Schedule(&publishAt, nil, now))
+	product.ClearEvents()
+
+	fired, err := product.RunSchedule(lifecycle, policy, publishAt)
+	require.NoError(t, err)
+	assert.True(t, fired)
+	assert.Equal(t, domain.ProductStatusDraft, product.Status())
+	assert.Nil(t, product.PublishAt())
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	failed := events[0].(*domain.ProductScheduleFailedEvent)
+	assert.Equal(t, "publish", failed.Transition)
+	require.Len(t, failed.Violations, 1)
+	assert.Equal(t, "description_required", failed.Violations[0].Rule)
+	assert.Equal(t, domain.FieldDescription, failed.Violations[0].Field)
+}
+
+func createProductWith(t *testing.T, description, category string, priceCents int64) *domain.Product {
+	t.Helper()
+	basePrice, err := domain.NewMoney(priceCents, 100)
+	require.NoError(t, err)
+	product, err := domain.NewProduct("test-id", "Product", description, category, basePrice, time.Now())
+	require.NoError(t, err)
+	return product
+}
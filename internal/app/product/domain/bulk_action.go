@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"time"
+)
+
+// BulkCommand names a product command that can be run over many products.
+type BulkCommand string
+
+const (
+	BulkCommandActivate       BulkCommand = "activate"
+	BulkCommandDeactivate     BulkCommand = "deactivate"
+	BulkCommandArchive        BulkCommand = "archive"
+	BulkCommandApplyDiscount  BulkCommand = "apply_discount"
+	BulkCommandRemoveDiscount BulkCommand = "remove_discount"
+	BulkCommandChangeCategory BulkCommand = "change_category"
+
+	// BulkCommandAssignCategory moves products into a category of the tree.
+	// It is started by RenameCategory and MergeCategories, not by BulkUpdate.
+	BulkCommandAssignCategory BulkCommand = "assign_category"
+)
+
+// IsValid checks if the command is a known BulkCommand.
+func (c BulkCommand) IsValid() bool {
+	switch c {
+	case BulkCommandActivate, BulkCommandDeactivate, BulkCommandArchive,
+		BulkCommandApplyDiscount, BulkCommandRemoveDiscount, BulkCommandChangeCategory,
+		BulkCommandAssignCategory:
+		return true
+	}
+	return false
+}
+
+// BulkActionParams are the arguments of a bulk command. Only the discount
+// fields are read for BulkCommandApplyDiscount, only Category for
+// BulkCommandChangeCategory and only CategoryID for BulkCommandAssignCategory.
+type BulkActionParams struct {
+	Percentage int64
+	StartDate  time.Time
+	EndDate    time.Time
+	Category   string
+	CategoryID string
+}
+
+// BulkAction is a validated bulk command with its arguments. It is applied
+// to one product at a time through the same methods as the single-product
+// commands, so every product gets the same rules and events.
+type BulkAction struct {
+	command    BulkCommand
+	discount   *Discount
+	category   string
+	categoryID string
+}
+
+// NewBulkAction creates a new BulkAction, validating the arguments the
+// command needs.
+func NewBulkAction(command BulkCommand, params BulkActionParams) (*BulkAction, error) {
+	action := &BulkAction{command: command}
+
+	switch command {
+	case BulkCommandApplyDiscount:
+		discount, err := NewDiscount(params.Percentage, params.StartDate, params.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		action.discount = discount
+	case BulkCommandChangeCategory:
+		if params.Category == "" {
+			return nil, ErrEmptyCategory
+		}
+		if len(params.Category) > MaxCategoryLength {
+			return nil, ErrCategoryTooLong
+		}
+		action.category = params.Category
+	case BulkCommandAssignCategory:
+		if params.CategoryID == "" {
+			return nil, ErrCategoryNotFound
+		}
+		action.categoryID = params.CategoryID
+	case BulkCommandActivate, BulkCommandDeactivate, BulkCommandArchive, BulkCommandRemoveDiscount:
+	default:
+		return nil, ErrInvalidBulkCommand
+	}
+
+	return action, nil
+}
+
+// Command returns the command the action runs.
+func (a *BulkAction) Command() BulkCommand {
+	return a.command
+}
+
+// Params returns the arguments the action was created with.
+func (a *BulkAction) Params() BulkActionParams {
+	var params BulkActionParams
+	if a.discount != nil {
+		params.Percentage = a.discount.Percentage()
+		params.StartDate = a.discount.StartDate()
+		params.EndDate = a.discount.EndDate()
+	}
+	params.Category = a.category
+	params.CategoryID = a.categoryID
+	return params
+}
+
+// Apply runs the action on p. Activation must meet the activation policy,
+// as in ActivateProduct. category is the category a
+// BulkCommandAssignCategory action assigns, as loaded when the chunk runs so
+// a later rename is picked up; nil means it no longer exists. Other commands
+// ignore it.
+func (a *BulkAction) Apply(p *Product, lifecycle *Lifecycle, policy *ActivationPolicy, category *Category, now time.Time) error {
+	switch a.command {
+	case BulkCommandActivate:
+		return p.Activate(lifecycle, policy, now)
+	case BulkCommandDeactivate:
+		return p.Deactivate(lifecycle, now)
+	case BulkCommandArchive:
+		return p.Archive(lifecycle, now)
+	case BulkCommandApplyDiscount:
+		return p.ApplyDiscount(a.discount, now)
+	case BulkCommandRemoveDiscount:
+		return p.RemoveDiscount(now)
+	case BulkCommandChangeCategory:
+		return p.Update(ProductUpdate{Category: &a.category}, now)
+	case BulkCommandAssignCategory:
+		if category == nil || category.ID() != a.categoryID {
+			return ErrCategoryNotFound
+		}
+		return p.MoveToCategory(category, now)
+	default:
+		return ErrInvalidBulkCommand
+	}
+}
@@ -0,0 +1,179 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+func TestNewBulkAction(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		command domain.BulkCommand
+		params  domain.BulkActionParams
+		wantErr error
+	}{
+		{
+			name:    "activate takes no arguments",
+			command: domain.BulkCommandActivate,
+		},
+		{
+			name:    "apply discount",
+			command: domain.BulkCommandApplyDiscount,
+			params:  domain.BulkActionParams{Percentage: 15, StartDate: now, EndDate: now.Add(time.Hour)},
+		},
+		{
+			name:    "apply discount with invalid percentage",
+			command: domain.BulkCommandApplyDiscount,
+			params:  domain.BulkActionParams{Percentage: 0, StartDate: now, EndDate: now.Add(time.Hour)},
+			wantErr: domain.ErrInvalidDiscountPercentage,
+		},
+		{
+			name:    "apply discount ending before it starts",
+			command: domain.BulkCommandApplyDiscount,
+			params:  domain.BulkActionParams{Percentage: 15, StartDate: now, EndDate: now.Add(-time.Hour)},
+			wantErr: domain.ErrInvalidDiscountPeriod,
+		},
+		{
+			name:    "change category",
+			command: domain.BulkCommandChangeCategory,
+			params:  domain.BulkActionParams{Category: "Lighting"},
+		},
+		{
+			name:    "change category to empty",
+			command: domain.BulkCommandChangeCategory,
+			wantErr: domain.ErrEmptyCategory,
+		},
+		{
+			name:    "change category too long",
+			command: domain.BulkCommandChangeCategory,
+			params:  domain.BulkActionParams{Category: strings.Repeat("c", domain.MaxCategoryLength+1)},
+			wantErr: domain.ErrCategoryTooLong,
+		},
+		{
+			name:    "assign category",
+			command: domain.BulkCommandAssignCategory,
+			params:  domain.BulkActionParams{CategoryID: "cat-1"},
+		},
+		{
+			name:    "assign category without an ID",
+			command: domain.BulkCommandAssignCategory,
+			wantErr: domain.ErrCategoryNotFound,
+		},
+		{
+			name:    "unknown command",
+			command: domain.BulkCommand("delete"),
+			wantErr: domain.ErrInvalidBulkCommand,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, err := domain.NewBulkAction(tt.command, tt.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.command, action.Command())
+			assert.Equal(t, tt.params, action.Params())
+		})
+	}
+}
+
+func TestBulkAction_Apply(t *testing.T) {
+	now := time.Now()
+
+	t.Run("activate runs the activation policy", func(t *testing.T) {
+		policy := domain.NewActivationPolicy(domain.MinDescriptionLength(50))
+		product := createProductWith(t, "Too short", "Category", 1999)
+		action, err := domain.NewBulkAction(domain.BulkCommandActivate, domain.BulkActionParams{})
+		require.NoError(t, err)
+
+		err = action.Apply(product, lifecycle, policy, nil, now)
+		assert.ErrorIs(t, err, domain.ErrActivationPolicyViolated)
+		assert.False(t, product.IsActive())
+	})
+
+	t.Run("activate reports state errors before policy violations", func(t *testing.T) {
+		policy := domain.NewActivationPolicy(domain.MinDescriptionLength(50))
+		product := createArchivedProduct(t)
+		action, err := domain.NewBulkAction(domain.BulkCommandActivate, domain.BulkActionParams{})
+		require.NoError(t, err)
+
+		err = action.Apply(product, lifecycle, policy, nil, now)
+		assert.ErrorIs(t, err, domain.ErrCannotActivateArchived)
+	})
+
+	t.Run("apply discount shares the discount", func(t *testing.T) {
+		action, err := domain.NewBulkAction(domain.BulkCommandApplyDiscount, domain.BulkActionParams{
+			Percentage: 10,
+			StartDate:  now,
+			EndDate:    now.Add(24 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		first, second := createActiveProduct(t), createActiveProduct(t)
+		require.NoError(t, action.Apply(first, lifecycle, nil, nil, now))
+		require.NoError(t, action.Apply(second, lifecycle, nil, nil, now))
+		assert.Equal(t, int64(10), first.Discount().Percentage())
+		assert.Equal(t, int64(10), second.Discount().Percentage())
+	})
+
+	t.Run("apply discount to an inactive product", func(t *testing.T) {
+		action, err := domain.NewBulkAction(domain.BulkCommandApplyDiscount, domain.BulkActionParams{
+			Percentage: 10,
+			StartDate:  now,
+			EndDate:    now.Add(24 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		product := createProductWith(t, "Description", "Category", 1999)
+		assert.ErrorIs(t, action.Apply(product, lifecycle, nil, nil, now), domain.ErrProductNotActive)
+	})
+
+	t.Run("change category emits an update", func(t *testing.T) {
+		action, err := domain.NewBulkAction(domain.BulkCommandChangeCategory, domain.BulkActionParams{Category: "Lighting"})
+		require.NoError(t, err)
+
+		product := createActiveProduct(t)
+		events := len(product.DomainEvents())
+		require.NoError(t, action.Apply(product, lifecycle, nil, nil, now))
+		assert.Equal(t, "Lighting", product.Category())
+		assert.Greater(t, len(product.DomainEvents()), events)
+	})
+
+	t.Run("assign category moves archived products too", func(t *testing.T) {
+		action, err := domain.NewBulkAction(domain.BulkCommandAssignCategory, domain.BulkActionParams{CategoryID: "cat-1"})
+		require.NoError(t, err)
+		category := domain.ReconstituteCategory("cat-1", "lighting", "", "Lighting", now, now)
+
+		product := createArchivedProduct(t)
+		require.NoError(t, action.Apply(product, lifecycle, nil, category, now))
+		assert.Equal(t, "Lighting", product.Category())
+		assert.Equal(t, "cat-1", product.CategoryID())
+		assert.True(t, product.Changes().Dirty(domain.FieldCategoryID))
+	})
+
+	t.Run("assign category that no longer exists", func(t *testing.T) {
+		action, err := domain.NewBulkAction(domain.BulkCommandAssignCategory, domain.BulkActionParams{CategoryID: "cat-1"})
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, action.Apply(createActiveProduct(t), lifecycle, nil, nil, now), domain.ErrCategoryNotFound)
+	})
+
+	t.Run("remove discount without one", func(t *testing.T) {
+		action, err := domain.NewBulkAction(domain.BulkCommandRemoveDiscount, domain.BulkActionParams{})
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, action.Apply(createActiveProduct(t), lifecycle, nil, nil, now), domain.ErrNoDiscountToRemove)
+	})
+}
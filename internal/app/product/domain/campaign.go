@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"time"
+)
+
+// MaxCampaignNameLength is the maximum allowed length for campaign names.
+const MaxCampaignNameLength = 255
+
+// MaxCampaignSelectionSize is the maximum number of product IDs, and
+// separately of categories, a campaign can select.
+const MaxCampaignSelectionSize = 1000
+
+// CampaignStatus is where a campaign is in its time window.
+type CampaignStatus string
+
+const (
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+	CampaignStatusRunning   CampaignStatus = "running"
+	CampaignStatusEnded     CampaignStatus = "ended"
+)
+
+// IsValid checks if the status is a valid CampaignStatus.
+func (s CampaignStatus) IsValid() bool {
+	switch s {
+	case CampaignStatusScheduled, CampaignStatusRunning, CampaignStatusEnded:
+		return true
+	}
+	return false
+}
+
+// CampaignSelection chooses the products a campaign applies to: the listed
+// products plus every product in one of the listed categories.
+type CampaignSelection struct {
+	ProductIDs []string
+	Categories []string
+}
+
+// Campaign is the aggregate root for a promotion shared by many products.
+// Its discount is not copied onto the products; a product's effective price
+// considers the campaigns running at the time alongside its own discount.
+type Campaign struct {
+	id        string
+	name      string
+	discount  *Discount
+	selection CampaignSelection
+	createdAt time.Time
+	updatedAt time.Time
+	endedAt   *time.Time
+
+	events []DomainEvent
+	isNew  bool
+}
+
+// NewCampaign creates a new campaign. The discount carries the campaign's
+// percentage and time window, which must not already be over.
+func NewCampaign(id, name string, discount *Discount, selection CampaignSelection, now time.Time) (*Campaign, error) {
+	if name == "" {
+		return nil, ErrEmptyCampaignName
+	}
+	if len(name) > MaxCampaignNameLength {
+		return nil, ErrCampaignNameTooLong
+	}
+	if discount.IsExpired(now) {
+		return nil, ErrDiscountExpired
+	}
+
+	selection = CampaignSelection{
+		ProductIDs: Dedupe(selection.ProductIDs),
+		Categories: Dedupe(selection.Categories),
+	}
+	if len(selection.ProductIDs) == 0 && len(selection.Categories) == 0 {
+		return nil, ErrEmptyCampaignSelection
+	}
+	if len(selection.ProductIDs) > MaxCampaignSelectionSize || len(selection.Categories) > MaxCampaignSelectionSize {
+		return nil, ErrCampaignSelectionTooLarge
+	}
+	for _, category := range selection.Categories {
+		if len(category) > MaxCategoryLength {
+			return nil, ErrCategoryTooLong
+		}
+	}
+
+	c := &Campaign{
+		id:        id,
+		name:      name,
+		discount:  discount,
+		selection: selection,
+		createdAt: now,
+		updatedAt: now,
+		events:    make([]DomainEvent, 0),
+		isNew:     true,
+	}
+
+	c.events = append(c.events, NewCampaignCreatedEvent(
+		id,
+		name,
+		discount.Percentage(),
+		discount.StartDate(),
+		discount.EndDate(),
+		selection,
+		now,
+	))
+
+	return c, nil
+}
+
+// ReconstituteCampaign recreates a campaign from persistence without triggering events.
+func ReconstituteCampaign(
+	id, name string,
+	discount *Discount,
+	selection CampaignSelection,
+	createdAt, updatedAt time.Time,
+	endedAt *time.Time,
+) *Campaign {
+	return &Campaign{
+		id:        id,
+		name:      name,
+		discount:  discount,
+		selection: selection,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+		endedAt:   endedAt,
+		events:    make([]DomainEvent, 0),
+		isNew:     false,
+	}
+}
+
+// ID returns the campaign ID.
+func (c *Campaign) ID() string {
+	return c.id
+}
+
+// Name returns the campaign name.
+func (c *Campaign) Name() string {
+	return c.name
+}
+
+// Discount returns the campaign's percentage and scheduled time window.
+func (c *Campaign) Discount() *Discount {
+	return c.discount
+}
+
+// Selection returns the products and categories the campaign applies to.
+func (c *Campaign) Selection() CampaignSelection {
+	return c.selection
+}
+
+// CreatedAt returns the creation timestamp.
+func (c *Campaign) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns the last update timestamp.
+func (c *Campaign) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// EndedAt returns when the campaign was ended early, or nil.
+func (c *Campaign) EndedAt() *time.Time {
+	return c.endedAt
+}
+
+// IsNew returns true if this is a new campaign not yet persisted.
+func (c *Campaign) IsNew() bool {
+	return c.isNew
+}
+
+// DomainEvents returns all domain events captured by this aggregate.
+func (c *Campaign) DomainEvents() []DomainEvent {
+	return c.events
+}
+
+// Status returns where the campaign is in its window at now.
+func (c *Campaign) Status(now time.Time) CampaignStatus {
+	switch {
+	case c.endedAt != nil && !now.Before(*c.endedAt), c.discount.IsExpired(now):
+		return CampaignStatusEnded
+	case !c.discount.HasStarted(now):
+		return CampaignStatusScheduled
+	default:
+		return CampaignStatusRunning
+	}
+}
+
+// IsRunningAt returns true if the campaign's discount applies at t. The
+// window is inclusive, as for product discounts, and closes when the
+// campaign is ended early.
+func (c *Campaign) IsRunningAt(t time.Time) bool {
+	if c.endedAt != nil && !t.Before(*c.endedAt) {
+		return false
+	}
+	return c.discount.IsValidAt(t)
+}
+
+// Selects returns true if the selection includes the given product.
+func (c *Campaign) Selects(productID, category string) bool {
+	for _, id := range c.selection.ProductIDs {
+		if id == productID {
+			return true
+		}
+	}
+	for _, cat := range c.selection.Categories {
+		if cat == category {
+			return true
+		}
+	}
+	return false
+}
+
+// End closes the campaign at now, before its scheduled end. A campaign that
+// has not started yet is cancelled.
+func (c *Campaign) End(now time.Time) error {
+	if c.Status(now) == CampaignStatusEnded {
+		return ErrCampaignAlreadyEnded
+	}
+
+	c.endedAt = &now
+	c.updatedAt = now
+	c.events = append(c.events, NewCampaignEndedEvent(c.id, c.discount.EndDate(), now))
+
+	return nil
+}
+
+// BestCampaign returns the campaign with the highest percentage among those
+// running at t that select the given product, or nil. Campaign discounts do
+// not stack with each other.
+func BestCampaign(campaigns []*Campaign, productID, category string, t time.Time) *Campaign {
+	var best *Campaign
+	for _, c := range campaigns {
+		if !c.IsRunningAt(t) || !c.Selects(productID, category) {
+			continue
+		}
+		if best == nil || c.discount.Percentage() > best.discount.Percentage() {
+			best = c
+		}
+	}
+	return best
+}
+
+// Dedupe returns values without empty strings and repeats, in first-seen
+// order. Commands taking lists of IDs use it to count distinct IDs.
+func Dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	return result
+}
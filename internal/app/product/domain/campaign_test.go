@@ -0,0 +1,206 @@
+package domain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+func TestNewCampaign(t *testing.T) {
+	now := time.Now()
+	week, _ := domain.NewDiscount(25, now, now.Add(7*24*time.Hour))
+	past, _ := domain.NewDiscount(25, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	tooMany := make([]string, domain.MaxCampaignSelectionSize+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("product-%d", i)
+	}
+
+	tests := []struct {
+		name      string
+		campaign  string
+		discount  *domain.Discount
+		selection domain.CampaignSelection
+		wantErr   error
+	}{
+		{
+			name:      "valid campaign",
+			campaign:  "Black Friday",
+			discount:  week,
+			selection: domain.CampaignSelection{Categories: []string{"Electronics"}},
+		},
+		{
+			name:      "empty name",
+			campaign:  "",
+			discount:  week,
+			selection: domain.CampaignSelection{Categories: []string{"Electronics"}},
+			wantErr:   domain.ErrEmptyCampaignName,
+		},
+		{
+			name:      "name too long",
+			campaign:  strings.Repeat("a", domain.MaxCampaignNameLength+1),
+			discount:  week,
+			selection: domain.CampaignSelection{Categories: []string{"Electronics"}},
+			wantErr:   domain.ErrCampaignNameTooLong,
+		},
+		{
+			name:      "window already over",
+			campaign:  "Black Friday",
+			discount:  past,
+			selection: domain.CampaignSelection{Categories: []string{"Electronics"}},
+			wantErr:   domain.ErrDiscountExpired,
+		},
+		{
+			name:      "empty selection",
+			campaign:  "Black Friday",
+			discount:  week,
+			selection: domain.CampaignSelection{ProductIDs: []string{""}},
+			wantErr:   domain.ErrEmptyCampaignSelection,
+		},
+		{
+			name:      "selection too large",
+			campaign:  "Black Friday",
+			discount:  week,
+			selection: domain.CampaignSelection{ProductIDs: tooMany},
+			wantErr:   domain.ErrCampaignSelectionTooLarge,
+		},
+		{
+			name:      "category too long",
+			campaign:  "Black Friday",
+			discount:  week,
+			selection: domain.CampaignSelection{Categories: []string{strings.Repeat("c", domain.MaxCategoryLength+1)}},
+			wantErr:   domain.ErrCategoryTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			campaign, err := domain.NewCampaign("campaign-id", tt.campaign, tt.discount, tt.selection, now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, campaign)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, campaign.IsNew())
+				assert.Equal(t, domain.CampaignStatusRunning, campaign.Status(now))
+				require.Len(t, campaign.DomainEvents(), 1)
+				assert.Equal(t, "campaign.created", campaign.DomainEvents()[0].EventType())
+			}
+		})
+	}
+}
+
+func TestNewCampaign_DedupesSelection(t *testing.T) {
+	campaign := createCampaign(t, 20, domain.CampaignSelection{
+		ProductIDs: []string{"p1", "", "p2", "p1"},
+		Categories: []string{"Books", "Books"},
+	})
+
+	assert.Equal(t, []string{"p1", "p2"}, campaign.Selection().ProductIDs)
+	assert.Equal(t, []string{"Books"}, campaign.Selection().Categories)
+}
+
+func TestCampaign_Status(t *testing.T) {
+	now := time.Now()
+	discount, _ := domain.NewDiscount(20, now.Add(24*time.Hour), now.Add(48*time.Hour))
+	campaign, err := domain.NewCampaign("campaign-id", "Weekend", discount, domain.CampaignSelection{Categories: []string{"Books"}}, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.CampaignStatusScheduled, campaign.Status(now))
+	assert.Equal(t, domain.CampaignStatusRunning, campaign.Status(now.Add(24*time.Hour)))
+	assert.Equal(t, domain.CampaignStatusRunning, campaign.Status(now.Add(48*time.Hour)))
+	assert.Equal(t, domain.CampaignStatusEnded, campaign.Status(now.Add(49*time.Hour)))
+}
+
+func TestCampaign_End(t *testing.T) {
+	now := time.Now()
+	campaign := createCampaign(t, 20, domain.CampaignSelection{Categories: []string{"Books"}})
+
+	err := campaign.End(now)
+
+	require.NoError(t, err)
+	require.NotNil(t, campaign.EndedAt())
+	assert.Equal(t, domain.CampaignStatusEnded, campaign.Status(now))
+	assert.False(t, campaign.IsRunningAt(now))
+	assert.True(t, campaign.IsRunningAt(now.Add(-time.Second)))
+	require.Len(t, campaign.DomainEvents(), 2)
+	assert.Equal(t, "campaign.ended", campaign.DomainEvents()[1].EventType())
+}
+
+func TestCampaign_EndTwice(t *testing.T) {
+	now := time.Now()
+	campaign := createCampaign(t, 20, domain.CampaignSelection{Categories: []string{"Books"}})
+	require.NoError(t, campaign.End(now))
+
+	err := campaign.End(now.Add(time.Minute))
+
+	assert.ErrorIs(t, err, domain.ErrCampaignAlreadyEnded)
+}
+
+func TestCampaign_EndScheduled(t *testing.T) {
+	now := time.Now()
+	discount, _ := domain.NewDiscount(20, now.Add(24*time.Hour), now.Add(48*time.Hour))
+	campaign, err := domain.NewCampaign("campaign-id", "Weekend", discount, domain.CampaignSelection{Categories: []string{"Books"}}, now)
+	require.NoError(t, err)
+
+	require.NoError(t, campaign.End(now))
+
+	// A cancelled campaign never runs
+	assert.Equal(t, domain.CampaignStatusEnded, campaign.Status(now.Add(36*time.Hour)))
+	assert.False(t, campaign.IsRunningAt(now.Add(36*time.Hour)))
+}
+
+func TestBestCampaign(t *testing.T) {
+	now := time.Now()
+	byID := createCampaign(t, 10, domain.CampaignSelection{ProductIDs: []string{"test-id"}})
+	byCategory := createCampaign(t, 30, domain.CampaignSelection{Categories: []string{"Category"}})
+	other := createCampaign(t, 50, domain.CampaignSelection{Categories: []string{"Books"}})
+
+	best := domain.BestCampaign([]*domain.Campaign{byID, byCategory, other}, "test-id", "Category", now)
+	assert.Same(t, byCategory, best)
+
+	require.NoError(t, byCategory.End(now))
+	best = domain.BestCampaign([]*domain.Campaign{byID, byCategory, other}, "test-id", "Category", now)
+	assert.Same(t, byID, best)
+
+	assert.Nil(t, domain.BestCampaign([]*domain.Campaign{other}, "test-id", "Category", now))
+}
+
+func TestProduct_EffectivePriceWithCampaigns(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(10000, 100) // $100.00
+	product, _ := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
+	product.Activate(lifecycle, nil, now)
+
+	// A campaign selecting the product's category applies
+	campaign := createCampaign(t, 25, domain.CampaignSelection{Categories: []string{"Category"}})
+	assert.Equal(t, "75.00", product.EffectivePrice(now, campaign).String())
+
+	// The product's own discount wins when it is higher; they do not stack
+	discount, _ := domain.NewDiscount(40, now, now.Add(7*24*time.Hour))
+	product.ApplyDiscount(discount, now)
+	assert.Equal(t, "60.00", product.EffectivePrice(now, campaign).String())
+
+	// And the campaign wins when it is higher
+	bigger := createCampaign(t, 50, domain.CampaignSelection{ProductIDs: []string{"test-id"}})
+	assert.Equal(t, "50.00", product.EffectivePrice(now, campaign, bigger).String())
+
+	// Campaigns that do not select the product are ignored
+	other := createCampaign(t, 90, domain.CampaignSelection{Categories: []string{"Books"}})
+	assert.Equal(t, "60.00", product.EffectivePrice(now, other).String())
+}
+
+func createCampaign(t *testing.T, percentage int64, selection domain.CampaignSelection) *domain.Campaign {
+	now := time.Now()
+	discount, err := domain.NewDiscount(percentage, now.Add(-time.Hour), now.Add(7*24*time.Hour))
+	require.NoError(t, err)
+	campaign, err := domain.NewCampaign("campaign-id", "Campaign", discount, selection, now)
+	require.NoError(t, err)
+	return campaign
+}
@@ -0,0 +1,286 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxCategorySlugLength is the maximum allowed length for category slugs.
+const MaxCategorySlugLength = 100
+
+// NormalizeCategorySlug returns the canonical form of a category slug. Slugs
+// are case-insensitive, so "Home-Garden" and "home-garden" are the same.
+func NormalizeCategorySlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+// Category is the aggregate root for a node of the category tree. Products
+// assigned to a category reference it by ID and carry its display name as
+// their category, so filters and promotions by category name keep working.
+// A category without a parent is a root.
+type Category struct {
+	id          string
+	slug        string
+	parentID    string
+	displayName string
+	createdAt   time.Time
+	updatedAt   time.Time
+
+	events []DomainEvent
+	isNew  bool
+}
+
+// NewCategory creates a new category under the given parent, or a root when
+// parentID is empty. The caller checks the parent exists.
+func NewCategory(id, slug, displayName, parentID string, now time.Time) (*Category, error) {
+	slug = NormalizeCategorySlug(slug)
+	if err := validateCategorySlug(slug); err != nil {
+		return nil, err
+	}
+	if displayName == "" {
+		return nil, ErrEmptyCategoryDisplayName
+	}
+	if len(displayName) > MaxCategoryLength {
+		return nil, ErrCategoryDisplayNameTooLong
+	}
+
+	c := &Category{
+		id:          id,
+		slug:        slug,
+		parentID:    parentID,
+		displayName: displayName,
+		createdAt:   now,
+		updatedAt:   now,
+		events:      make([]DomainEvent, 0),
+		isNew:       true,
+	}
+
+	c.events = append(c.events, NewCategoryCreatedEvent(id, slug, parentID, displayName, now))
+
+	return c, nil
+}
+
+// ReconstituteCategory recreates a category from persistence without triggering events.
+func ReconstituteCategory(id, slug, parentID, displayName string, createdAt, updatedAt time.Time) *Category {
+	return &Category{
+		id:          id,
+		slug:        slug,
+		parentID:    parentID,
+		displayName: displayName,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		events:      make([]DomainEvent, 0),
+		isNew:       false,
+	}
+}
+
+// ID returns the category ID.
+func (c *Category) ID() string {
+	return c.id
+}
+
+// Slug returns the normalized, URL-safe category slug.
+func (c *Category) Slug() string {
+	return c.slug
+}
+
+// ParentID returns the parent category ID, or empty for a root.
+func (c *Category) ParentID() string {
+	return c.parentID
+}
+
+// DisplayName returns the name products in the category carry.
+func (c *Category) DisplayName() string {
+	return c.displayName
+}
+
+// CreatedAt returns the creation timestamp.
+func (c *Category) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns the last update timestamp.
+func (c *Category) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// IsNew returns true if this is a new category not yet persisted.
+func (c *Category) IsNew() bool {
+	return c.isNew
+}
+
+// DomainEvents returns all domain events captured by this aggregate.
+func (c *Category) DomainEvents() []DomainEvent {
+	return c.events
+}
+
+// CategoryUpdate holds the category details to change. Nil fields are left
+// as they are; an empty ParentID makes the category a root. The display name
+// is changed by Rename, because the products carrying it must follow.
+type CategoryUpdate struct {
+	Slug     *string
+	ParentID *string
+}
+
+// Update changes the slug and parent of the category. parentPath lists the
+// new parent followed by its ancestors up to the root; it is only consulted
+// when the parent changes, to refuse moving a category under itself.
+func (c *Category) Update(update CategoryUpdate, parentPath []string, now time.Time) error {
+	var slug string
+	if update.Slug != nil {
+		slug = NormalizeCategorySlug(*update.Slug)
+		if err := validateCategorySlug(slug); err != nil {
+			return err
+		}
+	}
+	if update.ParentID != nil && *update.ParentID != c.parentID {
+		for _, id := range parentPath {
+			if id == c.id {
+				return ErrCategoryCycle
+			}
+		}
+	}
+
+	changed := false
+
+	if update.Slug != nil && slug != c.slug {
+		c.slug = slug
+		changed = true
+	}
+
+	if update.ParentID != nil && *update.ParentID != c.parentID {
+		c.parentID = *update.ParentID
+		changed = true
+	}
+
+	if changed {
+		c.updatedAt = now
+		c.events = append(c.events, NewCategoryUpdatedEvent(c.id, c.slug, c.parentID, now))
+	}
+
+	return nil
+}
+
+// Delete removes the category from the tree. Only a leaf no product is
+// assigned to can be deleted. moving reports whether a running bulk
+// operation still moves products into or out of the category: they would be
+// left pointing at a deleted category.
+func (c *Category) Delete(hasChildren, hasProducts, moving bool, now time.Time) error {
+	if hasChildren {
+		return ErrCategoryHasChildren
+	}
+	if hasProducts {
+		return ErrCategoryInUse
+	}
+	if moving {
+		return ErrCategoryMoveInProgress
+	}
+
+	c.events = append(c.events, NewCategoryDeletedEvent(c.id, c.slug, now))
+
+	return nil
+}
+
+// CategoryPromotions lists the campaigns and coupons, not over yet, that
+// select products by a category's display name. Campaigns and coupons match
+// the product's category name, so they would stop applying to the products
+// given another name.
+type CategoryPromotions struct {
+	CampaignIDs []string
+	CouponCodes []string
+}
+
+// check returns ErrCategoryInPromotion naming the promotions, if any.
+func (p CategoryPromotions) check() error {
+	var names []string
+	if len(p.CampaignIDs) > 0 {
+		names = append(names, "campaigns "+strings.Join(p.CampaignIDs, ", "))
+	}
+	if len(p.CouponCodes) > 0 {
+		names = append(names, "coupons "+strings.Join(p.CouponCodes, ", "))
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrCategoryInPromotion, strings.Join(names, "; "))
+}
+
+// Rename changes the display name of the category. Renaming to the current
+// name is a no-op. The products assigned to the category still carry the old
+// name until they are rewritten, so the rename is refused while promotions
+// select products by the old name.
+func (c *Category) Rename(displayName string, promotions CategoryPromotions, now time.Time) error {
+	if displayName == "" {
+		return ErrEmptyCategoryDisplayName
+	}
+	if len(displayName) > MaxCategoryLength {
+		return ErrCategoryDisplayNameTooLong
+	}
+	if displayName == c.displayName {
+		return nil
+	}
+	if err := promotions.check(); err != nil {
+		return err
+	}
+
+	old := c.displayName
+	c.displayName = displayName
+	c.updatedAt = now
+	c.events = append(c.events, NewCategoryRenamedEvent(c.id, old, displayName, now))
+
+	return nil
+}
+
+// MergeInto removes the category from the tree in favour of target.
+// targetPath lists target followed by its ancestors up to the root; merging
+// into a descendant is refused because the category's children move under
+// target. The caller moves the children and the products. As for Delete,
+// moving refuses the merge while a running bulk operation still moves
+// products into or out of the category, so a chain of merges cannot strand
+// them. As for Rename, promotions selecting products by the category's name
+// refuse the merge unless target has the same name.
+func (c *Category) MergeInto(target *Category, targetPath []string, moving bool, promotions CategoryPromotions, now time.Time) error {
+	if target.ID() == c.id {
+		return ErrCategoryMergeIntoSelf
+	}
+	for _, id := range targetPath {
+		if id == c.id {
+			return ErrCategoryMergeIntoChild
+		}
+	}
+	if moving {
+		return ErrCategoryMoveInProgress
+	}
+	if target.DisplayName() != c.displayName {
+		if err := promotions.check(); err != nil {
+			return err
+		}
+	}
+
+	c.events = append(c.events, NewCategoryMergedEvent(c.id, c.slug, target.ID(), now))
+
+	return nil
+}
+
+// validateCategorySlug checks a normalized category slug: lowercase words of
+// letters and digits joined by single hyphens, like "home-garden".
+func validateCategorySlug(slug string) error {
+	if slug == "" {
+		return ErrEmptyCategorySlug
+	}
+	if len(slug) > MaxCategorySlugLength {
+		return ErrCategorySlugTooLong
+	}
+	for _, word := range strings.Split(slug, "-") {
+		if word == "" {
+			return ErrInvalidCategorySlug
+		}
+		for _, r := range word {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+				return ErrInvalidCategorySlug
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+func TestNewCategory(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		slug        string
+		displayName string
+		wantSlug    string
+		wantErr     error
+	}{
+		{
+			name:        "valid root",
+			slug:        "home-garden",
+			displayName: "Home & Garden",
+			wantSlug:    "home-garden",
+		},
+		{
+			name:        "slug is normalized",
+			slug:        "  Home-Garden2 ",
+			displayName: "Home & Garden",
+			wantSlug:    "home-garden2",
+		},
+		{
+			name:        "empty slug",
+			slug:        " ",
+			displayName: "Home & Garden",
+			wantErr:     domain.ErrEmptyCategorySlug,
+		},
+		{
+			name:        "slug too long",
+			slug:        strings.Repeat("a", domain.MaxCategorySlugLength+1),
+			displayName: "Home & Garden",
+			wantErr:     domain.ErrCategorySlugTooLong,
+		},
+		{
+			name:        "slug with space",
+			slug:        "home garden",
+			displayName: "Home & Garden",
+			wantErr:     domain.ErrInvalidCategorySlug,
+		},
+		{
+			name:        "slug with double hyphen",
+			slug:        "home--garden",
+			displayName: "Home & Garden",
+			wantErr:     domain.ErrInvalidCategorySlug,
+		},
+		{
+			name:        "slug with trailing hyphen",
+			slug:        "home-",
+			displayName: "Home & Garden",
+			wantErr:     domain.ErrInvalidCategorySlug,
+		},
+		{
+			name:        "empty display name",
+			slug:        "home-garden",
+			displayName: "",
+			wantErr:     domain.ErrEmptyCategoryDisplayName,
+		},
+		{
+			name:        "display name too long",
+			slug:        "home-garden",
+			displayName: strings.Repeat("a", domain.MaxCategoryLength+1),
+			wantErr:     domain.ErrCategoryDisplayNameTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category, err := domain.NewCategory("cat-1", tt.slug, tt.displayName, "", now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, category)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSlug, category.Slug())
+			assert.Equal(t, tt.displayName, category.DisplayName())
+			assert.Empty(t, category.ParentID())
+			assert.True(t, category.IsNew())
+
+			events := category.DomainEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, "category.created", events[0].EventType())
+		})
+	}
+}
+
+func TestCategory_Update(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	now := time.Now()
+	newSlug := "outdoor"
+	newParent := "cat-parent"
+	root := ""
+
+	t.Run("changes slug and parent", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+
+		err := category.Update(domain.CategoryUpdate{Slug: &newSlug, ParentID: &newParent}, []string{newParent}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "outdoor", category.Slug())
+		assert.Equal(t, newParent, category.ParentID())
+		assert.Equal(t, now, category.UpdatedAt())
+		events := category.DomainEvents()
+		require.Len(t, events, 1)
+		updated := events[0].(*domain.CategoryUpdatedEvent)
+		assert.Equal(t, "outdoor", updated.Slug)
+		assert.Equal(t, newParent, updated.ParentID)
+	})
+
+	t.Run("moving to the root clears the parent", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "cat-parent", "Garden", created, created)
+
+		err := category.Update(domain.CategoryUpdate{ParentID: &root}, nil, now)
+
+		require.NoError(t, err)
+		assert.Empty(t, category.ParentID())
+		assert.Len(t, category.DomainEvents(), 1)
+	})
+
+	t.Run("unchanged values emit no event", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+		slug := "Garden"
+
+		err := category.Update(domain.CategoryUpdate{Slug: &slug, ParentID: &root}, nil, now)
+
+		require.NoError(t, err)
+		assert.Empty(t, category.DomainEvents())
+		assert.Equal(t, created, category.UpdatedAt())
+	})
+
+	t.Run("cannot move under a descendant", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+		child := "cat-child"
+
+		err := category.Update(domain.CategoryUpdate{ParentID: &child}, []string{child, "cat-1"}, now)
+
+		assert.ErrorIs(t, err, domain.ErrCategoryCycle)
+		assert.Empty(t, category.ParentID())
+		assert.Empty(t, category.DomainEvents())
+	})
+
+	t.Run("cannot move under itself", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+		self := "cat-1"
+
+		err := category.Update(domain.CategoryUpdate{ParentID: &self}, []string{self}, now)
+
+		assert.ErrorIs(t, err, domain.ErrCategoryCycle)
+	})
+
+	t.Run("invalid slug", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+		slug := "out door"
+
+		err := category.Update(domain.CategoryUpdate{Slug: &slug}, nil, now)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidCategorySlug)
+		assert.Equal(t, "garden", category.Slug())
+	})
+}
+
+func TestCategory_Delete(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		hasChildren bool
+		hasProducts bool
+		moving      bool
+		wantErr     error
+	}{
+		{name: "leaf without products"},
+		{name: "has children", hasChildren: true, wantErr: domain.ErrCategoryHasChildren},
+		{name: "has products", hasProducts: true, wantErr: domain.ErrCategoryInUse},
+		{name: "products still moving", moving: true, wantErr: domain.ErrCategoryMoveInProgress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+
+			err := category.Delete(tt.hasChildren, tt.hasProducts, tt.moving, now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, category.DomainEvents())
+				return
+			}
+			require.NoError(t, err)
+			events := category.DomainEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, "category.deleted", events[0].EventType())
+		})
+	}
+}
+
+func TestCategory_Rename(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	now := time.Now()
+
+	t.Run("renames and records the old name", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+
+		err := category.Rename("Garden & Patio", domain.CategoryPromotions{}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Garden & Patio", category.DisplayName())
+		assert.Equal(t, now, category.UpdatedAt())
+		events := category.DomainEvents()
+		require.Len(t, events, 1)
+		renamed := events[0].(*domain.CategoryRenamedEvent)
+		assert.Equal(t, "Garden", renamed.OldDisplayName)
+		assert.Equal(t, "Garden & Patio", renamed.DisplayName)
+	})
+
+	t.Run("same name is a no-op", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+
+		require.NoError(t, category.Rename("Garden", domain.CategoryPromotions{CampaignIDs: []string{"camp-1"}}, now))
+		assert.Empty(t, category.DomainEvents())
+		assert.Equal(t, created, category.UpdatedAt())
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+
+		assert.ErrorIs(t, category.Rename("", domain.CategoryPromotions{}, now), domain.ErrEmptyCategoryDisplayName)
+	})
+
+	t.Run("promotions selecting the old name", func(t *testing.T) {
+		category := domain.ReconstituteCategory("cat-1", "garden", "", "Garden", created, created)
+		promotions := domain.CategoryPromotions{
+			CampaignIDs: []string{"camp-1"},
+			CouponCodes: []string{"SPRING10"},
+		}
+
+		err := category.Rename("Yard", promotions, now)
+
+		assert.ErrorIs(t, err, domain.ErrCategoryInPromotion)
+		assert.Contains(t, err.Error(), "camp-1")
+		assert.Contains(t, err.Error(), "SPRING10")
+		assert.Equal(t, "Garden", category.DisplayName())
+		assert.Empty(t, category.DomainEvents())
+	})
+}
+
+func TestCategory_MergeInto(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	now := time.Now()
+	source := func() *domain.Category {
+		return domain.ReconstituteCategory("cat-src", "patio", "cat-root", "Patio", created, created)
+	}
+
+	t.Run("merges into a sibling", func(t *testing.T) {
+		category := source()
+		target := domain.ReconstituteCategory("cat-dst", "garden", "cat-root", "Garden", created, created)
+
+		err := category.MergeInto(target, []string{"cat-dst", "cat-root"}, false, domain.CategoryPromotions{}, now)
+
+		require.NoError(t, err)
+		events := category.DomainEvents()
+		require.Len(t, events, 1)
+		merged := events[0].(*domain.CategoryMergedEvent)
+		assert.Equal(t, "category.merged", merged.EventType())
+		assert.Equal(t, "cat-dst", merged.TargetID)
+	})
+
+	t.Run("cannot merge into itself", func(t *testing.T) {
+		category := source()
+
+		err := category.MergeInto(category, []string{"cat-src", "cat-root"}, false, domain.CategoryPromotions{}, now)
+
+		assert.ErrorIs(t, err, domain.ErrCategoryMergeIntoSelf)
+	})
+
+	t.Run("cannot merge into a descendant", func(t *testing.T) {
+		category := source()
+		child := domain.ReconstituteCategory("cat-child", "decking", "cat-src", "Decking", created, created)
+
+		err := category.MergeInto(child, []string{"cat-child", "cat-src", "cat-root"}, false, domain.CategoryPromotions{}, now)
+
+		assert.ErrorIs(t, err, domain.ErrCategoryMergeIntoChild)
+		assert.Empty(t, category.DomainEvents())
+	})
+
+	t.Run("cannot merge while products are still moving", func(t *testing.T) {
+		category := source()
+		target := domain.ReconstituteCategory("cat-dst", "garden", "cat-root", "Garden", created, created)
+
+		err := category.MergeInto(target, []string{"cat-dst", "cat-root"}, true, domain.CategoryPromotions{}, now)
+
+		assert.ErrorIs(t, err, domain.ErrCategoryMoveInProgress)
+		assert.Empty(t, category.DomainEvents())
+	})
+
+	t.Run("promotions block a merge that changes the products' name", func(t *testing.T) {
+		promotions := domain.CategoryPromotions{CouponCodes: []string{"PATIO5"}}
+		garden := domain.ReconstituteCategory("cat-dst", "garden", "cat-root", "Garden", created, created)
+		samePatio := domain.ReconstituteCategory("cat-dst", "patio-2", "cat-root", "Patio", created, created)
+
+		err := source().MergeInto(garden, []string{"cat-dst", "cat-root"}, false, promotions, now)
+		assert.ErrorIs(t, err, domain.ErrCategoryInPromotion)
+
+		err = source().MergeInto(samePatio, []string{"cat-dst", "cat-root"}, false, promotions, now)
+		assert.NoError(t, err, "the products keep their name")
+	})
+}
+
+func TestProduct_CategoryAssignment(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+	garden, err := domain.NewCategory("cat-garden", "garden", "Garden", "", now)
+	require.NoError(t, err)
+
+	t.Run("new product in a category carries its display name", func(t *testing.T) {
+		product, err := domain.NewProductInCategory("prod-1", "Rake", "", garden, basePrice, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Garden", product.Category())
+		assert.Equal(t, "cat-garden", product.CategoryID())
+		created := product.DomainEvents()[0].(*domain.ProductCreatedEvent)
+		assert.Equal(t, "cat-garden", created.CategoryID)
+	})
+
+	t.Run("assigning a category takes precedence over a free-form one", func(t *testing.T) {
+		product := createActiveProduct(t)
+		other := "Other"
+
+		err := product.Update(domain.ProductUpdate{Category: &other, AssignedCategory: garden}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Garden", product.Category())
+		assert.Equal(t, "cat-garden", product.CategoryID())
+		assert.True(t, product.Changes().Dirty(domain.FieldCategoryID))
+	})
+
+	t.Run("a new free-form category detaches the product", func(t *testing.T) {
+		product, err := domain.NewProductInCategory("prod-1", "Rake", "", garden, basePrice, now)
+		require.NoError(t, err)
+		tools := "Tools"
+
+		err = product.Update(domain.ProductUpdate{Category: &tools}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Tools", product.Category())
+		assert.Empty(t, product.CategoryID())
+	})
+
+	t.Run("the same free-form name keeps the assignment", func(t *testing.T) {
+		product, err := domain.NewProductInCategory("prod-1", "Rake", "", garden, basePrice, now)
+		require.NoError(t, err)
+		same := "Garden"
+
+		err = product.Update(domain.ProductUpdate{Category: &same}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "cat-garden", product.CategoryID())
+	})
+}
@@ -0,0 +1,330 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxCouponCodeLength is the maximum allowed length for coupon codes.
+const MaxCouponCodeLength = 64
+
+// MaxCouponEligibilitySize is the maximum number of product IDs, and
+// separately of categories, a coupon can be limited to.
+const MaxCouponEligibilitySize = 1000
+
+// MaxOrderIDLength is the maximum allowed length for the order a coupon is
+// redeemed for.
+const MaxOrderIDLength = 100
+
+// NormalizeCouponCode returns the canonical form of a coupon code. Codes are
+// case-insensitive, so "spring20" and "SPRING20" are the same coupon.
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CouponRule is what a coupon takes off the price of each eligible product:
+// either a percentage or a fixed amount.
+type CouponRule struct {
+	Percentage int64
+	AmountOff  *Money
+}
+
+// IsFixed returns true if the rule takes a fixed amount off.
+func (r CouponRule) IsFixed() bool {
+	return r.AmountOff != nil
+}
+
+// Apply returns price with the rule applied. A fixed amount never takes the
+// price below zero.
+func (r CouponRule) Apply(price *Money) *Money {
+	if !r.IsFixed() {
+		return price.SubtractPercentage(r.Percentage)
+	}
+	discounted, err := price.Subtract(r.AmountOff)
+	if err != nil {
+		return Zero()
+	}
+	return discounted
+}
+
+// CouponEligibility limits a coupon to the listed products plus every product
+// in one of the listed categories. When both are empty the coupon applies to
+// the whole catalog.
+type CouponEligibility struct {
+	ProductIDs []string
+	Categories []string
+}
+
+// Includes returns true if the given product is eligible.
+func (e CouponEligibility) Includes(productID, category string) bool {
+	if len(e.ProductIDs) == 0 && len(e.Categories) == 0 {
+		return true
+	}
+	for _, id := range e.ProductIDs {
+		if id == productID {
+			return true
+		}
+	}
+	for _, cat := range e.Categories {
+		if cat == category {
+			return true
+		}
+	}
+	return false
+}
+
+// CouponRedemption records a coupon being used for an order.
+type CouponRedemption struct {
+	OrderID    string
+	ProductIDs []string
+	RedeemedAt time.Time
+}
+
+// Coupon is the aggregate root for a code customers redeem at checkout. It
+// is valid for an inclusive time window and, when maxRedemptions is
+// positive, for that many orders.
+type Coupon struct {
+	id              string
+	code            string
+	rule            CouponRule
+	eligibility     CouponEligibility
+	startDate       time.Time
+	endDate         time.Time
+	maxRedemptions  int64
+	redemptionCount int64
+	createdAt       time.Time
+	updatedAt       time.Time
+
+	events []DomainEvent
+	isNew  bool
+}
+
+// NewCoupon creates a new coupon. The code is normalized, and the window must
+// not already be over. A maxRedemptions of zero means unlimited.
+func NewCoupon(
+	id, code string,
+	rule CouponRule,
+	eligibility CouponEligibility,
+	startDate, endDate time.Time,
+	maxRedemptions int64,
+	now time.Time,
+) (*Coupon, error) {
+	code = NormalizeCouponCode(code)
+	if err := validateCouponCode(code); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case rule.IsFixed() && rule.Percentage != 0, rule.IsFixed() && !rule.AmountOff.IsPositive():
+		return nil, ErrInvalidCouponRule
+	case !rule.IsFixed() && rule.Percentage == 0:
+		return nil, ErrInvalidCouponRule
+	case !rule.IsFixed() && (rule.Percentage < 1 || rule.Percentage > 100):
+		return nil, ErrInvalidDiscountPercentage
+	}
+
+	if !endDate.After(startDate) {
+		return nil, ErrInvalidCouponPeriod
+	}
+	if now.After(endDate) {
+		return nil, ErrCouponExpired
+	}
+	if maxRedemptions < 0 {
+		return nil, ErrInvalidMaxRedemptions
+	}
+
+	eligibility = CouponEligibility{
+		ProductIDs: Dedupe(eligibility.ProductIDs),
+		Categories: Dedupe(eligibility.Categories),
+	}
+	if len(eligibility.ProductIDs) > MaxCouponEligibilitySize || len(eligibility.Categories) > MaxCouponEligibilitySize {
+		return nil, ErrCouponEligibilityTooLarge
+	}
+	for _, category := range eligibility.Categories {
+		if len(category) > MaxCategoryLength {
+			return nil, ErrCategoryTooLong
+		}
+	}
+
+	c := &Coupon{
+		id:             id,
+		code:           code,
+		rule:           rule,
+		eligibility:    eligibility,
+		startDate:      startDate,
+		endDate:        endDate,
+		maxRedemptions: maxRedemptions,
+		createdAt:      now,
+		updatedAt:      now,
+		events:         make([]DomainEvent, 0),
+		isNew:          true,
+	}
+
+	c.events = append(c.events, NewCouponCreatedEvent(
+		id,
+		code,
+		rule,
+		eligibility,
+		startDate,
+		endDate,
+		maxRedemptions,
+		now,
+	))
+
+	return c, nil
+}
+
+// ReconstituteCoupon recreates a coupon from persistence without triggering events.
+func ReconstituteCoupon(
+	id, code string,
+	rule CouponRule,
+	eligibility CouponEligibility,
+	startDate, endDate time.Time,
+	maxRedemptions, redemptionCount int64,
+	createdAt, updatedAt time.Time,
+) *Coupon {
+	return &Coupon{
+		id:              id,
+		code:            code,
+		rule:            rule,
+		eligibility:     eligibility,
+		startDate:       startDate,
+		endDate:         endDate,
+		maxRedemptions:  maxRedemptions,
+		redemptionCount: redemptionCount,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+		events:          make([]DomainEvent, 0),
+		isNew:           false,
+	}
+}
+
+// ID returns the coupon ID.
+func (c *Coupon) ID() string {
+	return c.id
+}
+
+// Code returns the normalized coupon code.
+func (c *Coupon) Code() string {
+	return c.code
+}
+
+// Rule returns what the coupon takes off an eligible product's price.
+func (c *Coupon) Rule() CouponRule {
+	return c.rule
+}
+
+// Eligibility returns the products and categories the coupon is limited to.
+func (c *Coupon) Eligibility() CouponEligibility {
+	return c.eligibility
+}
+
+// StartDate returns when the coupon becomes valid.
+func (c *Coupon) StartDate() time.Time {
+	return c.startDate
+}
+
+// EndDate returns the last moment the coupon is valid.
+func (c *Coupon) EndDate() time.Time {
+	return c.endDate
+}
+
+// MaxRedemptions returns how many orders may redeem the coupon, or zero for
+// no limit.
+func (c *Coupon) MaxRedemptions() int64 {
+	return c.maxRedemptions
+}
+
+// RedemptionCount returns how many orders have redeemed the coupon.
+func (c *Coupon) RedemptionCount() int64 {
+	return c.redemptionCount
+}
+
+// CreatedAt returns the creation timestamp.
+func (c *Coupon) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns the last update timestamp.
+func (c *Coupon) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// IsNew returns true if this is a new coupon not yet persisted.
+func (c *Coupon) IsNew() bool {
+	return c.isNew
+}
+
+// DomainEvents returns all domain events captured by this aggregate.
+func (c *Coupon) DomainEvents() []DomainEvent {
+	return c.events
+}
+
+// CheckRedeemable returns why the coupon cannot be used at now, or nil.
+func (c *Coupon) CheckRedeemable(now time.Time) error {
+	switch {
+	case now.Before(c.startDate):
+		return ErrCouponNotStarted
+	case now.After(c.endDate):
+		return ErrCouponExpired
+	case c.maxRedemptions > 0 && c.redemptionCount >= c.maxRedemptions:
+		return ErrCouponExhausted
+	}
+	return nil
+}
+
+// CheckApplicable returns why the coupon cannot be used on the product, or
+// nil. Only active products can be bought.
+func (c *Coupon) CheckApplicable(p *Product) error {
+	if !p.IsActive() {
+		return ErrProductNotActive
+	}
+	if !c.eligibility.Includes(p.ID(), p.Category()) {
+		return ErrCouponNotApplicable
+	}
+	return nil
+}
+
+// Redeem uses the coupon for an order covering the given eligible products,
+// of which there must be at least one.
+func (c *Coupon) Redeem(orderID string, productIDs []string, now time.Time) (CouponRedemption, error) {
+	if orderID == "" {
+		return CouponRedemption{}, ErrEmptyOrderID
+	}
+	if len(orderID) > MaxOrderIDLength {
+		return CouponRedemption{}, ErrOrderIDTooLong
+	}
+	if err := c.CheckRedeemable(now); err != nil {
+		return CouponRedemption{}, err
+	}
+	productIDs = Dedupe(productIDs)
+	if len(productIDs) == 0 {
+		return CouponRedemption{}, ErrCouponNotApplicable
+	}
+
+	c.redemptionCount++
+	c.updatedAt = now
+	c.events = append(c.events, NewCouponRedeemedEvent(c.id, c.code, orderID, productIDs, c.redemptionCount, now))
+
+	return CouponRedemption{
+		OrderID:    orderID,
+		ProductIDs: productIDs,
+		RedeemedAt: now,
+	}, nil
+}
+
+// validateCouponCode checks a normalized coupon code.
+func validateCouponCode(code string) error {
+	if code == "" {
+		return ErrEmptyCouponCode
+	}
+	if len(code) > MaxCouponCodeLength {
+		return ErrCouponCodeTooLong
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return ErrInvalidCouponCode
+		}
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+func TestNewCoupon(t *testing.T) {
+	now := time.Now()
+	start, end := now.Add(-time.Hour), now.Add(7*24*time.Hour)
+	fiveOff, _ := domain.NewMoney(500, 100)
+
+	tests := []struct {
+		name    string
+		code    string
+		rule    domain.CouponRule
+		start   time.Time
+		end     time.Time
+		max     int64
+		wantErr error
+	}{
+		{
+			name:  "percentage coupon",
+			code:  "spring20",
+			rule:  domain.CouponRule{Percentage: 20},
+			start: start,
+			end:   end,
+		},
+		{
+			name:  "fixed amount coupon",
+			code:  "FIVE-OFF",
+			rule:  domain.CouponRule{AmountOff: fiveOff},
+			start: start,
+			end:   end,
+			max:   100,
+		},
+		{
+			name:    "empty code",
+			code:    "  ",
+			rule:    domain.CouponRule{Percentage: 20},
+			start:   start,
+			end:     end,
+			wantErr: domain.ErrEmptyCouponCode,
+		},
+		{
+			name:    "code too long",
+			code:    strings.Repeat("A", domain.MaxCouponCodeLength+1),
+			rule:    domain.CouponRule{Percentage: 20},
+			start:   start,
+			end:     end,
+			wantErr: domain.ErrCouponCodeTooLong,
+		},
+		{
+			name:    "invalid characters",
+			code:    "SPRING 20",
+			rule:    domain.CouponRule{Percentage: 20},
+			start:   start,
+			end:     end,
+			wantErr: domain.ErrInvalidCouponCode,
+		},
+		{
+			name:    "no rule",
+			code:    "SPRING20",
+			start:   start,
+			end:     end,
+			wantErr: domain.ErrInvalidCouponRule,
+		},
+		{
+			name:    "percentage and amount",
+			code:    "SPRING20",
+			rule:    domain.CouponRule{Percentage: 20, AmountOff: fiveOff},
+			start:   start,
+			end:     end,
+			wantErr: domain.ErrInvalidCouponRule,
+		},
+		{
+			name:    "zero amount",
+			code:    "SPRING20",
+			rule:    domain.CouponRule{AmountOff: domain.Zero()},
+			start:   start,
+			end:     end,
+			wantErr: domain.ErrInvalidCouponRule,
+		},
+		{
+			name:    "percentage over 100",
+			code:    "SPRING20",
+			rule:    domain.CouponRule{Percentage: 101},
+			start:   start,
+			end:     end,
+			wantErr: domain.ErrInvalidDiscountPercentage,
+		},
+		{
+			name:    "end before start",
+			code:    "SPRING20",
+			rule:    domain.CouponRule{Percentage: 20},
+			start:   end,
+			end:     start,
+			wantErr: domain.ErrInvalidCouponPeriod,
+		},
+		{
+			name:    "window already over",
+			code:    "SPRING20",
+			rule:    domain.CouponRule{Percentage: 20},
+			start:   now.Add(-48 * time.Hour),
+			end:     now.Add(-24 * time.Hour),
+			wantErr: domain.ErrCouponExpired,
+		},
+		{
+			name:    "negative max redemptions",
+			code:    "SPRING20",
+			rule:    domain.CouponRule{Percentage: 20},
+			start:   start,
+			end:     end,
+			max:     -1,
+			wantErr: domain.ErrInvalidMaxRedemptions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coupon, err := domain.NewCoupon("coupon-id", tt.code, tt.rule, domain.CouponEligibility{}, tt.start, tt.end, tt.max, now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, coupon)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, coupon.IsNew())
+				assert.Equal(t, strings.ToUpper(tt.code), coupon.Code())
+				assert.NoError(t, coupon.CheckRedeemable(now))
+				require.Len(t, coupon.DomainEvents(), 1)
+				assert.Equal(t, "coupon.created", coupon.DomainEvents()[0].EventType())
+			}
+		})
+	}
+}
+
+func TestCouponRule_Apply(t *testing.T) {
+	price, _ := domain.NewMoney(1999, 100) // $19.99
+	fiveOff, _ := domain.NewMoney(500, 100)
+	fiftyOff, _ := domain.NewMoney(5000, 100)
+
+	assert.Equal(t, "15.99", domain.CouponRule{Percentage: 20}.Apply(price).String())
+	assert.Equal(t, "14.99", domain.CouponRule{AmountOff: fiveOff}.Apply(price).String())
+
+	// A fixed amount never takes the price below zero
+	assert.True(t, domain.CouponRule{AmountOff: fiftyOff}.Apply(price).IsZero())
+}
+
+func TestCoupon_CheckApplicable(t *testing.T) {
+	product := createActiveProduct(t)
+
+	wholeCatalog := createCoupon(t, domain.CouponEligibility{}, 0)
+	assert.NoError(t, wholeCatalog.CheckApplicable(product))
+
+	byCategory := createCoupon(t, domain.CouponEligibility{Categories: []string{"Category"}}, 0)
+	assert.NoError(t, byCategory.CheckApplicable(product))
+
+	byID := createCoupon(t, domain.CouponEligibility{ProductIDs: []string{"test-id"}}, 0)
+	assert.NoError(t, byID.CheckApplicable(product))
+
+	other := createCoupon(t, domain.CouponEligibility{Categories: []string{"Books"}}, 0)
+	assert.ErrorIs(t, other.CheckApplicable(product), domain.ErrCouponNotApplicable)
+
+	require.NoError(t, product.Deactivate(lifecycle, time.Now()))
+	assert.ErrorIs(t, wholeCatalog.CheckApplicable(product), domain.ErrProductNotActive)
+}
+
+func TestCoupon_Redeem(t *testing.T) {
+	now := time.Now()
+	coupon := createCoupon(t, domain.CouponEligibility{}, 2)
+
+	redemption, err := coupon.Redeem("order-1", []string{"p1", "p2", "p1"}, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, "order-1", redemption.OrderID)
+	assert.Equal(t, []string{"p1", "p2"}, redemption.ProductIDs)
+	assert.Equal(t, int64(1), coupon.RedemptionCount())
+	require.Len(t, coupon.DomainEvents(), 2)
+	assert.Equal(t, "coupon.redeemed", coupon.DomainEvents()[1].EventType())
+}
+
+func TestCoupon_RedeemUntilExhausted(t *testing.T) {
+	now := time.Now()
+	coupon := createCoupon(t, domain.CouponEligibility{}, 2)
+
+	_, err := coupon.Redeem("order-1", []string{"p1"}, now)
+	require.NoError(t, err)
+	_, err = coupon.Redeem("order-2", []string{"p1"}, now)
+	require.NoError(t, err)
+
+	_, err = coupon.Redeem("order-3", []string{"p1"}, now)
+
+	assert.ErrorIs(t, err, domain.ErrCouponExhausted)
+	assert.ErrorIs(t, coupon.CheckRedeemable(now), domain.ErrCouponExhausted)
+	assert.Equal(t, int64(2), coupon.RedemptionCount())
+}
+
+func TestCoupon_RedeemErrors(t *testing.T) {
+	now := time.Now()
+	coupon := createCoupon(t, domain.CouponEligibility{}, 0)
+
+	_, err := coupon.Redeem("", []string{"p1"}, now)
+	assert.ErrorIs(t, err, domain.ErrEmptyOrderID)
+
+	_, err = coupon.Redeem(strings.Repeat("o", domain.MaxOrderIDLength+1), []string{"p1"}, now)
+	assert.ErrorIs(t, err, domain.ErrOrderIDTooLong)
+
+	_, err = coupon.Redeem("order-1", nil, now)
+	assert.ErrorIs(t, err, domain.ErrCouponNotApplicable)
+
+	_, err = coupon.Redeem("order-1", []string{"p1"}, now.Add(-2*time.Hour))
+	assert.ErrorIs(t, err, domain.ErrCouponNotStarted)
+
+	_, err = coupon.Redeem("order-1", []string{"p1"}, now.Add(8*24*time.Hour))
+	assert.ErrorIs(t, err, domain.ErrCouponExpired)
+
+	assert.Zero(t, coupon.RedemptionCount())
+}
+
+func createCoupon(t *testing.T, eligibility domain.CouponEligibility, maxRedemptions int64) *domain.Coupon {
+	now := time.Now()
+	coupon, err := domain.NewCoupon(
+		"coupon-id",
+		"SPRING20",
+		domain.CouponRule{Percentage: 20},
+		eligibility,
+		now.Add(-time.Hour),
+		now.Add(7*24*time.Hour),
+		maxRedemptions,
+		now,
+	)
+	require.NoError(t, err)
+	return coupon
+}
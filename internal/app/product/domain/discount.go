@@ -4,6 +4,20 @@ import (
 	"time"
 )
 
+// DiscountPhase records which discount boundaries have been announced.
+type DiscountPhase string
+
+const (
+	// DiscountPhaseNone means the product has no discount.
+	DiscountPhaseNone DiscountPhase = "none"
+	// DiscountPhasePending means the discount start has not been announced yet.
+	DiscountPhasePending DiscountPhase = "pending"
+	// DiscountPhaseActive means the start was announced and the expiry was not.
+	DiscountPhaseActive DiscountPhase = "active"
+	// DiscountPhaseExpired means the expiry was announced.
+	DiscountPhaseExpired DiscountPhase = "expired"
+)
+
 // Discount represents a percentage-based discount with validity period.
 type Discount struct {
 	percentage int64
@@ -4,6 +4,11 @@
 //   - Product: The aggregate root representing a product in the catalog
 //   - Money: A value object for precise monetary calculations using big.Rat
 //   - Discount: A value object representing percentage-based discounts with validity periods
+//   - Lifecycle: The validated table of allowed product status transitions
+//   - BulkAction: A product command with its arguments, applied to many products by a bulk operation
+//   - Campaign: The aggregate root for a promotion discounting a selection of products for a time window
+//   - Coupon: The aggregate root for a redeemable code taking a percentage or fixed amount off eligible products
+//   - Category: The aggregate root for a node of the category tree products are assigned to
 //   - Domain events: Captured as intents when business state changes
 //   - Domain errors: Sentinel errors representing business rule violations
 //
@@ -12,6 +12,7 @@ var (
 	ErrProductAlreadyActive = errors.New("product is already active")
 	ErrProductArchived      = errors.New("product is archived")
 	ErrProductInactive      = errors.New("product is inactive")
+	ErrVersionMismatch      = errors.New("product was modified concurrently: version does not match")
 
 	// Validation errors
 	ErrEmptyProductName     = errors.New("product name cannot be empty")
@@ -19,6 +20,10 @@ var (
 	ErrInvalidProductStatus = errors.New("invalid product status")
 	ErrProductNameTooLong   = errors.New("product name exceeds maximum length")
 	ErrCategoryTooLong      = errors.New("category exceeds maximum length")
+	ErrExternalKeyTooLong   = errors.New("external key exceeds maximum length")
+	ErrTooManyAttributes    = errors.New("product has too many attributes")
+	ErrInvalidAttributeName = errors.New("attribute name is empty or exceeds maximum length")
+	ErrAttributeTooLong     = errors.New("attribute value exceeds maximum length")
 
 	// Money errors
 	ErrInvalidMoney  = errors.New("invalid money value")
@@ -39,6 +44,68 @@ var (
 	ErrCannotDeactivateArchived = errors.New("cannot deactivate archived product")
 	ErrCannotArchiveActive      = errors.New("must deactivate product before archiving")
 	ErrCannotUpdateArchived     = errors.New("cannot update archived product")
+	ErrProductNotArchived       = errors.New("product is not archived")
+	ErrRestoreWindowExpired     = errors.New("restore retention period has elapsed")
+	ErrPurgeRetentionNotElapsed = errors.New("archive retention period has not elapsed")
+	ErrCannotScheduleArchived   = errors.New("cannot schedule archived product")
+	ErrInvalidStatusTransition  = errors.New("status transition is not allowed by the product lifecycle")
+	ErrProductAlreadyInStatus   = errors.New("product is already in the target status")
+
+	// Lifecycle errors
+	ErrInvalidLifecycle = errors.New("invalid product lifecycle")
+
+	// Activation policy errors
+	ErrActivationPolicyViolated = errors.New("product is not ready for activation")
+
+	// Schedule errors
+	ErrInvalidSchedule = errors.New("unpublish time must be after publish time")
+
+	// Bulk operation errors
+	ErrInvalidBulkCommand    = errors.New("invalid bulk command")
+	ErrBulkOperationNotFound = errors.New("bulk operation not found")
+
+	// Campaign errors
+	ErrCampaignNotFound          = errors.New("campaign not found")
+	ErrEmptyCampaignName         = errors.New("campaign name cannot be empty")
+	ErrCampaignNameTooLong       = errors.New("campaign name exceeds maximum length")
+	ErrEmptyCampaignSelection    = errors.New("campaign must select at least one product or category")
+	ErrCampaignSelectionTooLarge = errors.New("campaign selection exceeds maximum size")
+	ErrCampaignAlreadyEnded      = errors.New("campaign has already ended")
+
+	// Coupon errors
+	ErrCouponNotFound            = errors.New("coupon not found")
+	ErrEmptyCouponCode           = errors.New("coupon code cannot be empty")
+	ErrCouponCodeTooLong         = errors.New("coupon code exceeds maximum length")
+	ErrInvalidCouponCode         = errors.New("coupon code may only contain letters, digits, '-' and '_'")
+	ErrCouponCodeTaken           = errors.New("coupon code is already in use")
+	ErrInvalidCouponRule         = errors.New("coupon needs either a percentage or a positive amount off, not both")
+	ErrInvalidCouponPeriod       = errors.New("coupon end date must be after start date")
+	ErrInvalidMaxRedemptions     = errors.New("max redemptions cannot be negative")
+	ErrCouponEligibilityTooLarge = errors.New("coupon eligibility exceeds maximum size")
+	ErrCouponNotStarted          = errors.New("coupon is not valid yet")
+	ErrCouponExpired             = errors.New("coupon has expired")
+	ErrCouponExhausted           = errors.New("coupon has reached its maximum redemptions")
+	ErrCouponNotApplicable       = errors.New("coupon does not apply to the product")
+	ErrCouponAlreadyRedeemed     = errors.New("coupon has already been redeemed for the order")
+	ErrEmptyOrderID              = errors.New("order ID cannot be empty")
+	ErrOrderIDTooLong            = errors.New("order ID exceeds maximum length")
+
+	// Category errors
+	ErrCategoryNotFound           = errors.New("category not found")
+	ErrCategoryParentNotFound     = errors.New("parent category not found")
+	ErrEmptyCategorySlug          = errors.New("category slug cannot be empty")
+	ErrCategorySlugTooLong        = errors.New("category slug exceeds maximum length")
+	ErrInvalidCategorySlug        = errors.New("category slug may only contain lowercase letters, digits and single '-' between them")
+	ErrCategorySlugTaken          = errors.New("category slug is already in use")
+	ErrEmptyCategoryDisplayName   = errors.New("category display name cannot be empty")
+	ErrCategoryDisplayNameTooLong = errors.New("category display name exceeds maximum length")
+	ErrCategoryCycle              = errors.New("category cannot be moved under itself or one of its descendants")
+	ErrCategoryHasChildren        = errors.New("category has child categories")
+	ErrCategoryInUse              = errors.New("category is assigned to products")
+	ErrCategoryMergeIntoSelf      = errors.New("category cannot be merged into itself")
+	ErrCategoryMergeIntoChild     = errors.New("category cannot be merged into one of its descendants")
+	ErrCategoryMoveInProgress     = errors.New("a running bulk operation is still moving products into or out of the category")
+	ErrCategoryInPromotion        = errors.New("campaigns or coupons select products by the category's display name")
 )
 
 // MaxProductNameLength is the maximum allowed length for product names.
@@ -46,3 +113,14 @@ const MaxProductNameLength = 255
 
 // MaxCategoryLength is the maximum allowed length for categories.
 const MaxCategoryLength = 100
+
+// MaxExternalKeyLength is the maximum allowed length for external keys.
+const MaxExternalKeyLength = 255
+
+// Attribute limits: how many a product may have and the maximum lengths of
+// their names and values.
+const (
+	MaxAttributes           = 50
+	MaxAttributeNameLength  = 64
+	MaxAttributeValueLength = 255
+)
@@ -33,6 +33,11 @@ type ProductCreatedEvent struct {
 	Description string
 	Category    string
 	BasePrice   *Money
+	// ExternalKey is the importer's key for the product, empty if none.
+	ExternalKey string
+	// CategoryID is the category of the tree the product is in, empty if
+	// Category is free-form.
+	CategoryID string
 }
 
 func (e ProductCreatedEvent) EventType() string {
@@ -58,6 +63,11 @@ type ProductUpdatedEvent struct {
 	Name        string
 	Description string
 	Category    string
+	// CategoryID is the category of the tree the product is in, empty if
+	// Category is free-form.
+	CategoryID string
+	// Attributes are all of the product's attributes after the update.
+	Attributes map[string]string
 }
 
 func (e ProductUpdatedEvent) EventType() string {
@@ -76,6 +86,28 @@ func NewProductUpdatedEvent(id, name, description, category string, occurredAt t
 	}
 }
 
+// ProductBasePriceChangedEvent is raised when a product's base price is changed.
+type ProductBasePriceChangedEvent struct {
+	BaseEvent
+	OldPrice *Money
+	NewPrice *Money
+}
+
+func (e ProductBasePriceChangedEvent) EventType() string {
+	return "product.base_price_changed"
+}
+
+func NewProductBasePriceChangedEvent(id string, oldPrice, newPrice *Money, occurredAt time.Time) *ProductBasePriceChangedEvent {
+	return &ProductBasePriceChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		OldPrice: oldPrice,
+		NewPrice: newPrice,
+	}
+}
+
 // ProductActivatedEvent is raised when a product is activated.
 type ProductActivatedEvent struct {
 	BaseEvent
@@ -130,6 +162,114 @@ func NewProductArchivedEvent(id string, occurredAt time.Time) *ProductArchivedEv
 	}
 }
 
+// ProductStatusChangedEvent is raised when a product moves to a status other
+// than active, inactive or archived, which have their own events.
+type ProductStatusChangedEvent struct {
+	BaseEvent
+	FromStatus ProductStatus
+	ToStatus   ProductStatus
+}
+
+func (e ProductStatusChangedEvent) EventType() string {
+	return "product.status_changed"
+}
+
+func NewProductStatusChangedEvent(id string, from, to ProductStatus, occurredAt time.Time) *ProductStatusChangedEvent {
+	return &ProductStatusChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		FromStatus: from,
+		ToStatus:   to,
+	}
+}
+
+// ProductScheduledEvent is raised when the publish/unpublish schedule changes.
+// Nil times mean that side of the schedule is cleared.
+type ProductScheduledEvent struct {
+	BaseEvent
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+}
+
+func (e ProductScheduledEvent) EventType() string {
+	return "product.scheduled"
+}
+
+func NewProductScheduledEvent(id string, publishAt, unpublishAt *time.Time, occurredAt time.Time) *ProductScheduledEvent {
+	return &ProductScheduledEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+	}
+}
+
+// ProductScheduleFailedEvent is raised when a due scheduled transition is
+// dropped because the lifecycle or the activation policy does not allow it.
+// Transition is "publish" or "unpublish"; the product stays in Status.
+type ProductScheduleFailedEvent struct {
+	BaseEvent
+	Transition string
+	Status     ProductStatus
+	Violations []ActivationViolation
+}
+
+func (e ProductScheduleFailedEvent) EventType() string {
+	return "product.schedule_failed"
+}
+
+func NewProductScheduleFailedEvent(id, transition string, status ProductStatus, violations []ActivationViolation, occurredAt time.Time) *ProductScheduleFailedEvent {
+	return &ProductScheduleFailedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Transition: transition,
+		Status:     status,
+		Violations: violations,
+	}
+}
+
+// ProductRestoredEvent is raised when an archived product is restored.
+type ProductRestoredEvent struct {
+	BaseEvent
+}
+
+func (e ProductRestoredEvent) EventType() string {
+	return "product.restored"
+}
+
+func NewProductRestoredEvent(id string, occurredAt time.Time) *ProductRestoredEvent {
+	return &ProductRestoredEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+	}
+}
+
+// ProductPurgedEvent is raised when an archived product is permanently deleted.
+type ProductPurgedEvent struct {
+	BaseEvent
+}
+
+func (e ProductPurgedEvent) EventType() string {
+	return "product.purged"
+}
+
+func NewProductPurgedEvent(id string, occurredAt time.Time) *ProductPurgedEvent {
+	return &ProductPurgedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+	}
+}
+
 // DiscountAppliedEvent is raised when a discount is applied to a product.
 type DiscountAppliedEvent struct {
 	BaseEvent
@@ -154,6 +294,48 @@ func NewDiscountAppliedEvent(id string, percentage int64, startDate, endDate, oc
 	}
 }
 
+// DiscountStartedEvent is raised when a discount takes effect.
+type DiscountStartedEvent struct {
+	BaseEvent
+	Percentage     int64
+	EffectivePrice *Money
+}
+
+func (e DiscountStartedEvent) EventType() string {
+	return "product.discount_started"
+}
+
+func NewDiscountStartedEvent(id string, percentage int64, effectivePrice *Money, occurredAt time.Time) *DiscountStartedEvent {
+	return &DiscountStartedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Percentage:     percentage,
+		EffectivePrice: effectivePrice,
+	}
+}
+
+// DiscountExpiredEvent is raised when a discount period ends.
+type DiscountExpiredEvent struct {
+	BaseEvent
+	EffectivePrice *Money
+}
+
+func (e DiscountExpiredEvent) EventType() string {
+	return "product.discount_expired"
+}
+
+func NewDiscountExpiredEvent(id string, effectivePrice *Money, occurredAt time.Time) *DiscountExpiredEvent {
+	return &DiscountExpiredEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		EffectivePrice: effectivePrice,
+	}
+}
+
 // DiscountRemovedEvent is raised when a discount is removed from a product.
 type DiscountRemovedEvent struct {
 	BaseEvent
@@ -171,3 +353,220 @@ func NewDiscountRemovedEvent(id string, occurredAt time.Time) *DiscountRemovedEv
 		},
 	}
 }
+
+// CampaignCreatedEvent is raised when a promotion campaign is created.
+type CampaignCreatedEvent struct {
+	BaseEvent
+	Name       string
+	Percentage int64
+	StartDate  time.Time
+	EndDate    time.Time
+	Selection  CampaignSelection
+}
+
+func (e CampaignCreatedEvent) EventType() string {
+	return "campaign.created"
+}
+
+func NewCampaignCreatedEvent(id, name string, percentage int64, startDate, endDate time.Time, selection CampaignSelection, occurredAt time.Time) *CampaignCreatedEvent {
+	return &CampaignCreatedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Name:       name,
+		Percentage: percentage,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Selection:  selection,
+	}
+}
+
+// CampaignEndedEvent is raised when a campaign is ended before its scheduled end.
+type CampaignEndedEvent struct {
+	BaseEvent
+	ScheduledEndDate time.Time
+}
+
+func (e CampaignEndedEvent) EventType() string {
+	return "campaign.ended"
+}
+
+func NewCampaignEndedEvent(id string, scheduledEndDate time.Time, occurredAt time.Time) *CampaignEndedEvent {
+	return &CampaignEndedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		ScheduledEndDate: scheduledEndDate,
+	}
+}
+
+// CouponCreatedEvent is raised when a coupon code is created.
+type CouponCreatedEvent struct {
+	BaseEvent
+	Code           string
+	Rule           CouponRule
+	Eligibility    CouponEligibility
+	StartDate      time.Time
+	EndDate        time.Time
+	MaxRedemptions int64
+}
+
+func (e CouponCreatedEvent) EventType() string {
+	return "coupon.created"
+}
+
+func NewCouponCreatedEvent(id, code string, rule CouponRule, eligibility CouponEligibility, startDate, endDate time.Time, maxRedemptions int64, occurredAt time.Time) *CouponCreatedEvent {
+	return &CouponCreatedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Code:           code,
+		Rule:           rule,
+		Eligibility:    eligibility,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		MaxRedemptions: maxRedemptions,
+	}
+}
+
+// CouponRedeemedEvent is raised when a coupon is redeemed for an order.
+type CouponRedeemedEvent struct {
+	BaseEvent
+	Code            string
+	OrderID         string
+	ProductIDs      []string
+	RedemptionCount int64
+}
+
+func (e CouponRedeemedEvent) EventType() string {
+	return "coupon.redeemed"
+}
+
+func NewCouponRedeemedEvent(id, code, orderID string, productIDs []string, redemptionCount int64, occurredAt time.Time) *CouponRedeemedEvent {
+	return &CouponRedeemedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Code:            code,
+		OrderID:         orderID,
+		ProductIDs:      productIDs,
+		RedemptionCount: redemptionCount,
+	}
+}
+
+// CategoryCreatedEvent is raised when a category is added to the tree.
+type CategoryCreatedEvent struct {
+	BaseEvent
+	Slug        string
+	ParentID    string
+	DisplayName string
+}
+
+func (e CategoryCreatedEvent) EventType() string {
+	return "category.created"
+}
+
+func NewCategoryCreatedEvent(id, slug, parentID, displayName string, occurredAt time.Time) *CategoryCreatedEvent {
+	return &CategoryCreatedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Slug:        slug,
+		ParentID:    parentID,
+		DisplayName: displayName,
+	}
+}
+
+// CategoryUpdatedEvent is raised when a category's slug or parent changes.
+type CategoryUpdatedEvent struct {
+	BaseEvent
+	Slug     string
+	ParentID string
+}
+
+func (e CategoryUpdatedEvent) EventType() string {
+	return "category.updated"
+}
+
+func NewCategoryUpdatedEvent(id, slug, parentID string, occurredAt time.Time) *CategoryUpdatedEvent {
+	return &CategoryUpdatedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Slug:     slug,
+		ParentID: parentID,
+	}
+}
+
+// CategoryDeletedEvent is raised when a category is removed from the tree.
+type CategoryDeletedEvent struct {
+	BaseEvent
+	Slug string
+}
+
+func (e CategoryDeletedEvent) EventType() string {
+	return "category.deleted"
+}
+
+func NewCategoryDeletedEvent(id, slug string, occurredAt time.Time) *CategoryDeletedEvent {
+	return &CategoryDeletedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Slug: slug,
+	}
+}
+
+// CategoryRenamedEvent is raised when a category's display name changes. The
+// products assigned to it are rewritten by a bulk operation afterwards.
+type CategoryRenamedEvent struct {
+	BaseEvent
+	OldDisplayName string
+	DisplayName    string
+}
+
+func (e CategoryRenamedEvent) EventType() string {
+	return "category.renamed"
+}
+
+func NewCategoryRenamedEvent(id, oldDisplayName, displayName string, occurredAt time.Time) *CategoryRenamedEvent {
+	return &CategoryRenamedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		OldDisplayName: oldDisplayName,
+		DisplayName:    displayName,
+	}
+}
+
+// CategoryMergedEvent is raised when a category is merged into another and
+// removed from the tree. Its products are moved to the target by a bulk
+// operation afterwards.
+type CategoryMergedEvent struct {
+	BaseEvent
+	Slug     string
+	TargetID string
+}
+
+func (e CategoryMergedEvent) EventType() string {
+	return "category.merged"
+}
+
+func NewCategoryMergedEvent(id, slug, targetID string, occurredAt time.Time) *CategoryMergedEvent {
+	return &CategoryMergedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: id,
+			occurredAt:  occurredAt,
+		},
+		Slug:     slug,
+		TargetID: targetID,
+	}
+}
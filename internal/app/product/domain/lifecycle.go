@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Lifecycle is a validated product state-transition table. It decides which
+// status changes are allowed; the side effects of entering a status (archive
+// time, schedule clearing, events) stay on Product.
+//
+// Archived is terminal in every lifecycle: archived products only come back
+// through Restore, which enforces the restore window, and leave through Purge.
+type Lifecycle struct {
+	transitions map[ProductStatus]map[ProductStatus]bool
+}
+
+// Transition is an allowed status change.
+type Transition struct {
+	From ProductStatus
+	To   ProductStatus
+}
+
+// defaultTransitions are the transitions of the original hard-coded lifecycle.
+var defaultTransitions = []Transition{
+	{From: ProductStatusDraft, To: ProductStatusActive},
+	{From: ProductStatusDraft, To: ProductStatusInactive},
+	{From: ProductStatusDraft, To: ProductStatusArchived},
+	{From: ProductStatusActive, To: ProductStatusInactive},
+	{From: ProductStatusInactive, To: ProductStatusActive},
+	{From: ProductStatusInactive, To: ProductStatusArchived},
+}
+
+// DefaultLifecycle returns the draft → active ⇄ inactive → archived lifecycle.
+func DefaultLifecycle() *Lifecycle {
+	lifecycle, err := NewLifecycle(defaultTransitions)
+	if err != nil {
+		panic(err)
+	}
+	return lifecycle
+}
+
+// NewLifecycle builds a lifecycle from its allowed transitions. Every status
+// must be known and reachable from draft, active and inactive must be part of
+// the lifecycle (schedules and restores move products into them), and
+// archived must have no outgoing transitions.
+func NewLifecycle(transitions []Transition) (*Lifecycle, error) {
+	l := &Lifecycle{transitions: make(map[ProductStatus]map[ProductStatus]bool)}
+
+	for _, t := range transitions {
+		if !t.From.IsValid() {
+			return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidLifecycle, t.From)
+		}
+		if !t.To.IsValid() {
+			return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidLifecycle, t.To)
+		}
+		if t.From == t.To {
+			return nil, fmt.Errorf("%w: %s cannot transition to itself", ErrInvalidLifecycle, t.From)
+		}
+		if t.From == ProductStatusArchived {
+			return nil, fmt.Errorf("%w: archived products leave only through restore", ErrInvalidLifecycle)
+		}
+		if l.transitions[t.From] == nil {
+			l.transitions[t.From] = make(map[ProductStatus]bool)
+		}
+		l.transitions[t.From][t.To] = true
+	}
+
+	reachable := l.reachableFromDraft()
+	for _, status := range l.Statuses() {
+		if !reachable[status] {
+			return nil, fmt.Errorf("%w: %s is not reachable from draft", ErrInvalidLifecycle, status)
+		}
+	}
+	for _, required := range []ProductStatus{ProductStatusActive, ProductStatusInactive} {
+		if !reachable[required] {
+			return nil, fmt.Errorf("%w: %s is not reachable from draft", ErrInvalidLifecycle, required)
+		}
+	}
+
+	return l, nil
+}
+
+// Allows reports whether a product may move from one status to another.
+func (l *Lifecycle) Allows(from, to ProductStatus) bool {
+	return l.transitions[from][to]
+}
+
+// Contains reports whether status is part of the lifecycle.
+func (l *Lifecycle) Contains(status ProductStatus) bool {
+	if status == ProductStatusDraft {
+		return true
+	}
+	for _, targets := range l.transitions {
+		if targets[status] {
+			return true
+		}
+	}
+	return false
+}
+
+// Statuses returns the statuses of the lifecycle in sorted order.
+func (l *Lifecycle) Statuses() []ProductStatus {
+	seen := map[ProductStatus]bool{ProductStatusDraft: true}
+	for from, targets := range l.transitions {
+		seen[from] = true
+		for to := range targets {
+			seen[to] = true
+		}
+	}
+
+	statuses := make([]ProductStatus, 0, len(seen))
+	for status := range seen {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i] < statuses[j] })
+	return statuses
+}
+
+// Transitions returns the allowed transitions sorted by source and target.
+func (l *Lifecycle) Transitions() []Transition {
+	var transitions []Transition
+	for from, targets := range l.transitions {
+		for to := range targets {
+			transitions = append(transitions, Transition{From: from, To: to})
+		}
+	}
+	sort.Slice(transitions, func(i, j int) bool {
+		if transitions[i].From != transitions[j].From {
+			return transitions[i].From < transitions[j].From
+		}
+		return transitions[i].To < transitions[j].To
+	})
+	return transitions
+}
+
+func (l *Lifecycle) reachableFromDraft() map[ProductStatus]bool {
+	reachable := map[ProductStatus]bool{ProductStatusDraft: true}
+	queue := []ProductStatus{ProductStatusDraft}
+	for len(queue) > 0 {
+		from := queue[0]
+		queue = queue[1:]
+		for to := range l.transitions[from] {
+			if !reachable[to] {
+				reachable[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+	return reachable
+}
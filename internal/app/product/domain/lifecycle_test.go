@@ -0,0 +1,178 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+// reviewLifecycle adds review, preorder and discontinuation to the default lifecycle.
+func reviewLifecycle(t *testing.T) *domain.Lifecycle {
+	t.Helper()
+	l, err := domain.NewLifecycle([]domain.Transition{
+		{From: domain.ProductStatusDraft, To: domain.ProductStatusPendingReview},
+		{From: domain.ProductStatusPendingReview, To: domain.ProductStatusDraft},
+		{From: domain.ProductStatusPendingReview, To: domain.ProductStatusActive},
+		{From: domain.ProductStatusPendingReview, To: domain.ProductStatusPreorder},
+		{From: domain.ProductStatusPreorder, To: domain.ProductStatusActive},
+		{From: domain.ProductStatusActive, To: domain.ProductStatusInactive},
+		{From: domain.ProductStatusActive, To: domain.ProductStatusDiscontinued},
+		{From: domain.ProductStatusInactive, To: domain.ProductStatusActive},
+		{From: domain.ProductStatusInactive, To: domain.ProductStatusArchived},
+		{From: domain.ProductStatusDiscontinued, To: domain.ProductStatusArchived},
+	})
+	require.NoError(t, err)
+	return l
+}
+
+func TestDefaultLifecycle(t *testing.T) {
+	l := domain.DefaultLifecycle()
+
+	assert.True(t, l.Allows(domain.ProductStatusDraft, domain.ProductStatusActive))
+	assert.True(t, l.Allows(domain.ProductStatusInactive, domain.ProductStatusArchived))
+	assert.False(t, l.Allows(domain.ProductStatusActive, domain.ProductStatusArchived))
+	assert.False(t, l.Allows(domain.ProductStatusArchived, domain.ProductStatusInactive))
+	assert.False(t, l.Contains(domain.ProductStatusPendingReview))
+	assert.Equal(t, []domain.ProductStatus{
+		domain.ProductStatusActive,
+		domain.ProductStatusArchived,
+		domain.ProductStatusDraft,
+		domain.ProductStatusInactive,
+	}, l.Statuses())
+}
+
+func TestNewLifecycle_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		transitions []domain.Transition
+	}{
+		{
+			name:        "unknown status",
+			transitions: []domain.Transition{{From: domain.ProductStatusDraft, To: "retired"}},
+		},
+		{
+			name: "self transition",
+			transitions: []domain.Transition{
+				{From: domain.ProductStatusDraft, To: domain.ProductStatusActive},
+				{From: domain.ProductStatusActive, To: domain.ProductStatusInactive},
+				{From: domain.ProductStatusActive, To: domain.ProductStatusActive},
+			},
+		},
+		{
+			name: "leaves archived",
+			transitions: []domain.Transition{
+				{From: domain.ProductStatusDraft, To: domain.ProductStatusActive},
+				{From: domain.ProductStatusActive, To: domain.ProductStatusInactive},
+				{From: domain.ProductStatusInactive, To: domain.ProductStatusArchived},
+				{From: domain.ProductStatusArchived, To: domain.ProductStatusInactive},
+			},
+		},
+		{
+			name: "unreachable status",
+			transitions: []domain.Transition{
+				{From: domain.ProductStatusDraft, To: domain.ProductStatusActive},
+				{From: domain.ProductStatusActive, To: domain.ProductStatusInactive},
+				{From: domain.ProductStatusPreorder, To: domain.ProductStatusActive},
+			},
+		},
+		{
+			name: "no inactive",
+			transitions: []domain.Transition{
+				{From: domain.ProductStatusDraft, To: domain.ProductStatusActive},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewLifecycle(tt.transitions)
+			assert.ErrorIs(t, err, domain.ErrInvalidLifecycle)
+		})
+	}
+}
+
+func TestProduct_Transition(t *testing.T) {
+	l := reviewLifecycle(t)
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+	product, err := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
+	require.NoError(t, err)
+	product.ClearEvents()
+
+	// Not allowed straight from draft
+	err = product.Transition(l, nil, domain.ProductStatusPreorder, now)
+	assert.ErrorIs(t, err, domain.ErrInvalidStatusTransition)
+	err = product.Activate(l, nil, now)
+	assert.ErrorIs(t, err, domain.ErrInvalidStatusTransition)
+
+	require.NoError(t, product.Transition(l, nil, domain.ProductStatusPendingReview, now))
+	assert.Equal(t, domain.ProductStatusPendingReview, product.Status())
+	assert.True(t, product.Changes().Dirty(domain.FieldStatus))
+
+	err = product.Transition(l, nil, domain.ProductStatusPendingReview, now)
+	assert.ErrorIs(t, err, domain.ErrProductAlreadyInStatus)
+
+	// Built-in statuses keep their own events
+	require.NoError(t, product.Transition(l, nil, domain.ProductStatusActive, now))
+	require.NoError(t, product.Transition(l, nil, domain.ProductStatusDiscontinued, now))
+
+	events := product.DomainEvents()
+	require.Len(t, events, 3)
+	assert.Equal(t, "product.status_changed", events[0].EventType())
+	assert.Equal(t, "product.activated", events[1].EventType())
+	assert.Equal(t, "product.status_changed", events[2].EventType())
+	changed := events[2].(*domain.ProductStatusChangedEvent)
+	assert.Equal(t, domain.ProductStatusActive, changed.FromStatus)
+	assert.Equal(t, domain.ProductStatusDiscontinued, changed.ToStatus)
+
+	require.NoError(t, product.Transition(l, nil, domain.ProductStatusArchived, now))
+	assert.NotNil(t, product.ArchivedAt())
+
+	err = product.Transition(l, nil, domain.ProductStatusDraft, now)
+	assert.ErrorIs(t, err, domain.ErrProductArchived)
+}
+
+func TestProduct_TransitionUnknownStatus(t *testing.T) {
+	product := createActiveProduct(t)
+
+	err := product.Transition(lifecycle, nil, "retired", time.Now())
+	assert.ErrorIs(t, err, domain.ErrInvalidProductStatus)
+}
+
+func TestProduct_TransitionOutsideLifecycle(t *testing.T) {
+	product := createActiveProduct(t)
+
+	err := product.Transition(lifecycle, nil, domain.ProductStatusDiscontinued, time.Now())
+	assert.ErrorIs(t, err, domain.ErrInvalidStatusTransition)
+}
+
+func TestProduct_RunScheduleSkipsDisallowedPublish(t *testing.T) {
+	l := reviewLifecycle(t)
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+	product, err := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
+	require.NoError(t, err)
+
+	publishAt := now.Add(time.Hour)
+	require.NoError(t, product.Schedule(&publishAt, nil, now))
+	product.ClearEvents()
+
+	fired, err := product.RunSchedule(l, nil, publishAt)
+	require.NoError(t, err)
+	assert.True(t, fired)
+	assert.Equal(t, domain.ProductStatusDraft, product.Status())
+	assert.Nil(t, product.PublishAt())
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	failed := events[0].(*domain.ProductScheduleFailedEvent)
+	assert.Equal(t, "product.schedule_failed", failed.EventType())
+	assert.Equal(t, "publish", failed.Transition)
+	assert.Equal(t, domain.ProductStatusDraft, failed.Status)
+	require.Len(t, failed.Violations, 1)
+	assert.Equal(t, "lifecycle", failed.Violations[0].Rule)
+}
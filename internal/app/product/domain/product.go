@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"maps"
 	"time"
 )
 
@@ -9,10 +12,16 @@ const (
 	FieldName        = "name"
 	FieldDescription = "description"
 	FieldCategory    = "category"
+	FieldCategoryID  = "category_id"
+	FieldAttributes  = "attributes"
 	FieldBasePrice   = "base_price"
 	FieldDiscount    = "discount"
 	FieldStatus      = "status"
 	FieldArchivedAt  = "archived_at"
+	FieldPublishAt   = "publish_at"
+	FieldUnpublishAt = "unpublish_at"
+
+	FieldDiscountPhase = "discount_phase"
 )
 
 // ProductStatus represents the status of a product.
@@ -23,12 +32,19 @@ const (
 	ProductStatusActive   ProductStatus = "active"
 	ProductStatusInactive ProductStatus = "inactive"
 	ProductStatusArchived ProductStatus = "archived"
+
+	// Optional statuses, only reachable when the deployment's Lifecycle
+	// includes them.
+	ProductStatusPendingReview ProductStatus = "pending_review"
+	ProductStatusPreorder      ProductStatus = "preorder"
+	ProductStatusDiscontinued  ProductStatus = "discontinued"
 )
 
 // IsValid checks if the status is a valid ProductStatus.
 func (s ProductStatus) IsValid() bool {
 	switch s {
-	case ProductStatusDraft, ProductStatusActive, ProductStatusInactive, ProductStatusArchived:
+	case ProductStatusDraft, ProductStatusActive, ProductStatusInactive, ProductStatusArchived,
+		ProductStatusPendingReview, ProductStatusPreorder, ProductStatusDiscontinued:
 		return true
 	}
 	return false
@@ -88,10 +104,17 @@ type Product struct {
 	category    string
 	basePrice   *Money
 	discount    *Discount
+	phase       DiscountPhase
 	status      ProductStatus
 	createdAt   time.Time
 	updatedAt   time.Time
 	archivedAt  *time.Time
+	publishAt   *time.Time
+	unpublishAt *time.Time
+	version     int64
+	externalKey string
+	categoryID  string
+	attributes  map[string]string
 
 	changes *ChangeTracker
 	events  []DomainEvent
@@ -100,6 +123,36 @@ type Product struct {
 
 // NewProduct creates a new product in draft status.
 func NewProduct(id, name, description, category string, basePrice *Money, now time.Time) (*Product, error) {
+	return newProduct(id, "", "", name, description, category, basePrice, now)
+}
+
+// NewProductInCategory creates a new product in draft status assigned to a
+// category of the tree. Its category is the category's display name.
+func NewProductInCategory(id, name, description string, category *Category, basePrice *Money, now time.Time) (*Product, error) {
+	return newProduct(id, "", category.ID(), name, description, category.DisplayName(), basePrice, now)
+}
+
+// NewImportedProduct creates a new product in draft status identified by an
+// importer's external key, e.g. a supplier SKU. The key never changes and
+// must be unique; an empty key behaves like NewProduct.
+func NewImportedProduct(id, externalKey, name, description, category string, basePrice *Money, now time.Time) (*Product, error) {
+	if len(externalKey) > MaxExternalKeyLength {
+		return nil, ErrExternalKeyTooLong
+	}
+	return newProduct(id, externalKey, "", name, description, category, basePrice, now)
+}
+
+// NewImportedProductInCategory creates a new product in draft status
+// identified by an importer's external key and assigned to a category of
+// the tree, combining NewImportedProduct and NewProductInCategory.
+func NewImportedProductInCategory(id, externalKey, name, description string, category *Category, basePrice *Money, now time.Time) (*Product, error) {
+	if len(externalKey) > MaxExternalKeyLength {
+		return nil, ErrExternalKeyTooLong
+	}
+	return newProduct(id, externalKey, category.ID(), name, description, category.DisplayName(), basePrice, now)
+}
+
+func newProduct(id, externalKey, categoryID, name, description, category string, basePrice *Money, now time.Time) (*Product, error) {
 	if name == "" {
 		return nil, ErrEmptyProductName
 	}
@@ -122,15 +175,22 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 		description: description,
 		category:    category,
 		basePrice:   basePrice,
+		phase:       DiscountPhaseNone,
 		status:      ProductStatusDraft,
 		createdAt:   now,
 		updatedAt:   now,
+		version:     1,
+		externalKey: externalKey,
+		categoryID:  categoryID,
 		changes:     NewChangeTracker(),
 		events:      make([]DomainEvent, 0),
 		isNew:       true,
 	}
 
-	p.events = append(p.events, NewProductCreatedEvent(id, name, description, category, basePrice, now))
+	created := NewProductCreatedEvent(id, name, description, category, basePrice, now)
+	created.ExternalKey = externalKey
+	created.CategoryID = categoryID
+	p.events = append(p.events, created)
 
 	return p, nil
 }
@@ -140,10 +200,24 @@ func Reconstitute(
 	id, name, description, category string,
 	basePrice *Money,
 	discount *Discount,
+	phase DiscountPhase,
 	status ProductStatus,
 	createdAt, updatedAt time.Time,
 	archivedAt *time.Time,
+	publishAt, unpublishAt *time.Time,
+	version int64,
+	externalKey string,
+	categoryID string,
+	attributes map[string]string,
 ) *Product {
+	// Discounts stored before phases were tracked are pending, so the
+	// scheduler announces their start, or only their expiry once ended
+	if discount == nil {
+		phase = DiscountPhaseNone
+	} else if phase == DiscountPhaseNone || phase == "" {
+		phase = DiscountPhasePending
+	}
+
 	return &Product{
 		id:          id,
 		name:        name,
@@ -151,10 +225,17 @@ func Reconstitute(
 		category:    category,
 		basePrice:   basePrice,
 		discount:    discount,
+		phase:       phase,
 		status:      status,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
 		archivedAt:  archivedAt,
+		publishAt:   publishAt,
+		unpublishAt: unpublishAt,
+		version:     version,
+		externalKey: externalKey,
+		categoryID:  categoryID,
+		attributes:  attributes,
 		changes:     NewChangeTracker(),
 		events:      make([]DomainEvent, 0),
 		isNew:       false,
@@ -181,6 +262,21 @@ func (p *Product) Category() string {
 	return p.category
 }
 
+// CategoryID returns the ID of the category of the tree the product is
+// assigned to, or empty when its category is free-form.
+func (p *Product) CategoryID() string {
+	return p.categoryID
+}
+
+// Attributes returns a copy of the product's attributes by name, nil when
+// it has none.
+func (p *Product) Attributes() map[string]string {
+	if len(p.attributes) == 0 {
+		return nil
+	}
+	return maps.Clone(p.attributes)
+}
+
 // BasePrice returns the base price.
 func (p *Product) BasePrice() *Money {
 	return p.basePrice
@@ -191,6 +287,11 @@ func (p *Product) Discount() *Discount {
 	return p.discount
 }
 
+// DiscountPhase returns which boundaries of the current discount have been announced.
+func (p *Product) DiscountPhase() DiscountPhase {
+	return p.phase
+}
+
 // Status returns the product status.
 func (p *Product) Status() ProductStatus {
 	return p.status
@@ -211,6 +312,40 @@ func (p *Product) ArchivedAt() *time.Time {
 	return p.archivedAt
 }
 
+// PublishAt returns when the product is scheduled to be activated (nil if not scheduled).
+func (p *Product) PublishAt() *time.Time {
+	return p.publishAt
+}
+
+// UnpublishAt returns when the product is scheduled to be deactivated (nil if not scheduled).
+func (p *Product) UnpublishAt() *time.Time {
+	return p.unpublishAt
+}
+
+// Version returns the product's revision: 1 when created, incremented by
+// every persisted change. Pending changes count as the next revision, so
+// after a successful commit Version matches the stored row.
+func (p *Product) Version() int64 {
+	if !p.isNew && p.changes.HasChanges() {
+		return p.version + 1
+	}
+	return p.version
+}
+
+// CheckVersion returns ErrVersionMismatch unless the product as loaded is at
+// the expected version. An expected version of zero skips the check.
+func (p *Product) CheckVersion(expected int64) error {
+	if expected != 0 && expected != p.version {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// ExternalKey returns the importer's key for the product (empty if none).
+func (p *Product) ExternalKey() string {
+	return p.externalKey
+}
+
 // IsNew returns true if this is a new product that hasn't been persisted.
 func (p *Product) IsNew() bool {
 	return p.isNew
@@ -241,12 +376,22 @@ func (p *Product) IsArchived() bool {
 	return p.status == ProductStatusArchived
 }
 
-// EffectivePrice calculates the current effective price considering any active discount.
-func (p *Product) EffectivePrice(now time.Time) *Money {
-	if p.discount == nil || !p.discount.IsValidAt(now) {
+// EffectivePrice calculates the effective price at now. The product's own
+// discount and the given campaigns that are running and select the product
+// do not stack: the highest percentage among them applies.
+func (p *Product) EffectivePrice(now time.Time, campaigns ...*Campaign) *Money {
+	var percentage int64
+	if p.discount != nil && p.discount.IsValidAt(now) {
+		percentage = p.discount.Percentage()
+	}
+	if c := BestCampaign(campaigns, p.id, p.category, now); c != nil && c.Discount().Percentage() > percentage {
+		percentage = c.Discount().Percentage()
+	}
+
+	if percentage == 0 {
 		return p.basePrice
 	}
-	return p.discount.Apply(p.basePrice)
+	return p.basePrice.SubtractPercentage(percentage)
 }
 
 // HasActiveDiscount returns true if the product has an active discount at the given time.
@@ -254,102 +399,413 @@ func (p *Product) HasActiveDiscount(now time.Time) bool {
 	return p.discount != nil && p.discount.IsValidAt(now)
 }
 
-// Update updates the product details.
-func (p *Product) Update(name, description, category string, now time.Time) error {
+// ProductUpdate holds the product details to change. Nil fields are left
+// as they are. AssignedCategory moves the product into a category of the
+// tree and takes precedence over Category; changing Category to a free-form
+// name takes the product out of the tree.
+type ProductUpdate struct {
+	Name             *string
+	Description      *string
+	Category         *string
+	AssignedCategory *Category
+	// Attributes replaces all of the product's attributes when non-nil; an
+	// empty map removes them.
+	Attributes map[string]string
+}
+
+// Update updates the given product details. Only fields that are set and
+// differ from the current value are marked dirty.
+func (p *Product) Update(update ProductUpdate, now time.Time) error {
 	if p.IsArchived() {
 		return ErrCannotUpdateArchived
 	}
 
-	if name == "" {
-		return ErrEmptyProductName
-	}
-	if len(name) > MaxProductNameLength {
-		return ErrProductNameTooLong
+	if update.Name != nil {
+		if *update.Name == "" {
+			return ErrEmptyProductName
+		}
+		if len(*update.Name) > MaxProductNameLength {
+			return ErrProductNameTooLong
+		}
 	}
-	if category == "" {
-		return ErrEmptyCategory
+	if update.Category != nil {
+		if *update.Category == "" {
+			return ErrEmptyCategory
+		}
+		if len(*update.Category) > MaxCategoryLength {
+			return ErrCategoryTooLong
+		}
 	}
-	if len(category) > MaxCategoryLength {
-		return ErrCategoryTooLong
+	if update.Attributes != nil {
+		if err := validateAttributes(update.Attributes); err != nil {
+			return err
+		}
 	}
 
 	changed := false
 
-	if p.name != name {
-		p.name = name
+	if update.Name != nil && p.name != *update.Name {
+		p.name = *update.Name
 		p.changes.MarkDirty(FieldName)
 		changed = true
 	}
 
-	if p.description != description {
-		p.description = description
+	if update.Description != nil && p.description != *update.Description {
+		p.description = *update.Description
 		p.changes.MarkDirty(FieldDescription)
 		changed = true
 	}
 
+	category, categoryID := p.category, p.categoryID
+	if c := update.AssignedCategory; c != nil {
+		category, categoryID = c.DisplayName(), c.ID()
+	} else if update.Category != nil && p.category != *update.Category {
+		category, categoryID = *update.Category, ""
+	}
+
 	if p.category != category {
 		p.category = category
 		p.changes.MarkDirty(FieldCategory)
 		changed = true
 	}
 
+	if p.categoryID != categoryID {
+		p.categoryID = categoryID
+		p.changes.MarkDirty(FieldCategoryID)
+		changed = true
+	}
+
+	if update.Attributes != nil && !maps.Equal(p.attributes, update.Attributes) {
+		p.attributes = maps.Clone(update.Attributes)
+		p.changes.MarkDirty(FieldAttributes)
+		changed = true
+	}
+
 	if changed {
 		p.updatedAt = now
-		p.events = append(p.events, NewProductUpdatedEvent(p.id, name, description, category, now))
+		updated := NewProductUpdatedEvent(p.id, p.name, p.description, p.category, now)
+		updated.CategoryID = p.categoryID
+		updated.Attributes = p.Attributes()
+		p.events = append(p.events, updated)
+	}
+
+	return nil
+}
+
+// validateAttributes checks the number of attributes and the lengths of
+// their names and values.
+func validateAttributes(attributes map[string]string) error {
+	if len(attributes) > MaxAttributes {
+		return ErrTooManyAttributes
+	}
+	for name, value := range attributes {
+		if name == "" || len(name) > MaxAttributeNameLength {
+			return ErrInvalidAttributeName
+		}
+		if len(value) > MaxAttributeValueLength {
+			return ErrAttributeTooLong
+		}
+	}
+	return nil
+}
+
+// MoveToCategory assigns the product to c, taking c's current display name.
+// Unlike Update it applies to archived products too, so renaming or merging
+// a category reaches every product assigned to it. Moving to the category
+// the product is already in, under the same name, is a no-op.
+func (p *Product) MoveToCategory(c *Category, now time.Time) error {
+	if p.category == c.DisplayName() && p.categoryID == c.ID() {
+		return nil
 	}
 
+	if p.category != c.DisplayName() {
+		p.category = c.DisplayName()
+		p.changes.MarkDirty(FieldCategory)
+	}
+	if p.categoryID != c.ID() {
+		p.categoryID = c.ID()
+		p.changes.MarkDirty(FieldCategoryID)
+	}
+
+	p.updatedAt = now
+	updated := NewProductUpdatedEvent(p.id, p.name, p.description, p.category, now)
+	updated.CategoryID = p.categoryID
+	updated.Attributes = p.Attributes()
+	p.events = append(p.events, updated)
+
 	return nil
 }
 
-// Activate activates the product.
-func (p *Product) Activate(now time.Time) error {
+// ChangeBasePrice sets a new base price. Setting the current price is a no-op.
+func (p *Product) ChangeBasePrice(price *Money, now time.Time) error {
+	if p.IsArchived() {
+		return ErrCannotUpdateArchived
+	}
+	if price == nil || price.IsZero() {
+		return ErrZeroPrice
+	}
+	if p.basePrice.Equals(price) {
+		return nil
+	}
+
+	oldPrice := p.basePrice
+	p.basePrice = price
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldBasePrice)
+
+	p.events = append(p.events, NewProductBasePriceChangedEvent(p.id, oldPrice, price, now))
+
+	return nil
+}
+
+// Transition moves the product to target if lifecycle allows it. Active,
+// inactive and archived go through Activate, Deactivate and Archive, so they
+// keep their specific errors and events, and a move to active must meet
+// policy; other statuses raise ProductStatusChangedEvent.
+func (p *Product) Transition(lifecycle *Lifecycle, policy *ActivationPolicy, target ProductStatus, now time.Time) error {
+	if !target.IsValid() {
+		return ErrInvalidProductStatus
+	}
+
+	switch target {
+	case ProductStatusActive:
+		return p.Activate(lifecycle, policy, now)
+	case ProductStatusInactive:
+		return p.Deactivate(lifecycle, now)
+	case ProductStatusArchived:
+		return p.Archive(lifecycle, now)
+	}
+
+	if p.IsArchived() {
+		return ErrProductArchived
+	}
+	if p.status == target {
+		return ErrProductAlreadyInStatus
+	}
+	if !lifecycle.Allows(p.status, target) {
+		return ErrInvalidStatusTransition
+	}
+
+	from := p.status
+	p.setStatus(target, now)
+	p.events = append(p.events, NewProductStatusChangedEvent(p.id, from, target, now))
+
+	return nil
+}
+
+// Activate activates the product once it meets policy. Errors about the
+// product's state (archived, already active) come before policy violations.
+func (p *Product) Activate(lifecycle *Lifecycle, policy *ActivationPolicy, now time.Time) error {
 	if p.IsArchived() {
 		return ErrCannotActivateArchived
 	}
 	if p.IsActive() {
 		return ErrProductAlreadyActive
 	}
+	if err := policy.Check(p); err != nil {
+		return err
+	}
+	if !lifecycle.Allows(p.status, ProductStatusActive) {
+		return ErrInvalidStatusTransition
+	}
 
-	p.status = ProductStatusActive
-	p.updatedAt = now
-	p.changes.MarkDirty(FieldStatus)
+	p.setStatus(ProductStatusActive, now)
 	p.events = append(p.events, NewProductActivatedEvent(p.id, now))
 
 	return nil
 }
 
 // Deactivate deactivates the product.
-func (p *Product) Deactivate(now time.Time) error {
+func (p *Product) Deactivate(lifecycle *Lifecycle, now time.Time) error {
 	if p.IsArchived() {
 		return ErrCannotDeactivateArchived
 	}
 	if p.status == ProductStatusInactive {
 		return ErrProductInactive
 	}
+	if !lifecycle.Allows(p.status, ProductStatusInactive) {
+		return ErrInvalidStatusTransition
+	}
 
-	p.status = ProductStatusInactive
-	p.updatedAt = now
-	p.changes.MarkDirty(FieldStatus)
+	p.setStatus(ProductStatusInactive, now)
 	p.events = append(p.events, NewProductDeactivatedEvent(p.id, now))
 
 	return nil
 }
 
 // Archive archives (soft deletes) the product.
-func (p *Product) Archive(now time.Time) error {
+func (p *Product) Archive(lifecycle *Lifecycle, now time.Time) error {
 	if p.IsArchived() {
 		return ErrProductArchived
 	}
-	if p.IsActive() {
-		return ErrCannotArchiveActive
+	if !lifecycle.Allows(p.status, ProductStatusArchived) {
+		if p.IsActive() {
+			return ErrCannotArchiveActive
+		}
+		return ErrInvalidStatusTransition
 	}
 
-	p.status = ProductStatusArchived
+	p.setStatus(ProductStatusArchived, now)
 	p.archivedAt = &now
+	p.changes.MarkDirty(FieldArchivedAt)
+	p.setSchedule(nil, nil)
+	p.events = append(p.events, NewProductArchivedEvent(p.id, now))
+
+	return nil
+}
+
+// setStatus changes the status and marks it dirty.
+func (p *Product) setStatus(status ProductStatus, now time.Time) {
+	p.status = status
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldStatus)
+}
+
+// Schedule sets when the product is activated and deactivated automatically.
+// A nil time clears that side of the schedule.
+func (p *Product) Schedule(publishAt, unpublishAt *time.Time, now time.Time) error {
+	if p.IsArchived() {
+		return ErrCannotScheduleArchived
+	}
+	if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+		return ErrInvalidSchedule
+	}
+
+	if p.setSchedule(publishAt, unpublishAt) {
+		p.updatedAt = now
+		p.events = append(p.events, NewProductScheduledEvent(p.id, publishAt, unpublishAt, now))
+	}
+
+	return nil
+}
+
+// RunSchedule fires the scheduled transitions that are due at now. Each
+// fired time is cleared, so a transition fires at most once; a transition
+// that lifecycle does not allow from the current status, or a publish of a
+// product that fails policy, is dropped with a ProductScheduleFailedEvent
+// listing why.
+// It returns true if any scheduled time was due.
+func (p *Product) RunSchedule(lifecycle *Lifecycle, policy *ActivationPolicy, now time.Time) (bool, error) {
+	publishDue := p.publishAt != nil && !now.Before(*p.publishAt)
+	unpublishDue := p.unpublishAt != nil && !now.Before(*p.unpublishAt)
+	if !publishDue && !unpublishDue {
+		return false, nil
+	}
+
+	if publishDue {
+		p.publishAt = nil
+		p.changes.MarkDirty(FieldPublishAt)
+		if !p.IsActive() {
+			violations := p.scheduleViolations(lifecycle, ProductStatusActive)
+			if len(violations) == 0 {
+				violations = policyViolations(policy.Check(p))
+			}
+			if len(violations) > 0 {
+				p.events = append(p.events, NewProductScheduleFailedEvent(p.id, "publish", p.status, violations, now))
+			} else if err := p.Activate(lifecycle, policy, now); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if unpublishDue {
+		p.unpublishAt = nil
+		p.changes.MarkDirty(FieldUnpublishAt)
+		if p.IsActive() {
+			if violations := p.scheduleViolations(lifecycle, ProductStatusInactive); len(violations) > 0 {
+				p.events = append(p.events, NewProductScheduleFailedEvent(p.id, "unpublish", p.status, violations, now))
+			} else if err := p.Deactivate(lifecycle, now); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	p.updatedAt = now
+
+	return true, nil
+}
+
+// scheduleViolations reports a scheduled move to target that lifecycle does
+// not allow from the current status.
+func (p *Product) scheduleViolations(lifecycle *Lifecycle, target ProductStatus) []ActivationViolation {
+	if lifecycle.Allows(p.status, target) {
+		return nil
+	}
+	return []ActivationViolation{{
+		Rule:        "lifecycle",
+		Field:       FieldStatus,
+		Description: fmt.Sprintf("%s: %s to %s", ErrInvalidStatusTransition, p.status, target),
+	}}
+}
+
+// policyViolations returns the violations of an ActivationPolicy.Check error.
+func policyViolations(err error) []ActivationViolation {
+	var policyErr *ActivationPolicyError
+	if errors.As(err, &policyErr) {
+		return policyErr.Violations
+	}
+	return nil
+}
+
+// setSchedule replaces the schedule and reports whether it changed.
+func (p *Product) setSchedule(publishAt, unpublishAt *time.Time) bool {
+	changed := false
+
+	if !timesEqual(p.publishAt, publishAt) {
+		p.publishAt = publishAt
+		p.changes.MarkDirty(FieldPublishAt)
+		changed = true
+	}
+
+	if !timesEqual(p.unpublishAt, unpublishAt) {
+		p.unpublishAt = unpublishAt
+		p.changes.MarkDirty(FieldUnpublishAt)
+		changed = true
+	}
+
+	return changed
+}
+
+// timesEqual compares optional times.
+func timesEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// Restore moves an archived product back to inactive. Restores are only
+// allowed within retention of the archive time; a non-positive retention
+// means archived products can always be restored.
+func (p *Product) Restore(retention time.Duration, now time.Time) error {
+	if !p.IsArchived() {
+		return ErrProductNotArchived
+	}
+	if retention > 0 && p.archivedAt != nil && now.After(p.archivedAt.Add(retention)) {
+		return ErrRestoreWindowExpired
+	}
+
+	p.status = ProductStatusInactive
+	p.archivedAt = nil
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldStatus)
 	p.changes.MarkDirty(FieldArchivedAt)
-	p.events = append(p.events, NewProductArchivedEvent(p.id, now))
+	p.events = append(p.events, NewProductRestoredEvent(p.id, now))
+
+	return nil
+}
+
+// Purge marks an archived product for permanent deletion once it has been
+// archived for at least retention. The caller deletes the persisted product.
+func (p *Product) Purge(retention time.Duration, now time.Time) error {
+	if !p.IsArchived() {
+		return ErrProductNotArchived
+	}
+	if p.archivedAt == nil || now.Before(p.archivedAt.Add(retention)) {
+		return ErrPurgeRetentionNotElapsed
+	}
+
+	p.events = append(p.events, NewProductPurgedEvent(p.id, now))
 
 	return nil
 }
@@ -367,6 +823,7 @@ func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 	}
 
 	p.discount = discount
+	p.setDiscountPhase(DiscountPhasePending)
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldDiscount)
 	p.events = append(p.events, NewDiscountAppliedEvent(
@@ -387,9 +844,47 @@ func (p *Product) RemoveDiscount(now time.Time) error {
 	}
 
 	p.discount = nil
+	p.setDiscountPhase(DiscountPhaseNone)
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldDiscount)
 	p.events = append(p.events, NewDiscountRemovedEvent(p.id, now))
 
 	return nil
 }
+
+// AdvanceDiscountPhase announces the discount boundaries crossed by now: the
+// start once the discount takes effect and the expiry once it has ended. A
+// discount that ended before its start was announced only announces the
+// expiry. The events carry the effective price with the given running
+// campaigns. It returns true if a boundary was announced.
+func (p *Product) AdvanceDiscountPhase(now time.Time, campaigns ...*Campaign) bool {
+	if p.discount == nil {
+		return false
+	}
+
+	switch {
+	case p.discount.IsExpired(now) && p.phase != DiscountPhaseExpired:
+		p.setDiscountPhase(DiscountPhaseExpired)
+		p.events = append(p.events, NewDiscountExpiredEvent(p.id, p.EffectivePrice(now, campaigns...), now))
+	case p.discount.IsValidAt(now) && p.phase == DiscountPhasePending:
+		p.setDiscountPhase(DiscountPhaseActive)
+		p.events = append(p.events, NewDiscountStartedEvent(
+			p.id,
+			p.discount.Percentage(),
+			p.EffectivePrice(now, campaigns...),
+			now,
+		))
+	default:
+		return false
+	}
+
+	return true
+}
+
+// setDiscountPhase records a discount phase change.
+func (p *Product) setDiscountPhase(phase DiscountPhase) {
+	if p.phase != phase {
+		p.phase = phase
+		p.changes.MarkDirty(FieldDiscountPhase)
+	}
+}
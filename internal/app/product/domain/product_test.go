@@ -1,6 +1,8 @@
 package domain_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +12,8 @@ import (
 	"github.com/product-catalog-service/internal/app/product/domain"
 )
 
+var lifecycle = domain.DefaultLifecycle()
+
 func TestNewProduct(t *testing.T) {
 	now := time.Now()
 	basePrice, _ := domain.NewMoney(1999, 100)
@@ -103,7 +107,11 @@ func TestProduct_Update(t *testing.T) {
 	require.NoError(t, err)
 	product.ClearEvents()
 
-	err = product.Update("New Name", "New Description", "Category2", now.Add(time.Hour))
+	err = product.Update(domain.ProductUpdate{
+		Name:        stringPtr("New Name"),
+		Description: stringPtr("New Description"),
+		Category:    stringPtr("Category2"),
+	}, now.Add(time.Hour))
 	require.NoError(t, err)
 
 	assert.Equal(t, "New Name", product.Name())
@@ -124,10 +132,97 @@ func TestProduct_Update(t *testing.T) {
 func TestProduct_UpdateArchived(t *testing.T) {
 	product := createArchivedProduct(t)
 
-	err := product.Update("New Name", "New Description", "Category", time.Now())
+	err := product.Update(domain.ProductUpdate{
+		Name:        stringPtr("New Name"),
+		Description: stringPtr("New Description"),
+		Category:    stringPtr("Category"),
+	}, time.Now())
 	assert.ErrorIs(t, err, domain.ErrCannotUpdateArchived)
 }
 
+func TestProduct_UpdatePartial(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+	product, err := domain.NewProduct("test-id", "Original Name", "Original Description", "Category1", basePrice, now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	// Clearing the description alone leaves name and category untouched
+	err = product.Update(domain.ProductUpdate{Description: stringPtr("")}, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Original Name", product.Name())
+	assert.Equal(t, "", product.Description())
+	assert.Equal(t, "Category1", product.Category())
+	assert.Equal(t, []string{domain.FieldDescription}, product.Changes().DirtyFields())
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	updated := events[0].(*domain.ProductUpdatedEvent)
+	assert.Equal(t, "Original Name", updated.Name)
+	assert.Equal(t, "Category1", updated.Category)
+
+	// Unset fields are not validated; set ones are
+	err = product.Update(domain.ProductUpdate{Name: stringPtr("")}, now)
+	assert.ErrorIs(t, err, domain.ErrEmptyProductName)
+
+	// An unchanged value is not dirty
+	product.Changes().Reset()
+	err = product.Update(domain.ProductUpdate{Category: stringPtr("Category1")}, now)
+	require.NoError(t, err)
+	assert.False(t, product.Changes().HasChanges())
+}
+
+func TestNewImportedProduct(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+
+	product, err := domain.NewImportedProduct("test-id", "SKU-1", "Product", "Description", "Category", basePrice, now)
+	require.NoError(t, err)
+	assert.Equal(t, "SKU-1", product.ExternalKey())
+
+	created := product.DomainEvents()[0].(*domain.ProductCreatedEvent)
+	assert.Equal(t, "SKU-1", created.ExternalKey)
+
+	_, err = domain.NewImportedProduct("test-id", strings.Repeat("k", domain.MaxExternalKeyLength+1), "Product", "", "Category", basePrice, now)
+	assert.ErrorIs(t, err, domain.ErrExternalKeyTooLong)
+
+	// The usual rules still apply
+	_, err = domain.NewImportedProduct("test-id", "SKU-1", "", "", "Category", basePrice, now)
+	assert.ErrorIs(t, err, domain.ErrEmptyProductName)
+}
+
+func TestProduct_ChangeBasePrice(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+	product, err := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
+	require.NoError(t, err)
+	product.ClearEvents()
+
+	newPrice, _ := domain.NewMoney(2499, 100)
+	err = product.ChangeBasePrice(newPrice, now)
+	require.NoError(t, err)
+
+	assert.True(t, product.BasePrice().Equals(newPrice))
+	assert.True(t, product.Changes().Dirty(domain.FieldBasePrice))
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	changed := events[0].(*domain.ProductBasePriceChangedEvent)
+	assert.True(t, changed.OldPrice.Equals(basePrice))
+	assert.True(t, changed.NewPrice.Equals(newPrice))
+
+	// The same price is a no-op
+	product.ClearEvents()
+	samePrice, _ := domain.NewMoney(4998, 200)
+	require.NoError(t, product.ChangeBasePrice(samePrice, now))
+	assert.Empty(t, product.DomainEvents())
+
+	err = product.ChangeBasePrice(domain.Zero(), now)
+	assert.ErrorIs(t, err, domain.ErrZeroPrice)
+}
+
 func TestProduct_Activate(t *testing.T) {
 	now := time.Now()
 	basePrice, _ := domain.NewMoney(1999, 100)
@@ -135,7 +230,7 @@ func TestProduct_Activate(t *testing.T) {
 	require.NoError(t, err)
 	product.ClearEvents()
 
-	err = product.Activate(now)
+	err = product.Activate(lifecycle, nil, now)
 	require.NoError(t, err)
 
 	assert.Equal(t, domain.ProductStatusActive, product.Status())
@@ -150,14 +245,14 @@ func TestProduct_Activate(t *testing.T) {
 func TestProduct_ActivateAlreadyActive(t *testing.T) {
 	product := createActiveProduct(t)
 
-	err := product.Activate(time.Now())
+	err := product.Activate(lifecycle, nil, time.Now())
 	assert.ErrorIs(t, err, domain.ErrProductAlreadyActive)
 }
 
 func TestProduct_ActivateArchived(t *testing.T) {
 	product := createArchivedProduct(t)
 
-	err := product.Activate(time.Now())
+	err := product.Activate(lifecycle, nil, time.Now())
 	assert.ErrorIs(t, err, domain.ErrCannotActivateArchived)
 }
 
@@ -165,7 +260,7 @@ func TestProduct_Deactivate(t *testing.T) {
 	product := createActiveProduct(t)
 	product.ClearEvents()
 
-	err := product.Deactivate(time.Now())
+	err := product.Deactivate(lifecycle, time.Now())
 	require.NoError(t, err)
 
 	assert.Equal(t, domain.ProductStatusInactive, product.Status())
@@ -179,7 +274,7 @@ func TestProduct_Deactivate(t *testing.T) {
 func TestProduct_DeactivateArchived(t *testing.T) {
 	product := createArchivedProduct(t)
 
-	err := product.Deactivate(time.Now())
+	err := product.Deactivate(lifecycle, time.Now())
 	assert.ErrorIs(t, err, domain.ErrCannotDeactivateArchived)
 }
 
@@ -190,7 +285,7 @@ func TestProduct_Archive(t *testing.T) {
 	require.NoError(t, err)
 	product.ClearEvents()
 
-	err = product.Archive(now)
+	err = product.Archive(lifecycle, now)
 	require.NoError(t, err)
 
 	assert.Equal(t, domain.ProductStatusArchived, product.Status())
@@ -205,10 +300,162 @@ func TestProduct_Archive(t *testing.T) {
 func TestProduct_ArchiveActive(t *testing.T) {
 	product := createActiveProduct(t)
 
-	err := product.Archive(time.Now())
+	err := product.Archive(lifecycle, time.Now())
 	assert.ErrorIs(t, err, domain.ErrCannotArchiveActive)
 }
 
+func TestProduct_Restore(t *testing.T) {
+	product := createArchivedProduct(t)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	err := product.Restore(24*time.Hour, product.ArchivedAt().Add(time.Hour))
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.ProductStatusInactive, product.Status())
+	assert.Nil(t, product.ArchivedAt())
+	assert.True(t, product.Changes().Dirty(domain.FieldStatus))
+	assert.True(t, product.Changes().Dirty(domain.FieldArchivedAt))
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "product.restored", events[0].EventType())
+}
+
+func TestProduct_RestoreNotArchived(t *testing.T) {
+	product := createActiveProduct(t)
+
+	err := product.Restore(24*time.Hour, time.Now())
+	assert.ErrorIs(t, err, domain.ErrProductNotArchived)
+}
+
+func TestProduct_RestoreAfterRetention(t *testing.T) {
+	product := createArchivedProduct(t)
+	archivedAt := *product.ArchivedAt()
+
+	err := product.Restore(24*time.Hour, archivedAt.Add(25*time.Hour))
+	assert.ErrorIs(t, err, domain.ErrRestoreWindowExpired)
+	assert.True(t, product.IsArchived())
+
+	// No retention limit
+	err = product.Restore(0, archivedAt.Add(365*24*time.Hour))
+	assert.NoError(t, err)
+}
+
+func TestProduct_Purge(t *testing.T) {
+	product := createArchivedProduct(t)
+	product.ClearEvents()
+	archivedAt := *product.ArchivedAt()
+
+	err := product.Purge(24*time.Hour, archivedAt.Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrPurgeRetentionNotElapsed)
+	assert.Empty(t, product.DomainEvents())
+
+	err = product.Purge(24*time.Hour, archivedAt.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "product.purged", events[0].EventType())
+}
+
+func TestProduct_PurgeNotArchived(t *testing.T) {
+	product := createActiveProduct(t)
+
+	err := product.Purge(0, time.Now())
+	assert.ErrorIs(t, err, domain.ErrProductNotArchived)
+}
+
+func TestProduct_Schedule(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+	product, err := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
+	require.NoError(t, err)
+	product.ClearEvents()
+
+	publishAt := now.Add(time.Hour)
+	unpublishAt := now.Add(2 * time.Hour)
+
+	err = product.Schedule(&unpublishAt, &publishAt, now)
+	assert.ErrorIs(t, err, domain.ErrInvalidSchedule)
+
+	err = product.Schedule(&publishAt, &unpublishAt, now)
+	require.NoError(t, err)
+	assert.True(t, product.PublishAt().Equal(publishAt))
+	assert.True(t, product.UnpublishAt().Equal(unpublishAt))
+	assert.True(t, product.Changes().Dirty(domain.FieldPublishAt))
+	assert.True(t, product.Changes().Dirty(domain.FieldUnpublishAt))
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "product.scheduled", events[0].EventType())
+
+	// Same schedule again is a no-op
+	product.ClearEvents()
+	require.NoError(t, product.Schedule(&publishAt, &unpublishAt, now))
+	assert.Empty(t, product.DomainEvents())
+}
+
+func TestProduct_ScheduleArchived(t *testing.T) {
+	product := createArchivedProduct(t)
+	publishAt := time.Now().Add(time.Hour)
+
+	err := product.Schedule(&publishAt, nil, time.Now())
+	assert.ErrorIs(t, err, domain.ErrCannotScheduleArchived)
+}
+
+func TestProduct_RunSchedule(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+	product, err := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
+	require.NoError(t, err)
+
+	publishAt := now.Add(time.Hour)
+	unpublishAt := now.Add(2 * time.Hour)
+	require.NoError(t, product.Schedule(&publishAt, &unpublishAt, now))
+	product.ClearEvents()
+
+	// Nothing due yet
+	fired, err := product.RunSchedule(lifecycle, nil, now)
+	require.NoError(t, err)
+	assert.False(t, fired)
+
+	fired, err = product.RunSchedule(lifecycle, nil, publishAt)
+	require.NoError(t, err)
+	assert.True(t, fired)
+	assert.True(t, product.IsActive())
+	assert.Nil(t, product.PublishAt())
+
+	// A fired time never fires again
+	fired, err = product.RunSchedule(lifecycle, nil, publishAt.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, fired)
+
+	fired, err = product.RunSchedule(lifecycle, nil, unpublishAt)
+	require.NoError(t, err)
+	assert.True(t, fired)
+	assert.Equal(t, domain.ProductStatusInactive, product.Status())
+	assert.Nil(t, product.UnpublishAt())
+
+	events := product.DomainEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, "product.activated", events[0].EventType())
+	assert.Equal(t, "product.deactivated", events[1].EventType())
+}
+
+func TestProduct_ArchiveClearsSchedule(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+	product, err := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
+	require.NoError(t, err)
+
+	publishAt := now.Add(time.Hour)
+	require.NoError(t, product.Schedule(&publishAt, nil, now))
+	require.NoError(t, product.Archive(lifecycle, now))
+
+	assert.Nil(t, product.PublishAt())
+}
+
 func TestProduct_ApplyDiscount(t *testing.T) {
 	product := createActiveProduct(t)
 	product.ClearEvents()
@@ -262,11 +509,98 @@ func TestProduct_RemoveNonExistentDiscount(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrNoDiscountToRemove)
 }
 
+func TestProduct_AdvanceDiscountPhase(t *testing.T) {
+	now := time.Now()
+	product := createActiveProduct(t)
+	discount, err := domain.NewDiscount(20, now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount, now))
+	product.ClearEvents()
+	assert.Equal(t, domain.DiscountPhasePending, product.DiscountPhase())
+
+	// Not started yet
+	assert.False(t, product.AdvanceDiscountPhase(now))
+
+	assert.True(t, product.AdvanceDiscountPhase(now.Add(time.Hour)))
+	assert.Equal(t, domain.DiscountPhaseActive, product.DiscountPhase())
+	assert.True(t, product.Changes().Dirty(domain.FieldDiscountPhase))
+
+	// Each boundary is announced once
+	assert.False(t, product.AdvanceDiscountPhase(now.Add(90*time.Minute)))
+
+	assert.True(t, product.AdvanceDiscountPhase(now.Add(3*time.Hour)))
+	assert.Equal(t, domain.DiscountPhaseExpired, product.DiscountPhase())
+	assert.False(t, product.AdvanceDiscountPhase(now.Add(4*time.Hour)))
+
+	events := product.DomainEvents()
+	require.Len(t, events, 2)
+	started, ok := events[0].(*domain.DiscountStartedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "product.discount_started", started.EventType())
+	assert.True(t, started.EffectivePrice.Equals(product.BasePrice().SubtractPercentage(20)))
+	expired, ok := events[1].(*domain.DiscountExpiredEvent)
+	require.True(t, ok)
+	assert.Equal(t, "product.discount_expired", expired.EventType())
+	assert.True(t, expired.EffectivePrice.Equals(product.BasePrice()))
+}
+
+func TestProduct_AdvanceDiscountPhaseMissedStart(t *testing.T) {
+	now := time.Now()
+	product := createActiveProduct(t)
+	discount, err := domain.NewDiscount(20, now, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount, now))
+	product.ClearEvents()
+
+	// Whole window passed unobserved: only the expiry is announced
+	assert.True(t, product.AdvanceDiscountPhase(now.Add(2*time.Hour)))
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "product.discount_expired", events[0].EventType())
+}
+
+func TestProduct_AdvanceDiscountPhaseWithCampaign(t *testing.T) {
+	now := time.Now()
+	product := createActiveProduct(t)
+	discount, err := domain.NewDiscount(20, now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount, now))
+	product.ClearEvents()
+
+	campaignDiscount, err := domain.NewDiscount(30, now, now.Add(4*time.Hour))
+	require.NoError(t, err)
+	campaign, err := domain.NewCampaign("campaign-id", "Weekend", campaignDiscount,
+		domain.CampaignSelection{ProductIDs: []string{product.ID()}}, now)
+	require.NoError(t, err)
+
+	// The campaign beats the product's own discount at both boundaries
+	assert.True(t, product.AdvanceDiscountPhase(now.Add(time.Hour), campaign))
+	assert.True(t, product.AdvanceDiscountPhase(now.Add(3*time.Hour), campaign))
+
+	events := product.DomainEvents()
+	require.Len(t, events, 2)
+	started, ok := events[0].(*domain.DiscountStartedEvent)
+	require.True(t, ok)
+	assert.True(t, started.EffectivePrice.Equals(product.BasePrice().SubtractPercentage(30)))
+	expired, ok := events[1].(*domain.DiscountExpiredEvent)
+	require.True(t, ok)
+	assert.True(t, expired.EffectivePrice.Equals(product.BasePrice().SubtractPercentage(30)))
+}
+
+func TestProduct_RemoveDiscountResetsPhase(t *testing.T) {
+	product := createProductWithDiscount(t)
+	require.NoError(t, product.RemoveDiscount(time.Now()))
+
+	assert.Equal(t, domain.DiscountPhaseNone, product.DiscountPhase())
+	assert.False(t, product.AdvanceDiscountPhase(time.Now()))
+}
+
 func TestProduct_EffectivePrice(t *testing.T) {
 	now := time.Now()
 	basePrice, _ := domain.NewMoney(10000, 100) // $100.00
 	product, _ := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
-	product.Activate(now)
+	product.Activate(lifecycle, nil, now)
 
 	// Without discount
 	effectivePrice := product.EffectivePrice(now)
@@ -284,7 +618,7 @@ func TestProduct_EffectivePriceExpiredDiscount(t *testing.T) {
 	now := time.Now()
 	basePrice, _ := domain.NewMoney(10000, 100) // $100.00
 	product, _ := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
-	product.Activate(now)
+	product.Activate(lifecycle, nil, now)
 
 	// Apply discount that was valid yesterday
 	discount, _ := domain.NewDiscount(20, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
@@ -295,14 +629,109 @@ func TestProduct_EffectivePriceExpiredDiscount(t *testing.T) {
 	assert.Equal(t, "100.00", effectivePrice.String())
 }
 
+func TestProduct_Version(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+
+	created, err := domain.NewProduct("test-id", "Product", "Description", "Category", basePrice, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), created.Version())
+
+	product := domain.Reconstitute(
+		"test-id", "Product", "Description", "Category", basePrice,
+		nil, domain.DiscountPhaseNone, domain.ProductStatusDraft,
+		now, now, nil, nil, nil, 3, "", "", nil,
+	)
+	assert.Equal(t, int64(3), product.Version())
+
+	// Pending changes are the next revision, however many fields they touch
+	err = product.Update(domain.ProductUpdate{Name: stringPtr("Renamed")}, now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(lifecycle, nil, now))
+	assert.Equal(t, int64(4), product.Version())
+}
+
+func TestProduct_CheckVersion(t *testing.T) {
+	now := time.Now()
+	basePrice, _ := domain.NewMoney(1999, 100)
+
+	product := domain.Reconstitute(
+		"test-id", "Product", "Description", "Category", basePrice,
+		nil, domain.DiscountPhaseNone, domain.ProductStatusDraft,
+		now, now, nil, nil, nil, 3, "", "", nil,
+	)
+	assert.NoError(t, product.CheckVersion(0))
+	assert.NoError(t, product.CheckVersion(3))
+	assert.ErrorIs(t, product.CheckVersion(2), domain.ErrVersionMismatch)
+
+	// The check is against the loaded revision, not the pending one
+	require.NoError(t, product.Update(domain.ProductUpdate{Name: stringPtr("Renamed")}, now))
+	assert.NoError(t, product.CheckVersion(3))
+}
+
+func TestProduct_UpdateAttributes(t *testing.T) {
+	now := time.Now()
+	product := createActiveProduct(t)
+	product.ClearEvents()
+
+	attributes := map[string]string{"brand": "Acme", "color": "red"}
+	require.NoError(t, product.Update(domain.ProductUpdate{Attributes: attributes}, now))
+	assert.Equal(t, attributes, product.Attributes())
+	assert.True(t, product.Changes().Dirty(domain.FieldAttributes))
+
+	events := product.DomainEvents()
+	require.Len(t, events, 1)
+	updated, ok := events[0].(*domain.ProductUpdatedEvent)
+	require.True(t, ok)
+	assert.Equal(t, attributes, updated.Attributes)
+
+	// The product keeps its own copy
+	attributes["brand"] = "Other"
+	assert.Equal(t, "Acme", product.Attributes()["brand"])
+
+	// The same attributes are not a change; an empty map removes them
+	product.ClearEvents()
+	require.NoError(t, product.Update(domain.ProductUpdate{Attributes: map[string]string{"brand": "Acme", "color": "red"}}, now))
+	assert.Empty(t, product.DomainEvents())
+	require.NoError(t, product.Update(domain.ProductUpdate{Attributes: map[string]string{}}, now))
+	assert.Nil(t, product.Attributes())
+	assert.Len(t, product.DomainEvents(), 1)
+
+	tooMany := make(map[string]string, domain.MaxAttributes+1)
+	for i := 0; i <= domain.MaxAttributes; i++ {
+		tooMany[fmt.Sprintf("attribute-%d", i)] = "value"
+	}
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		wantErr    error
+	}{
+		{"too many", tooMany, domain.ErrTooManyAttributes},
+		{"empty name", map[string]string{"": "value"}, domain.ErrInvalidAttributeName},
+		{"long name", map[string]string{strings.Repeat("n", domain.MaxAttributeNameLength+1): "value"}, domain.ErrInvalidAttributeName},
+		{"long value", map[string]string{"brand": strings.Repeat("v", domain.MaxAttributeValueLength+1)}, domain.ErrAttributeTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := product.Update(domain.ProductUpdate{Attributes: tt.attributes}, now)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, product.Attributes())
+		})
+	}
+}
+
 // Helper functions
 
+func stringPtr(s string) *string {
+	return &s
+}
+
 func createActiveProduct(t *testing.T) *domain.Product {
 	now := time.Now()
 	basePrice, _ := domain.NewMoney(1999, 100)
 	product, err := domain.NewProduct("test-id", "Active Product", "Description", "Category", basePrice, now)
 	require.NoError(t, err)
-	err = product.Activate(now)
+	err = product.Activate(lifecycle, nil, now)
 	require.NoError(t, err)
 	return product
 }
@@ -312,7 +741,7 @@ func createArchivedProduct(t *testing.T) *domain.Product {
 	basePrice, _ := domain.NewMoney(1999, 100)
 	product, err := domain.NewProduct("test-id", "Archived Product", "Description", "Category", basePrice, now)
 	require.NoError(t, err)
-	err = product.Archive(now)
+	err = product.Archive(lifecycle, now)
 	require.NoError(t, err)
 	return product
 }
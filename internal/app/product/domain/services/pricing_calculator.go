@@ -15,9 +15,10 @@ func NewPricingCalculator() *PricingCalculator {
 	return &PricingCalculator{}
 }
 
-// CalculateEffectivePrice calculates the effective price of a product at a given time.
-func (pc *PricingCalculator) CalculateEffectivePrice(product *domain.Product, now time.Time) *domain.Money {
-	return product.EffectivePrice(now)
+// CalculateEffectivePrice calculates the effective price of a product at a
+// given time, considering the campaigns running then.
+func (pc *PricingCalculator) CalculateEffectivePrice(product *domain.Product, now time.Time, campaigns ...*domain.Campaign) *domain.Money {
+	return product.EffectivePrice(now, campaigns...)
 }
 
 // CalculateDiscountAmount calculates the discount amount in money.
@@ -85,3 +86,60 @@ func (pc *PricingCalculator) ValidateDiscountApplication(
 
 	return nil
 }
+
+// CouponLine is the price of one product with a coupon.
+type CouponLine struct {
+	ProductID string
+	// EffectivePrice is the price before the coupon, nil when the product
+	// does not exist.
+	EffectivePrice *domain.Money
+	// DiscountedPrice is the price with the coupon. It equals EffectivePrice
+	// when the coupon does not apply.
+	DiscountedPrice *domain.Money
+	// Err is why the coupon does not apply to the product, or nil.
+	Err error
+}
+
+// PriceWithCoupon prices the given products with a coupon, in order. The
+// coupon comes on top of each product's effective price, so it adds to the
+// product's own discount or campaign. Products absent from products are
+// reported with ErrProductNotFound.
+func (pc *PricingCalculator) PriceWithCoupon(
+	coupon *domain.Coupon,
+	productIDs []string,
+	products map[string]*domain.Product,
+	now time.Time,
+	campaigns ...*domain.Campaign,
+) []CouponLine {
+	lines := make([]CouponLine, len(productIDs))
+	for i, id := range productIDs {
+		lines[i].ProductID = id
+
+		product, ok := products[id]
+		if !ok {
+			lines[i].Err = domain.ErrProductNotFound
+			continue
+		}
+
+		price := pc.CalculateEffectivePrice(product, now, campaigns...)
+		lines[i].EffectivePrice = price
+		lines[i].DiscountedPrice = price
+		if err := coupon.CheckApplicable(product); err != nil {
+			lines[i].Err = err
+			continue
+		}
+		lines[i].DiscountedPrice = coupon.Rule().Apply(price)
+	}
+	return lines
+}
+
+// EligibleProductIDs returns the products of lines the coupon applies to.
+func EligibleProductIDs(lines []CouponLine) []string {
+	ids := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line.Err == nil {
+			ids = append(ids, line.ProductID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,152 @@
+package projections
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// DefaultBatchSize is the number of outbox events handled per transaction.
+const DefaultBatchSize = 100
+
+// lagSeconds reports the age of the oldest unprojected outbox event.
+// It is published on /debug/vars when the metrics endpoint is enabled.
+var lagSeconds = expvar.NewFloat("projection_lag_seconds")
+
+// Projection turns outbox events into read model mutations.
+type Projection interface {
+	// Name identifies the projection in logs.
+	Name() string
+
+	// ProjectMuts returns the mutations that bring the read model up to date
+	// with the given events. It runs inside the transaction that marks the
+	// events as projected.
+	ProjectMuts(ctx context.Context, txn *spanner.ReadWriteTransaction, events []*contracts.OutboxEvent) ([]*spanner.Mutation, error)
+}
+
+// Dispatcher feeds unprojected outbox events to the registered projections.
+// It records its progress in the events' projected_at column and leaves
+// their delivery status to the publisher.
+type Dispatcher struct {
+	outbox      contracts.OutboxReadRepository
+	committer   committer.TransactionalCommitter
+	clock       clock.Clock
+	projections []Projection
+	batchSize   int
+}
+
+// NewDispatcher creates a new Dispatcher for the given projections.
+func NewDispatcher(
+	outbox contracts.OutboxReadRepository,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+	projections ...Projection,
+) *Dispatcher {
+	return &Dispatcher{
+		outbox:      outbox,
+		committer:   committer,
+		clock:       clock,
+		projections: projections,
+		batchSize:   DefaultBatchSize,
+	}
+}
+
+// ProcessBatch projects one batch of unprojected events and marks them projected.
+// It returns the number of events handled.
+func (d *Dispatcher) ProcessBatch(ctx context.Context) (int, error) {
+	events, err := d.outbox.ListUnprojected(ctx, d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	err = d.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		plan := committer.NewPlan()
+
+		for _, projection := range d.projections {
+			muts, err := projection.ProjectMuts(ctx, txn, events)
+			if err != nil {
+				return nil, err
+			}
+			plan.AddAll(muts...)
+		}
+
+		projectedAt := d.clock.Now()
+		for _, event := range events {
+			plan.Add(d.outbox.MarkProjectedMut(event.ID, projectedAt))
+		}
+
+		return plan, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(events), nil
+}
+
+// Drain processes batches until every outbox event has been projected.
+// It returns the total number of events handled.
+func (d *Dispatcher) Drain(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, err := d.ProcessBatch(ctx)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < d.batchSize {
+			return total, nil
+		}
+	}
+}
+
+// Lag returns the age of the oldest unprojected outbox event, which bounds how
+// stale the read models are. It also updates the published lag metric.
+func (d *Dispatcher) Lag(ctx context.Context) (time.Duration, error) {
+	oldest, err := d.outbox.OldestUnprojectedAt(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var lag time.Duration
+	if oldest != nil {
+		lag = d.clock.Now().Sub(*oldest)
+		if lag < 0 {
+			lag = 0
+		}
+	}
+
+	lagSeconds.Set(lag.Seconds())
+	return lag, nil
+}
+
+// Run drains the outbox every interval until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.Drain(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("projections: failed to process outbox: %v", err)
+		}
+		if _, err := d.Lag(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("projections: failed to measure lag: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,18 @@
+// Package projections keeps the CQRS read models in sync with the write side.
+//
+// Projections consume the transactional outbox rather than being called from
+// use cases, so a command never waits on (or fails because of) a read model.
+// The Dispatcher polls outbox events that have not been projected yet, lets
+// every registered Projection turn them into mutations, and sets the events'
+// projected_at in the same read-write transaction. The status column is left
+// alone: it tracks delivery to external consumers, which the projections must
+// not pre-empt.
+//
+// Projections are state-based: for each affected aggregate they re-read the
+// current row inside the transaction and overwrite the read model. Handling an
+// event twice, or out of order, therefore converges to the same result, which
+// makes it safe to run the dispatcher on several replicas.
+//
+// Available projections:
+//   - product_view: Maintains the denormalized product_views table
+package projections
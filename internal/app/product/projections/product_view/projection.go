@@ -0,0 +1,145 @@
+package product_view
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// replayBatchSize is the number of products rewritten per replay transaction.
+const replayBatchSize = 200
+
+// productEventPrefix selects the outbox events emitted by the Product aggregate.
+const productEventPrefix = "product."
+
+// Projection maintains the product_views table.
+type Projection struct {
+	productRepo *repo.ProductRepo
+	viewRepo    *repo.ProductViewRepo
+	committer   committer.TransactionalCommitter
+	clock       clock.Clock
+}
+
+// NewProjection creates a new product view projection.
+func NewProjection(
+	productRepo *repo.ProductRepo,
+	viewRepo *repo.ProductViewRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Projection {
+	return &Projection{
+		productRepo: productRepo,
+		viewRepo:    viewRepo,
+		committer:   committer,
+		clock:       clock,
+	}
+}
+
+// Name identifies the projection in logs.
+func (p *Projection) Name() string {
+	return "product_views"
+}
+
+// ProjectMuts re-projects every product touched by the events.
+func (p *Projection) ProjectMuts(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	events []*contracts.OutboxEvent,
+) ([]*spanner.Mutation, error) {
+	ids := make([]string, 0, len(events))
+	seen := make(map[string]bool, len(events))
+
+	for _, event := range events {
+		if !strings.HasPrefix(event.EventType, productEventPrefix) || seen[event.AggregateID] {
+			continue
+		}
+		seen[event.AggregateID] = true
+		ids = append(ids, event.AggregateID)
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return p.projectMuts(ctx, txn, ids)
+}
+
+// Replay rebuilds the product_views table from the products table.
+// Rows are rewritten in place so readers never see an empty view, and rows
+// whose product no longer exists are swept afterwards.
+// Returns the number of products projected.
+func (p *Projection) Replay(ctx context.Context) (int, error) {
+	startedAt := p.clock.Now()
+	projected := 0
+
+	batch := make([]string, 0, replayBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := p.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+			muts, err := p.projectMuts(ctx, txn, batch)
+			if err != nil {
+				return nil, err
+			}
+			plan := committer.NewPlan()
+			plan.AddAll(muts...)
+			return plan, nil
+		})
+		if err != nil {
+			return err
+		}
+		projected += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	err := p.productRepo.ForEachID(ctx, func(id string) error {
+		batch = append(batch, id)
+		if len(batch) < replayBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return projected, err
+	}
+	if err := flush(); err != nil {
+		return projected, err
+	}
+
+	if _, err := p.viewRepo.DeleteProjectedBefore(ctx, startedAt); err != nil {
+		return projected, err
+	}
+
+	return projected, nil
+}
+
+// projectMuts reads the products inside the transaction and returns view
+// mutations: an upsert for existing products and a delete for missing ones.
+func (p *Projection) projectMuts(ctx context.Context, txn *spanner.ReadWriteTransaction, ids []string) ([]*spanner.Mutation, error) {
+	products, err := p.productRepo.GetByIDsWithTxn(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	now := p.clock.Now()
+	muts := make([]*spanner.Mutation, 0, len(ids))
+
+	for _, id := range ids {
+		product, ok := products[id]
+		if !ok {
+			muts = append(muts, p.viewRepo.DeleteMut(id))
+			continue
+		}
+		muts = append(muts, p.viewRepo.UpsertMut(product, now))
+	}
+
+	return muts, nil
+}
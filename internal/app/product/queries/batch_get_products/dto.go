@@ -0,0 +1,12 @@
+package batch_get_products
+
+import (
+	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+)
+
+// BatchResultDTO represents the result of a batch get.
+// Products are in request order; MissingIDs lists requested IDs that don't exist.
+type BatchResultDTO struct {
+	Products   []*get_product.ProductDTO
+	MissingIDs []string
+}
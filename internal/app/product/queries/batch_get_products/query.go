@@ -0,0 +1,70 @@
+package batch_get_products
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+)
+
+// MaxBatchSize is the maximum number of distinct product IDs per request.
+const MaxBatchSize = 200
+
+var (
+	ErrNoProductIDs      = errors.New("at least one product_id is required")
+	ErrTooManyProductIDs = fmt.Errorf("at most %d product_ids may be requested at once", MaxBatchSize)
+)
+
+// Request represents the input for getting several products.
+type Request struct {
+	ProductIDs []string
+}
+
+// Query handles the batch get products query.
+type Query struct {
+	readModel contracts.ProductReadModelRepository
+}
+
+// NewQuery creates a new batch get products query handler.
+func NewQuery(readModel contracts.ProductReadModelRepository) *Query {
+	return &Query{
+		readModel: readModel,
+	}
+}
+
+// Execute retrieves products by ID with a single read. Duplicate IDs are
+// collapsed to their first occurrence; missing products don't fail the batch.
+func (q *Query) Execute(ctx context.Context, req Request) (*BatchResultDTO, error) {
+	ids := domain.Dedupe(req.ProductIDs)
+	if len(ids) == 0 {
+		return nil, ErrNoProductIDs
+	}
+	if len(ids) > MaxBatchSize {
+		return nil, ErrTooManyProductIDs
+	}
+
+	found, err := q.readModel.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BatchResultDTO{
+		Products:   make([]*get_product.ProductDTO, 0, len(found)),
+		MissingIDs: make([]string, 0),
+	}
+
+	// Restore request order
+	for _, id := range ids {
+		product, ok := found[id]
+		if !ok {
+			result.MissingIDs = append(result.MissingIDs, id)
+			continue
+		}
+		result.Products = append(result.Products, get_product.MapToDTO(product))
+	}
+
+	return result, nil
+}
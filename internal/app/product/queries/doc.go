@@ -6,7 +6,16 @@
 //
 // Available queries:
 //   - get_product: Retrieve a single product by ID with effective price calculation
-//   - list_products: Paginated listing with filtering by category and status
+//   - batch_get_products: Up to 200 products by ID in a single read, in request order
+//   - list_products: Paginated listing with filtering by category, category subtree and status
+//   - get_product_facets: Category, status, discount and price band counts for a filter set
+//   - search_products: Keyword search with relevance ranking over the product_views read model
+//   - export_products: The whole filtered catalog at one read timestamp, read in parallel partitions
+//   - get_bulk_operation: Progress and per-product failures of a bulk operation
+//   - get_campaign: Retrieve a promotion campaign with its selection and current status
+//   - list_campaigns: Campaigns newest first, optionally by scheduled, running or ended status
+//   - get_category: Retrieve a node of the category tree
+//   - list_categories: Categories by slug, the whole tree or the children of one category
 //
 // Query handlers are stateless and produce no side effects.
 package queries
@@ -0,0 +1,43 @@
+package export_products
+
+import (
+	"time"
+)
+
+// BatchDTO is a batch of exported products. ReadTimestamp is the same for
+// every batch of an export.
+type BatchDTO struct {
+	ReadTimestamp time.Time
+	Products      []*ProductDTO
+}
+
+// ResultDTO summarizes a completed export.
+type ResultDTO struct {
+	ReadTimestamp time.Time
+	Products      int
+}
+
+// ProductDTO represents an exported product.
+type ProductDTO struct {
+	ID                   string
+	Name                 string
+	Description          string
+	Category             string
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	EffectivePriceNum    int64
+	EffectivePriceDenom  int64
+	DiscountPercent      *int64
+	DiscountStartDate    *time.Time
+	DiscountEndDate      *time.Time
+	Status               string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	ArchivedAt           *time.Time
+	PublishAt            *time.Time
+	UnpublishAt          *time.Time
+	Version              int64
+	ExternalKey          string
+	CategoryID           string
+	Attributes           map[string]string
+}
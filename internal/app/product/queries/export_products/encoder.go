@@ -0,0 +1,219 @@
+package export_products
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// Format is the encoding of an export.
+type Format string
+
+const (
+	// FormatCSV is comma-separated values with a header row.
+	FormatCSV Format = "csv"
+	// FormatNDJSON is one JSON object per line.
+	FormatNDJSON Format = "ndjson"
+)
+
+// ErrUnsupportedFormat is returned for an unknown export format.
+var ErrUnsupportedFormat = errors.New("unsupported export format: expected csv or ndjson")
+
+// Columns are the CSV header and NDJSON keys of an export, in CSV order.
+// external_key, name, description, category and price are the columns
+// import_products reads, so an export can be imported again.
+var Columns = []string{
+	"id",
+	"external_key",
+	"name",
+	"description",
+	"category",
+	"status",
+	"price",
+	"effective_price",
+	"discount_percent",
+	"discount_start_date",
+	"discount_end_date",
+	"created_at",
+	"updated_at",
+	"archived_at",
+	"publish_at",
+	"unpublish_at",
+	"version",
+}
+
+// Encoder writes exported products to a writer.
+type Encoder interface {
+	// Encode writes a batch of products.
+	Encode(products []*ProductDTO) error
+	// Flush writes anything buffered, including the CSV header of an empty export.
+	Flush() error
+}
+
+// NewEncoder returns an encoder for the given format.
+func NewEncoder(w io.Writer, format Format) (Encoder, error) {
+	switch format {
+	case FormatCSV:
+		return &csvEncoder{writer: csv.NewWriter(w)}, nil
+	case FormatNDJSON:
+		return &ndjsonEncoder{encoder: json.NewEncoder(w)}, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+type csvEncoder struct {
+	writer      *csv.Writer
+	wroteHeader bool
+}
+
+func (e *csvEncoder) Encode(products []*ProductDTO) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	for _, p := range products {
+		discountPercent := ""
+		if p.DiscountPercent != nil {
+			discountPercent = strconv.FormatInt(*p.DiscountPercent, 10)
+		}
+
+		record := []string{
+			p.ID,
+			p.ExternalKey,
+			p.Name,
+			p.Description,
+			p.Category,
+			p.Status,
+			decimalString(p.BasePriceNumerator, p.BasePriceDenominator),
+			decimalString(p.EffectivePriceNum, p.EffectivePriceDenom),
+			discountPercent,
+			formatTime(p.DiscountStartDate),
+			formatTime(p.DiscountEndDate),
+			formatTime(&p.CreatedAt),
+			formatTime(&p.UpdatedAt),
+			formatTime(p.ArchivedAt),
+			formatTime(p.PublishAt),
+			formatTime(p.UnpublishAt),
+			strconv.FormatInt(p.Version, 10),
+		}
+		if err := e.writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) Flush() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) writeHeader() error {
+	if e.wroteHeader {
+		return nil
+	}
+	e.wroteHeader = true
+	return e.writer.Write(Columns)
+}
+
+// ndjsonProduct is the JSON shape of an exported product. Unset optional
+// fields are omitted.
+type ndjsonProduct struct {
+	ID                string            `json:"id"`
+	ExternalKey       string            `json:"external_key,omitempty"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	Category          string            `json:"category"`
+	CategoryID        string            `json:"category_id,omitempty"`
+	Status            string            `json:"status"`
+	Price             string            `json:"price"`
+	EffectivePrice    string            `json:"effective_price"`
+	DiscountPercent   *int64            `json:"discount_percent,omitempty"`
+	DiscountStartDate string            `json:"discount_start_date,omitempty"`
+	DiscountEndDate   string            `json:"discount_end_date,omitempty"`
+	CreatedAt         string            `json:"created_at"`
+	UpdatedAt         string            `json:"updated_at"`
+	ArchivedAt        string            `json:"archived_at,omitempty"`
+	PublishAt         string            `json:"publish_at,omitempty"`
+	UnpublishAt       string            `json:"unpublish_at,omitempty"`
+	Version           int64             `json:"version"`
+	Attributes        map[string]string `json:"attributes,omitempty"`
+}
+
+type ndjsonEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonEncoder) Encode(products []*ProductDTO) error {
+	for _, p := range products {
+		err := e.encoder.Encode(ndjsonProduct{
+			ID:                p.ID,
+			ExternalKey:       p.ExternalKey,
+			Name:              p.Name,
+			Description:       p.Description,
+			Category:          p.Category,
+			CategoryID:        p.CategoryID,
+			Attributes:        p.Attributes,
+			Status:            p.Status,
+			Price:             decimalString(p.BasePriceNumerator, p.BasePriceDenominator),
+			EffectivePrice:    decimalString(p.EffectivePriceNum, p.EffectivePriceDenom),
+			DiscountPercent:   p.DiscountPercent,
+			DiscountStartDate: formatTime(p.DiscountStartDate),
+			DiscountEndDate:   formatTime(p.DiscountEndDate),
+			CreatedAt:         formatTime(&p.CreatedAt),
+			UpdatedAt:         formatTime(&p.UpdatedAt),
+			ArchivedAt:        formatTime(p.ArchivedAt),
+			PublishAt:         formatTime(p.PublishAt),
+			UnpublishAt:       formatTime(p.UnpublishAt),
+			Version:           p.Version,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *ndjsonEncoder) Flush() error {
+	return nil
+}
+
+// maxDecimals bounds the digits written for prices without an exact
+// decimal form, such as a third.
+const maxDecimals = 12
+
+// decimalString formats num/denom as a decimal with at least two digits,
+// using as many more as needed to be exact.
+func decimalString(num, denom int64) string {
+	if denom == 0 {
+		return ""
+	}
+	amount := big.NewRat(num, denom)
+
+	ten := big.NewRat(10, 1)
+	scaled := new(big.Rat).Mul(amount, big.NewRat(100, 1))
+	for digits := 2; digits < maxDecimals; digits++ {
+		if scaled.IsInt() {
+			return amount.FloatString(digits)
+		}
+		scaled.Mul(scaled, ten)
+	}
+	return amount.FloatString(maxDecimals)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
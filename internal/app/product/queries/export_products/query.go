@@ -0,0 +1,156 @@
+package export_products
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+const (
+	// DefaultParallelism is how many partitions are read at once by default.
+	DefaultParallelism = 4
+	// MaxParallelism caps the partitions read at once by a single export.
+	MaxParallelism = 16
+)
+
+var ErrInvalidParallelism = errors.New("invalid parallelism: expected 0 (default) to 16")
+
+// Request represents the input for exporting products.
+// The filters have the same meaning as in list_products.
+type Request struct {
+	Category   *string
+	Status     *string
+	ActiveOnly bool
+
+	// ArchivedMode behaves as in list_products.
+	ArchivedMode contracts.ArchivedMode
+
+	MinBasePrice       *big.Rat
+	MaxBasePrice       *big.Rat
+	MinEffectivePrice  *big.Rat
+	MaxEffectivePrice  *big.Rat
+	OnSaleAt           *time.Time
+	MinDiscountPercent *int64
+
+	// CategoryID and IncludeDescendants behave as in list_products.
+	CategoryID         *string
+	IncludeDescendants bool
+
+	// Parallelism is how many partitions are read at once. Defaults to
+	// DefaultParallelism when zero.
+	Parallelism int
+}
+
+// Query handles the export products query.
+type Query struct {
+	readModel  contracts.ProductReadModelRepository
+	categories contracts.CategoryReadRepository
+}
+
+// NewQuery creates a new export products query handler.
+func NewQuery(readModel contracts.ProductReadModelRepository, categories contracts.CategoryReadRepository) *Query {
+	return &Query{
+		readModel:  readModel,
+		categories: categories,
+	}
+}
+
+// Execute streams every product matching the request filters to fn, a batch
+// at a time. All batches are read at the same timestamp, reported with each
+// of them and in the result. Products are not sorted.
+func (q *Query) Execute(ctx context.Context, req Request, fn func(batch *BatchDTO) error) (*ResultDTO, error) {
+	archivedMode, err := contracts.ResolveArchivedMode(req.ArchivedMode, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := contracts.ValidatePriceRanges(req.MinBasePrice, req.MaxBasePrice, req.MinEffectivePrice, req.MaxEffectivePrice); err != nil {
+		return nil, err
+	}
+
+	parallelism := req.Parallelism
+	if parallelism == 0 {
+		parallelism = DefaultParallelism
+	}
+	if parallelism < 1 || parallelism > MaxParallelism {
+		return nil, ErrInvalidParallelism
+	}
+
+	filters := contracts.ProductListFilters{
+		Category:           req.Category,
+		Status:             req.Status,
+		ActiveOnly:         req.ActiveOnly,
+		ArchivedMode:       archivedMode,
+		MinBasePrice:       req.MinBasePrice,
+		MaxBasePrice:       req.MaxBasePrice,
+		MinEffectivePrice:  req.MinEffectivePrice,
+		MaxEffectivePrice:  req.MaxEffectivePrice,
+		OnSaleAt:           req.OnSaleAt,
+		MinDiscountPercent: req.MinDiscountPercent,
+	}
+
+	if req.CategoryID != nil {
+		filters.CategoryIDs = []string{*req.CategoryID}
+		if req.IncludeDescendants {
+			filters.CategoryIDs, err = q.categories.SubtreeIDs(ctx, *req.CategoryID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result := &ResultDTO{}
+	readTimestamp, err := q.readModel.Export(ctx, filters, parallelism, func(readTimestamp time.Time, products []*contracts.ProductReadModel) error {
+		result.Products += len(products)
+		return fn(mapToBatchDTO(readTimestamp, products))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.ReadTimestamp = readTimestamp
+	return result, nil
+}
+
+func mapToBatchDTO(readTimestamp time.Time, products []*contracts.ProductReadModel) *BatchDTO {
+	batch := &BatchDTO{
+		ReadTimestamp: readTimestamp,
+		Products:      make([]*ProductDTO, len(products)),
+	}
+
+	for i, rm := range products {
+		dto := &ProductDTO{
+			ID:                   rm.ID,
+			Name:                 rm.Name,
+			Description:          rm.Description,
+			Category:             rm.Category,
+			BasePriceNumerator:   rm.BasePriceNumerator,
+			BasePriceDenominator: rm.BasePriceDenominator,
+			EffectivePriceNum:    rm.EffectivePriceNum,
+			EffectivePriceDenom:  rm.EffectivePriceDenom,
+			Status:               rm.Status,
+			CreatedAt:            rm.CreatedAt,
+			UpdatedAt:            rm.UpdatedAt,
+			ArchivedAt:           rm.ArchivedAt,
+			PublishAt:            rm.PublishAt,
+			UnpublishAt:          rm.UnpublishAt,
+			Version:              rm.Version,
+			ExternalKey:          rm.ExternalKey,
+			CategoryID:           rm.CategoryID,
+			Attributes:           rm.Attributes,
+		}
+
+		if rm.DiscountPercent != nil {
+			dto.DiscountPercent = rm.DiscountPercent
+			dto.DiscountStartDate = rm.DiscountStartDate
+			dto.DiscountEndDate = rm.DiscountEndDate
+		}
+
+		batch.Products[i] = dto
+	}
+
+	return batch
+}
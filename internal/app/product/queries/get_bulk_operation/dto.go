@@ -0,0 +1,33 @@
+package get_bulk_operation
+
+import (
+	"time"
+)
+
+// OperationDTO represents a bulk operation for query responses.
+type OperationDTO struct {
+	ID             string
+	Command        string
+	Status         string
+	MatchedCount   int64
+	ProcessedCount int64
+	SucceededCount int64
+	FailedCount    int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CompletedAt    *time.Time
+	Failures       []FailureDTO
+}
+
+// FailureDTO represents a product the operation could not be applied to.
+// Message is the error the domain rejected the product with.
+type FailureDTO struct {
+	ProductID string
+	Message   string
+	FailedAt  time.Time
+}
+
+// Done reports whether the operation has visited every matching product.
+func (o *OperationDTO) Done() bool {
+	return o.CompletedAt != nil
+}
@@ -0,0 +1,64 @@
+package get_bulk_operation
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+// MaxReportedFailures caps the failures returned with an operation; the
+// failed count always covers all of them.
+const MaxReportedFailures = 1000
+
+// Request represents the input for getting a bulk operation.
+type Request struct {
+	OperationID string
+}
+
+// Query handles the get bulk operation query.
+type Query struct {
+	operations contracts.BulkOperationReadRepository
+}
+
+// NewQuery creates a new get bulk operation query handler.
+func NewQuery(operations contracts.BulkOperationReadRepository) *Query {
+	return &Query{
+		operations: operations,
+	}
+}
+
+// Execute retrieves a bulk operation's progress and failures by ID.
+func (q *Query) Execute(ctx context.Context, req Request) (*OperationDTO, error) {
+	op, err := q.operations.Get(ctx, req.OperationID, MaxReportedFailures)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToDTO(op), nil
+}
+
+func mapToDTO(op *contracts.BulkOperation) *OperationDTO {
+	dto := &OperationDTO{
+		ID:             op.ID,
+		Command:        string(op.Action.Command()),
+		Status:         string(op.Status),
+		MatchedCount:   op.MatchedCount,
+		ProcessedCount: op.ProcessedCount,
+		SucceededCount: op.SucceededCount,
+		FailedCount:    op.FailedCount,
+		CreatedAt:      op.CreatedAt,
+		UpdatedAt:      op.UpdatedAt,
+		CompletedAt:    op.CompletedAt,
+		Failures:       make([]FailureDTO, len(op.Failures)),
+	}
+
+	for i, f := range op.Failures {
+		dto.Failures[i] = FailureDTO{
+			ProductID: f.ProductID,
+			Message:   f.Message,
+			FailedAt:  f.FailedAt,
+		}
+	}
+
+	return dto
+}
@@ -0,0 +1,20 @@
+package get_campaign
+
+import (
+	"time"
+)
+
+// CampaignDTO represents a campaign for query responses.
+type CampaignDTO struct {
+	ID              string
+	Name            string
+	DiscountPercent int64
+	StartDate       time.Time
+	EndDate         time.Time
+	EndedAt         *time.Time
+	Status          string
+	ProductIDs      []string
+	Categories      []string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
@@ -0,0 +1,46 @@
+package get_campaign
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+// Request represents the input for getting a campaign.
+type Request struct {
+	CampaignID string
+}
+
+// Query handles the get campaign query.
+type Query struct {
+	campaigns contracts.CampaignReadRepository
+}
+
+// NewQuery creates a new get campaign query handler.
+func NewQuery(campaigns contracts.CampaignReadRepository) *Query {
+	return &Query{
+		campaigns: campaigns,
+	}
+}
+
+// Execute retrieves a campaign by ID.
+func (q *Query) Execute(ctx context.Context, req Request) (*CampaignDTO, error) {
+	c, err := q.campaigns.Get(ctx, req.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CampaignDTO{
+		ID:              c.ID,
+		Name:            c.Name,
+		DiscountPercent: c.DiscountPercent,
+		StartDate:       c.StartDate,
+		EndDate:         c.EndDate,
+		EndedAt:         c.EndedAt,
+		Status:          c.Status,
+		ProductIDs:      c.ProductIDs,
+		Categories:      c.Categories,
+		CreatedAt:       c.CreatedAt,
+		UpdatedAt:       c.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package get_category
+
+import (
+	"time"
+)
+
+// CategoryDTO represents a category for query responses.
+type CategoryDTO struct {
+	ID          string
+	Slug        string
+	ParentID    string
+	DisplayName string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,41 @@
+package get_category
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+// Request represents the input for getting a category.
+type Request struct {
+	CategoryID string
+}
+
+// Query handles the get category query.
+type Query struct {
+	categories contracts.CategoryReadRepository
+}
+
+// NewQuery creates a new get category query handler.
+func NewQuery(categories contracts.CategoryReadRepository) *Query {
+	return &Query{
+		categories: categories,
+	}
+}
+
+// Execute retrieves a category by ID.
+func (q *Query) Execute(ctx context.Context, req Request) (*CategoryDTO, error) {
+	c, err := q.categories.Get(ctx, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CategoryDTO{
+		ID:          c.ID,
+		Slug:        c.Slug,
+		ParentID:    c.ParentID,
+		DisplayName: c.DisplayName,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}, nil
+}
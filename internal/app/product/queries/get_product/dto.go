@@ -20,6 +20,13 @@ type ProductDTO struct {
 	Status               string
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
+	ArchivedAt           *time.Time
+	PublishAt            *time.Time
+	UnpublishAt          *time.Time
+	Version              int64
+	ExternalKey          string
+	CategoryID           string
+	Attributes           map[string]string
 }
 
 // HasActiveDiscount returns true if the product has an active discount.
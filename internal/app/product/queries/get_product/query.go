@@ -30,10 +30,12 @@ func (q *Query) Execute(ctx context.Context, req Request) (*ProductDTO, error) {
 		return nil, err
 	}
 
-	return mapToDTO(product), nil
+	return MapToDTO(product), nil
 }
 
-func mapToDTO(rm *contracts.ProductReadModel) *ProductDTO {
+// MapToDTO converts a read model product to its DTO. Queries returning whole
+// products share it, so every RPC replies with the same Product.
+func MapToDTO(rm *contracts.ProductReadModel) *ProductDTO {
 	dto := &ProductDTO{
 		ID:                   rm.ID,
 		Name:                 rm.Name,
@@ -46,6 +48,13 @@ func mapToDTO(rm *contracts.ProductReadModel) *ProductDTO {
 		Status:               rm.Status,
 		CreatedAt:            rm.CreatedAt,
 		UpdatedAt:            rm.UpdatedAt,
+		ArchivedAt:           rm.ArchivedAt,
+		PublishAt:            rm.PublishAt,
+		UnpublishAt:          rm.UnpublishAt,
+		Version:              rm.Version,
+		ExternalKey:          rm.ExternalKey,
+		CategoryID:           rm.CategoryID,
+		Attributes:           rm.Attributes,
 	}
 
 	if rm.DiscountPercent != nil {
@@ -0,0 +1,28 @@
+package get_product_facets
+
+import (
+	"math/big"
+)
+
+// FacetValueDTO is the number of products sharing a facet value.
+type FacetValueDTO struct {
+	Value string
+	Count int64
+}
+
+// PriceBandDTO is the number of products whose effective price falls in
+// [Min, Max). A nil bound is unbounded.
+type PriceBandDTO struct {
+	Min   *big.Rat
+	Max   *big.Rat
+	Count int64
+}
+
+// FacetsDTO represents the facet counts for a filtered product set.
+type FacetsDTO struct {
+	TotalCount      int64
+	Categories      []FacetValueDTO
+	Statuses        []FacetValueDTO
+	DiscountBuckets []FacetValueDTO
+	PriceBands      []PriceBandDTO
+}
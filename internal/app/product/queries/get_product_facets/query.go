@@ -0,0 +1,164 @@
+package get_product_facets
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+// MaxPriceBands limits the number of price band boundaries per request.
+const MaxPriceBands = 20
+
+var ErrInvalidPriceBands = errors.New("price band boundaries must be positive, strictly ascending and at most 20")
+
+// DefaultPriceBandBoundaries returns the effective price boundaries used when
+// the request doesn't specify any: <10, 10-25, 25-50, 50-100, 100-250, >=250.
+func DefaultPriceBandBoundaries() []*big.Rat {
+	return []*big.Rat{
+		big.NewRat(10, 1),
+		big.NewRat(25, 1),
+		big.NewRat(50, 1),
+		big.NewRat(100, 1),
+		big.NewRat(250, 1),
+	}
+}
+
+// Request represents the input for computing product facets.
+// The filters have the same meaning as in list_products.
+type Request struct {
+	Category   *string
+	Status     *string
+	ActiveOnly bool
+
+	// ArchivedMode behaves as in list_products.
+	ArchivedMode contracts.ArchivedMode
+
+	MinBasePrice       *big.Rat
+	MaxBasePrice       *big.Rat
+	MinEffectivePrice  *big.Rat
+	MaxEffectivePrice  *big.Rat
+	OnSaleAt           *time.Time
+	MinDiscountPercent *int64
+
+	// CategoryID and IncludeDescendants behave as in list_products.
+	CategoryID         *string
+	IncludeDescendants bool
+
+	// PriceBandBoundaries splits effective prices into bands. Defaults to
+	// DefaultPriceBandBoundaries when empty.
+	PriceBandBoundaries []*big.Rat
+}
+
+// Query handles the get product facets query.
+type Query struct {
+	readModel  contracts.ProductReadModelRepository
+	categories contracts.CategoryReadRepository
+}
+
+// NewQuery creates a new get product facets query handler.
+func NewQuery(readModel contracts.ProductReadModelRepository, categories contracts.CategoryReadRepository) *Query {
+	return &Query{
+		readModel:  readModel,
+		categories: categories,
+	}
+}
+
+// Execute computes facet counts for the products matching the request filters.
+func (q *Query) Execute(ctx context.Context, req Request) (*FacetsDTO, error) {
+	archivedMode, err := contracts.ResolveArchivedMode(req.ArchivedMode, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := contracts.ValidatePriceRanges(req.MinBasePrice, req.MaxBasePrice, req.MinEffectivePrice, req.MaxEffectivePrice); err != nil {
+		return nil, err
+	}
+
+	boundaries := req.PriceBandBoundaries
+	if len(boundaries) == 0 {
+		boundaries = DefaultPriceBandBoundaries()
+	}
+	if !validBoundaries(boundaries) {
+		return nil, ErrInvalidPriceBands
+	}
+
+	filters := contracts.ProductListFilters{
+		Category:           req.Category,
+		Status:             req.Status,
+		ActiveOnly:         req.ActiveOnly,
+		ArchivedMode:       archivedMode,
+		MinBasePrice:       req.MinBasePrice,
+		MaxBasePrice:       req.MaxBasePrice,
+		MinEffectivePrice:  req.MinEffectivePrice,
+		MaxEffectivePrice:  req.MaxEffectivePrice,
+		OnSaleAt:           req.OnSaleAt,
+		MinDiscountPercent: req.MinDiscountPercent,
+	}
+
+	if req.CategoryID != nil {
+		filters.CategoryIDs = []string{*req.CategoryID}
+		if req.IncludeDescendants {
+			filters.CategoryIDs, err = q.categories.SubtreeIDs(ctx, *req.CategoryID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	facets, err := q.readModel.Facets(ctx, filters, boundaries)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToFacetsDTO(facets), nil
+}
+
+// validBoundaries reports whether band boundaries are positive and strictly ascending.
+func validBoundaries(boundaries []*big.Rat) bool {
+	if len(boundaries) > MaxPriceBands {
+		return false
+	}
+	for i, b := range boundaries {
+		if b == nil || b.Sign() <= 0 {
+			return false
+		}
+		if i > 0 && boundaries[i-1].Cmp(b) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func mapToFacetsDTO(facets *contracts.ProductFacets) *FacetsDTO {
+	dto := &FacetsDTO{
+		TotalCount:      facets.TotalCount,
+		Categories:      mapFacetValues(facets.Categories),
+		Statuses:        mapFacetValues(facets.Statuses),
+		DiscountBuckets: mapFacetValues(facets.DiscountBuckets),
+		PriceBands:      make([]PriceBandDTO, len(facets.PriceBands)),
+	}
+
+	for i, b := range facets.PriceBands {
+		dto.PriceBands[i] = PriceBandDTO{
+			Min:   b.Min,
+			Max:   b.Max,
+			Count: b.Count,
+		}
+	}
+
+	return dto
+}
+
+func mapFacetValues(counts []contracts.FacetCount) []FacetValueDTO {
+	values := make([]FacetValueDTO, len(counts))
+	for i, c := range counts {
+		values[i] = FacetValueDTO{
+			Value: c.Value,
+			Count: c.Count,
+		}
+	}
+	return values
+}
@@ -0,0 +1,26 @@
+package list_campaigns
+
+import (
+	"time"
+)
+
+// CampaignDTO represents a campaign in a list response.
+type CampaignDTO struct {
+	ID              string
+	Name            string
+	DiscountPercent int64
+	StartDate       time.Time
+	EndDate         time.Time
+	EndedAt         *time.Time
+	Status          string
+	ProductIDs      []string
+	Categories      []string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ListResultDTO represents a page of campaigns, newest first.
+type ListResultDTO struct {
+	Campaigns []*CampaignDTO
+	HasMore   bool
+}
@@ -0,0 +1,79 @@
+package list_campaigns
+
+import (
+	"context"
+	"errors"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+// ErrInvalidCampaignStatus is returned for an unknown status filter.
+var ErrInvalidCampaignStatus = errors.New("invalid campaign status: expected scheduled, running or ended")
+
+// Request represents the input for listing campaigns.
+type Request struct {
+	// Status keeps only campaigns in that state at query time.
+	Status *string
+	Limit  int
+	Offset int
+}
+
+// Query handles the list campaigns query.
+type Query struct {
+	campaigns contracts.CampaignReadRepository
+}
+
+// NewQuery creates a new list campaigns query handler.
+func NewQuery(campaigns contracts.CampaignReadRepository) *Query {
+	return &Query{
+		campaigns: campaigns,
+	}
+}
+
+// Execute retrieves a page of campaigns, newest first.
+func (q *Query) Execute(ctx context.Context, req Request) (*ListResultDTO, error) {
+	if req.Status != nil && !domain.CampaignStatus(*req.Status).IsValid() {
+		return nil, ErrInvalidCampaignStatus
+	}
+
+	// Apply defaults
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	result, err := q.campaigns.List(ctx, contracts.CampaignListFilters{Status: req.Status}, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &ListResultDTO{
+		Campaigns: make([]*CampaignDTO, len(result.Campaigns)),
+		HasMore:   result.HasMore,
+	}
+	for i, c := range result.Campaigns {
+		dto.Campaigns[i] = &CampaignDTO{
+			ID:              c.ID,
+			Name:            c.Name,
+			DiscountPercent: c.DiscountPercent,
+			StartDate:       c.StartDate,
+			EndDate:         c.EndDate,
+			EndedAt:         c.EndedAt,
+			Status:          c.Status,
+			ProductIDs:      c.ProductIDs,
+			Categories:      c.Categories,
+			CreatedAt:       c.CreatedAt,
+			UpdatedAt:       c.UpdatedAt,
+		}
+	}
+
+	return dto, nil
+}
@@ -0,0 +1,21 @@
+package list_categories
+
+import (
+	"time"
+)
+
+// CategoryDTO represents a category in a list response.
+type CategoryDTO struct {
+	ID          string
+	Slug        string
+	ParentID    string
+	DisplayName string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ListResultDTO represents a page of categories, ordered by slug.
+type ListResultDTO struct {
+	Categories []*CategoryDTO
+	HasMore    bool
+}
@@ -0,0 +1,66 @@
+package list_categories
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+// Request represents the input for listing categories.
+type Request struct {
+	// ParentID keeps only the children of that category; an empty ParentID
+	// keeps the roots. Nil lists the whole tree.
+	ParentID *string
+	Limit    int
+	Offset   int
+}
+
+// Query handles the list categories query.
+type Query struct {
+	categories contracts.CategoryReadRepository
+}
+
+// NewQuery creates a new list categories query handler.
+func NewQuery(categories contracts.CategoryReadRepository) *Query {
+	return &Query{
+		categories: categories,
+	}
+}
+
+// Execute retrieves a page of categories, ordered by slug.
+func (q *Query) Execute(ctx context.Context, req Request) (*ListResultDTO, error) {
+	// Apply defaults
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	result, err := q.categories.List(ctx, contracts.CategoryListFilters{ParentID: req.ParentID}, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &ListResultDTO{
+		Categories: make([]*CategoryDTO, len(result.Categories)),
+		HasMore:    result.HasMore,
+	}
+	for i, c := range result.Categories {
+		dto.Categories[i] = &CategoryDTO{
+			ID:          c.ID,
+			Slug:        c.Slug,
+			ParentID:    c.ParentID,
+			DisplayName: c.DisplayName,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   c.UpdatedAt,
+		}
+	}
+
+	return dto, nil
+}
@@ -10,6 +10,7 @@ type ProductListItemDTO struct {
 	Name                 string
 	Description          string
 	Category             string
+	CategoryID           string
 	BasePriceNumerator   int64
 	BasePriceDenominator int64
 	EffectivePriceNum    int64
@@ -17,6 +18,7 @@ type ProductListItemDTO struct {
 	DiscountPercent      *int64
 	Status               string
 	CreatedAt            time.Time
+	ArchivedAt           *time.Time
 }
 
 // HasActiveDiscount returns true if the product has an active discount.
@@ -41,8 +43,10 @@ func (p *ProductListItemDTO) EffectivePriceFloat() float64 {
 }
 
 // ListResultDTO represents the result of a product list query.
+// TotalCount is zero when the request skipped counting.
 type ListResultDTO struct {
-	Products   []*ProductListItemDTO
-	TotalCount int64
-	HasMore    bool
+	Products      []*ProductListItemDTO
+	TotalCount    int64
+	HasMore       bool
+	NextPageToken string
 }
@@ -0,0 +1,51 @@
+package list_products
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+// ErrInvalidOrderBy is returned when order_by names an unknown field or direction.
+var ErrInvalidOrderBy = errors.New("invalid order_by: expected \"<field> [asc|desc]\" with field one of created_at, updated_at, name, category, base_price, effective_price")
+
+// parseOrderBy parses "<field> [asc|desc]". The direction defaults to ascending;
+// an empty string yields the default order (created_at desc).
+func parseOrderBy(s string) (contracts.ProductOrder, error) {
+	parts := strings.Fields(strings.ToLower(s))
+	if len(parts) == 0 {
+		return contracts.ProductOrder{Field: contracts.SortByCreatedAt}, nil
+	}
+	if len(parts) > 2 {
+		return contracts.ProductOrder{}, ErrInvalidOrderBy
+	}
+
+	order := contracts.ProductOrder{
+		Field:     contracts.ProductSortField(parts[0]),
+		Ascending: true,
+	}
+	if !order.Field.IsValid() {
+		return contracts.ProductOrder{}, ErrInvalidOrderBy
+	}
+
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			order.Ascending = false
+		default:
+			return contracts.ProductOrder{}, ErrInvalidOrderBy
+		}
+	}
+
+	return order, nil
+}
+
+// formatOrderBy returns the canonical "<field> <direction>" form of an order.
+func formatOrderBy(order contracts.ProductOrder) string {
+	if order.Ascending {
+		return string(order.Field) + " asc"
+	}
+	return string(order.Field) + " desc"
+}
@@ -0,0 +1,60 @@
+package list_products
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+// ErrInvalidPageToken is returned when a page token cannot be decoded or was
+// issued for a different order_by.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// pageToken is the serialized form of a keyset cursor.
+// Clients treat the encoded string as opaque.
+type pageToken struct {
+	OrderBy   string `json:"o"`
+	SortKey   string `json:"k"`
+	ProductID string `json:"i"`
+}
+
+// encodePageToken serializes a cursor into an opaque URL-safe token.
+func encodePageToken(order contracts.ProductOrder, cursor *contracts.ProductCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(pageToken{
+		OrderBy:   formatOrderBy(order),
+		SortKey:   cursor.SortKey,
+		ProductID: cursor.ProductID,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken parses a token produced by encodePageToken for the same order.
+func decodePageToken(order contracts.ProductOrder, token string) (*contracts.ProductCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var t pageToken
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if t.ProductID == "" || t.OrderBy != formatOrderBy(order) {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &contracts.ProductCursor{
+		SortKey:   t.SortKey,
+		ProductID: t.ProductID,
+	}, nil
+}
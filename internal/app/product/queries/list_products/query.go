@@ -2,6 +2,9 @@ package list_products
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"time"
 
 	"github.com/product-catalog-service/internal/app/product/contracts"
 )
@@ -13,31 +16,112 @@ type Request struct {
 	ActiveOnly bool
 	Limit      int
 	Offset     int
+
+	// ArchivedMode controls whether archived products are listed. When it is
+	// unspecified, filtering by the archived status implies ArchivedModeOnly.
+	ArchivedMode contracts.ArchivedMode
+
+	// PageToken resumes a listing after the last product of a previous page.
+	// It cannot be combined with Offset: the gRPC API rejects such requests
+	// with INVALID_FIELD on offset.
+	PageToken string
+
+	// OrderBy is "<field> [asc|desc]"; empty means "created_at desc".
+	OrderBy string
+
+	// Inclusive price bounds. Effective price is evaluated at query time.
+	MinBasePrice      *big.Rat
+	MaxBasePrice      *big.Rat
+	MinEffectivePrice *big.Rat
+	MaxEffectivePrice *big.Rat
+
+	// OnSaleAt keeps only products with a discount running at that instant.
+	OnSaleAt *time.Time
+
+	// MinDiscountPercent keeps only products whose running discount is at least this large.
+	MinDiscountPercent *int64
+
+	// CampaignID keeps only products the campaign selects, running or not.
+	CampaignID *string
+
+	// CategoryID keeps only products assigned to that category of the tree.
+	// With IncludeDescendants, products assigned to any category below it
+	// are kept too, and an unknown category is an error.
+	CategoryID         *string
+	IncludeDescendants bool
+
+	// SkipTotalCount avoids the COUNT query when the caller does not need it.
+	SkipTotalCount bool
 }
 
 // Query handles the list products query.
 type Query struct {
-	readModel contracts.ProductReadModelRepository
+	readModel  contracts.ProductReadModelRepository
+	categories contracts.CategoryReadRepository
 }
 
 // NewQuery creates a new list products query handler.
-func NewQuery(readModel contracts.ProductReadModelRepository) *Query {
+func NewQuery(readModel contracts.ProductReadModelRepository, categories contracts.CategoryReadRepository) *Query {
 	return &Query{
-		readModel: readModel,
+		readModel:  readModel,
+		categories: categories,
 	}
 }
 
 // Execute retrieves a paginated list of products.
 func (q *Query) Execute(ctx context.Context, req Request) (*ListResultDTO, error) {
+	orderBy, err := parseOrderBy(req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	archivedMode, err := contracts.ResolveArchivedMode(req.ArchivedMode, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := contracts.ValidatePriceRanges(req.MinBasePrice, req.MaxBasePrice, req.MinEffectivePrice, req.MaxEffectivePrice); err != nil {
+		return nil, err
+	}
+
 	filters := contracts.ProductListFilters{
-		Category:   req.Category,
-		Status:     req.Status,
-		ActiveOnly: req.ActiveOnly,
+		Category:           req.Category,
+		Status:             req.Status,
+		ActiveOnly:         req.ActiveOnly,
+		ArchivedMode:       archivedMode,
+		OrderBy:            orderBy,
+		MinBasePrice:       req.MinBasePrice,
+		MaxBasePrice:       req.MaxBasePrice,
+		MinEffectivePrice:  req.MinEffectivePrice,
+		MaxEffectivePrice:  req.MaxEffectivePrice,
+		OnSaleAt:           req.OnSaleAt,
+		MinDiscountPercent: req.MinDiscountPercent,
+		CampaignID:         req.CampaignID,
+	}
+
+	if req.CategoryID != nil {
+		filters.CategoryIDs = []string{*req.CategoryID}
+		if req.IncludeDescendants {
+			filters.CategoryIDs, err = q.categories.SubtreeIDs(ctx, *req.CategoryID)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	pagination := contracts.Pagination{
-		Limit:  req.Limit,
-		Offset: req.Offset,
+		Limit:          req.Limit,
+		Offset:         req.Offset,
+		SkipTotalCount: req.SkipTotalCount,
+	}
+
+	if req.PageToken != "" {
+		cursor, err := decodePageToken(orderBy, req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		pagination.After = cursor
+		pagination.Offset = 0
 	}
 
 	// Apply defaults
@@ -50,13 +134,16 @@ func (q *Query) Execute(ctx context.Context, req Request) (*ListResultDTO, error
 
 	result, err := q.readModel.List(ctx, filters, pagination)
 	if err != nil {
+		if errors.Is(err, contracts.ErrInvalidCursor) {
+			return nil, ErrInvalidPageToken
+		}
 		return nil, err
 	}
 
-	return mapToListResult(result), nil
+	return mapToListResult(orderBy, result), nil
 }
 
-func mapToListResult(result *contracts.ProductListResult) *ListResultDTO {
+func mapToListResult(orderBy contracts.ProductOrder, result *contracts.ProductListResult) *ListResultDTO {
 	products := make([]*ProductListItemDTO, len(result.Products))
 
 	for i, p := range result.Products {
@@ -65,6 +152,7 @@ func mapToListResult(result *contracts.ProductListResult) *ListResultDTO {
 			Name:                 p.Name,
 			Description:          p.Description,
 			Category:             p.Category,
+			CategoryID:           p.CategoryID,
 			BasePriceNumerator:   p.BasePriceNumerator,
 			BasePriceDenominator: p.BasePriceDenominator,
 			EffectivePriceNum:    p.EffectivePriceNum,
@@ -72,12 +160,14 @@ func mapToListResult(result *contracts.ProductListResult) *ListResultDTO {
 			DiscountPercent:      p.DiscountPercent,
 			Status:               p.Status,
 			CreatedAt:            p.CreatedAt,
+			ArchivedAt:           p.ArchivedAt,
 		}
 	}
 
 	return &ListResultDTO{
-		Products:   products,
-		TotalCount: result.TotalCount,
-		HasMore:    result.HasMore,
+		Products:      products,
+		TotalCount:    result.TotalCount,
+		HasMore:       result.HasMore,
+		NextPageToken: encodePageToken(orderBy, result.NextCursor),
 	}
 }
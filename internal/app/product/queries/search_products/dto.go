@@ -0,0 +1,29 @@
+package search_products
+
+import (
+	"time"
+)
+
+// SearchHitDTO represents a product matched by a search.
+type SearchHitDTO struct {
+	ID                   string
+	Name                 string
+	Description          string
+	Category             string
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	EffectivePriceNum    int64
+	EffectivePriceDenom  int64
+	DiscountPercent      *int64
+	Status               string
+	CreatedAt            time.Time
+	ArchivedAt           *time.Time
+	Score                float64
+}
+
+// SearchResultDTO represents the result of a product search.
+type SearchResultDTO struct {
+	Results    []*SearchHitDTO
+	TotalCount int64
+	HasMore    bool
+}
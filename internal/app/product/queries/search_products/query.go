@@ -0,0 +1,119 @@
+package search_products
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+)
+
+// MaxQueryLength is the maximum length of a normalized search query.
+const MaxQueryLength = 200
+
+var (
+	ErrEmptySearchQuery   = errors.New("search query must contain at least one letter or digit")
+	ErrSearchQueryTooLong = fmt.Errorf("search query must be at most %d characters", MaxQueryLength)
+)
+
+// Request represents the input for searching products.
+type Request struct {
+	Query      string
+	Category   *string
+	Status     *string
+	ActiveOnly bool
+	Limit      int
+	Offset     int
+}
+
+// Query handles the search products query.
+type Query struct {
+	search contracts.ProductSearchRepository
+}
+
+// NewQuery creates a new search products query handler.
+func NewQuery(search contracts.ProductSearchRepository) *Query {
+	return &Query{
+		search: search,
+	}
+}
+
+// Execute searches products by keyword, most relevant first.
+func (q *Query) Execute(ctx context.Context, req Request) (*SearchResultDTO, error) {
+	text := normalizeQuery(req.Query)
+	if text == "" {
+		return nil, ErrEmptySearchQuery
+	}
+	if len(text) > MaxQueryLength {
+		return nil, ErrSearchQueryTooLong
+	}
+
+	filters := contracts.ProductListFilters{
+		Category:   req.Category,
+		Status:     req.Status,
+		ActiveOnly: req.ActiveOnly,
+	}
+
+	pagination := contracts.Pagination{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+
+	// Apply defaults
+	if pagination.Limit <= 0 {
+		pagination.Limit = 20
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+	if pagination.Offset < 0 {
+		pagination.Offset = 0
+	}
+
+	result, err := q.search.Search(ctx, text, filters, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapToSearchResult(result), nil
+}
+
+// normalizeQuery lowercases the input and keeps only words of letters and
+// digits, so user input can't inject search query operators.
+func normalizeQuery(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
+
+func mapToSearchResult(result *contracts.ProductSearchResult) *SearchResultDTO {
+	hits := make([]*SearchHitDTO, len(result.Hits))
+
+	for i, h := range result.Hits {
+		p := h.Product
+		hits[i] = &SearchHitDTO{
+			ID:                   p.ID,
+			Name:                 p.Name,
+			Description:          p.Description,
+			Category:             p.Category,
+			BasePriceNumerator:   p.BasePriceNumerator,
+			BasePriceDenominator: p.BasePriceDenominator,
+			EffectivePriceNum:    p.EffectivePriceNum,
+			EffectivePriceDenom:  p.EffectivePriceDenom,
+			DiscountPercent:      p.DiscountPercent,
+			Status:               p.Status,
+			CreatedAt:            p.CreatedAt,
+			ArchivedAt:           p.ArchivedAt,
+			Score:                h.Score,
+		}
+	}
+
+	return &SearchResultDTO{
+		Results:    hits,
+		TotalCount: result.TotalCount,
+		HasMore:    result.HasMore,
+	}
+}
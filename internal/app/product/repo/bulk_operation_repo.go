@@ -0,0 +1,441 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/models/m_bulk_operation"
+	"github.com/product-catalog-service/internal/models/m_bulk_operation_failure"
+	"github.com/product-catalog-service/internal/models/m_product"
+	"github.com/product-catalog-service/internal/pkg/clock"
+)
+
+// BulkOperationRepo implements BulkOperationReadRepository for Spanner, along
+// with the mutations and product matching bulk operations need.
+type BulkOperationRepo struct {
+	client       *spanner.Client
+	model        *m_bulk_operation.Model
+	failureModel *m_bulk_operation_failure.Model
+
+	// products builds filter clauses against the products table, so bulk
+	// operations match the state they change rather than a lagging view.
+	products *ReadModelRepo
+}
+
+// NewBulkOperationRepo creates a new BulkOperationRepo.
+func NewBulkOperationRepo(client *spanner.Client, clock clock.Clock) *BulkOperationRepo {
+	return &BulkOperationRepo{
+		client:       client,
+		model:        m_bulk_operation.NewModel(),
+		failureModel: m_bulk_operation_failure.NewModel(),
+		products:     NewReadModelRepo(client, clock, ReadModelSourceProducts),
+	}
+}
+
+// storedParams is the JSON layout of the params column.
+type storedParams struct {
+	Percentage int64      `json:"percentage,omitempty"`
+	StartDate  *time.Time `json:"start_date,omitempty"`
+	EndDate    *time.Time `json:"end_date,omitempty"`
+	Category   string     `json:"category,omitempty"`
+	CategoryID string     `json:"category_id,omitempty"`
+}
+
+// storedFilters is the JSON layout of the filters column. Prices are stored
+// as exact fractions.
+type storedFilters struct {
+	Category           *string    `json:"category,omitempty"`
+	CategoryIDs        []string   `json:"category_ids,omitempty"`
+	Status             *string    `json:"status,omitempty"`
+	ActiveOnly         bool       `json:"active_only,omitempty"`
+	ArchivedMode       string     `json:"archived_mode,omitempty"`
+	MinBasePrice       *big.Rat   `json:"min_base_price,omitempty"`
+	MaxBasePrice       *big.Rat   `json:"max_base_price,omitempty"`
+	MinEffectivePrice  *big.Rat   `json:"min_effective_price,omitempty"`
+	MaxEffectivePrice  *big.Rat   `json:"max_effective_price,omitempty"`
+	OnSaleAt           *time.Time `json:"on_sale_at,omitempty"`
+	MinDiscountPercent *int64     `json:"min_discount_percent,omitempty"`
+}
+
+// InsertMut returns a mutation for inserting a new bulk operation.
+func (r *BulkOperationRepo) InsertMut(op *contracts.BulkOperation) *spanner.Mutation {
+	return r.model.InsertMut(r.operationToDBModel(op))
+}
+
+// ProgressMut returns a mutation saving the status, cursor and counts of a
+// bulk operation.
+func (r *BulkOperationRepo) ProgressMut(op *contracts.BulkOperation) *spanner.Mutation {
+	return r.model.UpdateProgressMut(&m_bulk_operation.BulkOperation{
+		OperationID:    op.ID,
+		Status:         string(op.Status),
+		LastProductID:  spanner.NullString{StringVal: op.LastProductID, Valid: op.LastProductID != ""},
+		ProcessedCount: op.ProcessedCount,
+		SucceededCount: op.SucceededCount,
+		FailedCount:    op.FailedCount,
+		UpdatedAt:      op.UpdatedAt,
+		CompletedAt:    timeToNull(op.CompletedAt),
+	})
+}
+
+// FailureMut returns a mutation recording a product a bulk operation failed on.
+func (r *BulkOperationRepo) FailureMut(operationID string, failure contracts.BulkOperationFailure) *spanner.Mutation {
+	return r.failureModel.InsertOrUpdateMut(&m_bulk_operation_failure.BulkOperationFailure{
+		OperationID: operationID,
+		ProductID:   failure.ProductID,
+		Message:     failure.Message,
+		FailedAt:    failure.FailedAt,
+	})
+}
+
+// GetWithTxn retrieves a bulk operation, without its failures, within a
+// transaction.
+func (r *BulkOperationRepo) GetWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, id string) (*contracts.BulkOperation, error) {
+	row, err := txn.ReadRow(ctx, m_bulk_operation.TableName, spanner.Key{id}, m_bulk_operation.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == 5 { // NotFound
+			return nil, domain.ErrBulkOperationNotFound
+		}
+		return nil, err
+	}
+
+	return r.rowToOperation(row)
+}
+
+// Get retrieves a bulk operation with up to failureLimit of its failures,
+// ordered by product ID. Both are read at the same timestamp.
+func (r *BulkOperationRepo) Get(ctx context.Context, id string, failureLimit int) (*contracts.BulkOperation, error) {
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	row, err := txn.ReadRow(ctx, m_bulk_operation.TableName, spanner.Key{id}, m_bulk_operation.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == 5 { // NotFound
+			return nil, domain.ErrBulkOperationNotFound
+		}
+		return nil, err
+	}
+
+	op, err := r.rowToOperation(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if failureLimit <= 0 {
+		return op, nil
+	}
+
+	iter := txn.ReadWithOptions(
+		ctx,
+		m_bulk_operation_failure.TableName,
+		spanner.Key{id}.AsPrefix(),
+		[]string{
+			m_bulk_operation_failure.ProductID,
+			m_bulk_operation_failure.Message,
+			m_bulk_operation_failure.FailedAt,
+		},
+		&spanner.ReadOptions{Limit: failureLimit},
+	)
+	defer iter.Stop()
+
+	err = iter.Do(func(row *spanner.Row) error {
+		var failure contracts.BulkOperationFailure
+		if err := row.Columns(&failure.ProductID, &failure.Message, &failure.FailedAt); err != nil {
+			return err
+		}
+		op.Failures = append(op.Failures, failure)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// ForEachRunning streams the IDs of running bulk operations to fn, oldest
+// first, stopping at the first error.
+func (r *BulkOperationRepo) ForEachRunning(ctx context.Context, fn func(id string) error) error {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s = @status ORDER BY %s",
+			m_bulk_operation.OperationID,
+			m_bulk_operation.TableName,
+			m_bulk_operation.IndexStatus,
+			m_bulk_operation.Status,
+			m_bulk_operation.CreatedAt,
+		),
+		Params: map[string]interface{}{
+			"status": m_bulk_operation.StatusRunning,
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	return iter.Do(func(row *spanner.Row) error {
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return err
+		}
+		return fn(id)
+	})
+}
+
+// MovingCategoriesWithTxn returns the IDs of the categories running
+// assign_category operations still move products into or out of, within a
+// transaction. Reading them in the transaction that deletes a category
+// conflicts with an operation started concurrently.
+func (r *BulkOperationRepo) MovingCategoriesWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction) (map[string]bool, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s WHERE %s = @status AND %s = @command",
+			strings.Join(m_bulk_operation.AllColumns(), ", "),
+			m_bulk_operation.TableName,
+			m_bulk_operation.Status,
+			m_bulk_operation.Command,
+		),
+		Params: map[string]interface{}{
+			"status":  m_bulk_operation.StatusRunning,
+			"command": string(domain.BulkCommandAssignCategory),
+		},
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	moving := make(map[string]bool)
+	err := iter.Do(func(row *spanner.Row) error {
+		op, err := r.rowToOperation(row)
+		if err != nil {
+			return err
+		}
+		moving[op.Action.Params().CategoryID] = true
+		for _, id := range op.Filters.CategoryIDs {
+			moving[id] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moving, nil
+}
+
+// CountMatching counts the products matching filters, with time-dependent
+// filters evaluated at now.
+func (r *BulkOperationRepo) CountMatching(ctx context.Context, filters contracts.ProductListFilters, now time.Time) (int64, error) {
+	where, params := r.products.buildListFilters(filters, now)
+	return r.products.count(ctx, where, params)
+}
+
+// MatchingIDs returns up to limit IDs of products matching filters with an
+// ID after afterID, in ID order. It reads outside any read-write transaction,
+// so the filter scan takes no locks; the caller rechecks the products with
+// StillMatchingIDsWithTxn before changing them.
+func (r *BulkOperationRepo) MatchingIDs(
+	ctx context.Context,
+	filters contracts.ProductListFilters,
+	now time.Time,
+	afterID string,
+	limit int,
+) ([]string, error) {
+	where, params := r.products.buildListFilters(filters, now)
+	params["afterProductID"] = afterID
+	params["limit"] = int64(limit)
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %[1]s FROM %[2]s WHERE %[3]s AND %[1]s > @afterProductID ORDER BY %[1]s LIMIT @limit",
+			m_product.ProductID,
+			m_product.TableName,
+			where,
+		),
+		Params: params,
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	return collectIDs(iter, limit)
+}
+
+// StillMatchingIDsWithTxn returns, in ID order, those of ids whose products
+// still match filters within a transaction. It looks the products up by key,
+// so only their rows are read and locked.
+func (r *BulkOperationRepo) StillMatchingIDsWithTxn(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	filters contracts.ProductListFilters,
+	now time.Time,
+	ids []string,
+) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	where, params := r.products.buildListFilters(filters, now)
+	params["productIDs"] = ids
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %[1]s FROM %[2]s WHERE %[1]s IN UNNEST(@productIDs) AND %[3]s ORDER BY %[1]s",
+			m_product.ProductID,
+			m_product.TableName,
+			where,
+		),
+		Params: params,
+	}
+
+	iter := txn.Query(ctx, stmt)
+	return collectIDs(iter, len(ids))
+}
+
+// collectIDs reads the single ID column of every row of iter.
+func collectIDs(iter *spanner.RowIterator, capacity int) ([]string, error) {
+	defer iter.Stop()
+
+	ids := make([]string, 0, capacity)
+	err := iter.Do(func(row *spanner.Row) error {
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return err
+		}
+		ids = append(ids, id)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// operationToDBModel converts a bulk operation to its database model.
+func (r *BulkOperationRepo) operationToDBModel(op *contracts.BulkOperation) *m_bulk_operation.BulkOperation {
+	params := op.Action.Params()
+	stored := storedParams{
+		Percentage: params.Percentage,
+		Category:   params.Category,
+		CategoryID: params.CategoryID,
+	}
+	if !params.StartDate.IsZero() {
+		stored.StartDate = &params.StartDate
+	}
+	if !params.EndDate.IsZero() {
+		stored.EndDate = &params.EndDate
+	}
+
+	f := op.Filters
+	filters := storedFilters{
+		Category:           f.Category,
+		CategoryIDs:        f.CategoryIDs,
+		Status:             f.Status,
+		ActiveOnly:         f.ActiveOnly,
+		ArchivedMode:       string(f.ArchivedMode),
+		MinBasePrice:       f.MinBasePrice,
+		MaxBasePrice:       f.MaxBasePrice,
+		MinEffectivePrice:  f.MinEffectivePrice,
+		MaxEffectivePrice:  f.MaxEffectivePrice,
+		OnSaleAt:           f.OnSaleAt,
+		MinDiscountPercent: f.MinDiscountPercent,
+	}
+
+	return &m_bulk_operation.BulkOperation{
+		OperationID:    op.ID,
+		Command:        string(op.Action.Command()),
+		Params:         spanner.NullJSON{Value: stored, Valid: true},
+		Filters:        spanner.NullJSON{Value: filters, Valid: true},
+		Status:         string(op.Status),
+		LastProductID:  spanner.NullString{StringVal: op.LastProductID, Valid: op.LastProductID != ""},
+		MatchedCount:   op.MatchedCount,
+		ProcessedCount: op.ProcessedCount,
+		SucceededCount: op.SucceededCount,
+		FailedCount:    op.FailedCount,
+		CreatedAt:      op.CreatedAt,
+		UpdatedAt:      op.UpdatedAt,
+		CompletedAt:    timeToNull(op.CompletedAt),
+	}
+}
+
+// rowToOperation converts a Spanner row to a bulk operation.
+func (r *BulkOperationRepo) rowToOperation(row *spanner.Row) (*contracts.BulkOperation, error) {
+	var dbOp m_bulk_operation.BulkOperation
+	if err := row.Columns(
+		&dbOp.OperationID,
+		&dbOp.Command,
+		&dbOp.Params,
+		&dbOp.Filters,
+		&dbOp.Status,
+		&dbOp.LastProductID,
+		&dbOp.MatchedCount,
+		&dbOp.ProcessedCount,
+		&dbOp.SucceededCount,
+		&dbOp.FailedCount,
+		&dbOp.CreatedAt,
+		&dbOp.UpdatedAt,
+		&dbOp.CompletedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var params storedParams
+	if dbOp.Params.Valid {
+		if err := json.NewDecoder(strings.NewReader(dbOp.Params.String())).Decode(&params); err != nil {
+			return nil, fmt.Errorf("decode params of bulk operation %s: %w", dbOp.OperationID, err)
+		}
+	}
+	actionParams := domain.BulkActionParams{
+		Percentage: params.Percentage,
+		Category:   params.Category,
+		CategoryID: params.CategoryID,
+	}
+	if params.StartDate != nil {
+		actionParams.StartDate = *params.StartDate
+	}
+	if params.EndDate != nil {
+		actionParams.EndDate = *params.EndDate
+	}
+	action, err := domain.NewBulkAction(domain.BulkCommand(dbOp.Command), actionParams)
+	if err != nil {
+		return nil, fmt.Errorf("rebuild action of bulk operation %s: %w", dbOp.OperationID, err)
+	}
+
+	var filters storedFilters
+	if err := json.NewDecoder(strings.NewReader(dbOp.Filters.String())).Decode(&filters); err != nil {
+		return nil, fmt.Errorf("decode filters of bulk operation %s: %w", dbOp.OperationID, err)
+	}
+
+	return &contracts.BulkOperation{
+		ID:     dbOp.OperationID,
+		Action: action,
+		Filters: contracts.ProductListFilters{
+			Category:           filters.Category,
+			CategoryIDs:        filters.CategoryIDs,
+			Status:             filters.Status,
+			ActiveOnly:         filters.ActiveOnly,
+			ArchivedMode:       contracts.ArchivedMode(filters.ArchivedMode),
+			MinBasePrice:       filters.MinBasePrice,
+			MaxBasePrice:       filters.MaxBasePrice,
+			MinEffectivePrice:  filters.MinEffectivePrice,
+			MaxEffectivePrice:  filters.MaxEffectivePrice,
+			OnSaleAt:           filters.OnSaleAt,
+			MinDiscountPercent: filters.MinDiscountPercent,
+		},
+		Status:         contracts.BulkOperationStatus(dbOp.Status),
+		LastProductID:  dbOp.LastProductID.StringVal,
+		MatchedCount:   dbOp.MatchedCount,
+		ProcessedCount: dbOp.ProcessedCount,
+		SucceededCount: dbOp.SucceededCount,
+		FailedCount:    dbOp.FailedCount,
+		CreatedAt:      dbOp.CreatedAt,
+		UpdatedAt:      dbOp.UpdatedAt,
+		CompletedAt:    nullToTime(dbOp.CompletedAt),
+	}, nil
+}
@@ -0,0 +1,338 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/models/m_campaign"
+	"github.com/product-catalog-service/internal/pkg/clock"
+)
+
+// CampaignRepo implements CampaignReadRepository for Spanner, along with the
+// Campaign aggregate's persistence.
+type CampaignRepo struct {
+	client *spanner.Client
+	model  *m_campaign.Model
+	clock  clock.Clock
+}
+
+// NewCampaignRepo creates a new CampaignRepo.
+func NewCampaignRepo(client *spanner.Client, clock clock.Clock) *CampaignRepo {
+	return &CampaignRepo{
+		client: client,
+		model:  m_campaign.NewModel(),
+		clock:  clock,
+	}
+}
+
+// GetByID retrieves a campaign aggregate by its ID.
+func (r *CampaignRepo) GetByID(ctx context.Context, id string) (*domain.Campaign, error) {
+	row, err := r.client.Single().ReadRow(ctx, m_campaign.TableName, spanner.Key{id}, m_campaign.AllColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == 5 { // NotFound
+			return nil, domain.ErrCampaignNotFound
+		}
+		return nil, err
+	}
+
+	return r.rowToCampaign(row)
+}
+
+// InsertMut returns a mutation for inserting a new campaign.
+func (r *CampaignRepo) InsertMut(c *domain.Campaign) *spanner.Mutation {
+	if !c.IsNew() {
+		return nil
+	}
+
+	return r.model.InsertMut(&m_campaign.Campaign{
+		CampaignID:      c.ID(),
+		Name:            c.Name(),
+		DiscountPercent: c.Discount().Percentage(),
+		StartDate:       c.Discount().StartDate(),
+		EndDate:         c.Discount().EndDate(),
+		EndedAt:         timeToNull(c.EndedAt()),
+		ProductIDs:      c.Selection().ProductIDs,
+		Categories:      c.Selection().Categories,
+		CreatedAt:       c.CreatedAt(),
+		UpdatedAt:       c.UpdatedAt(),
+	})
+}
+
+// EndMut returns a mutation saving that a campaign was ended early.
+func (r *CampaignRepo) EndMut(c *domain.Campaign) *spanner.Mutation {
+	if c.IsNew() || c.EndedAt() == nil {
+		return nil
+	}
+
+	return r.model.UpdateMut(c.ID(), map[string]interface{}{
+		m_campaign.EndedAt:   timeToNull(c.EndedAt()),
+		m_campaign.UpdatedAt: c.UpdatedAt(),
+	})
+}
+
+// Get retrieves a campaign read model by ID.
+func (r *CampaignRepo) Get(ctx context.Context, id string) (*contracts.CampaignReadModel, error) {
+	campaign, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCampaignReadModel(campaign, r.clock.Now()), nil
+}
+
+// List retrieves a page of campaigns, newest first. One extra row is read to
+// learn whether another page exists.
+func (r *CampaignRepo) List(
+	ctx context.Context,
+	filters contracts.CampaignListFilters,
+	limit, offset int,
+) (*contracts.CampaignListResult, error) {
+	now := r.clock.Now()
+	where := "1=1"
+	params := map[string]interface{}{
+		"now":    now,
+		"limit":  int64(limit + 1),
+		"offset": int64(offset),
+	}
+
+	if filters.Status != nil {
+		switch domain.CampaignStatus(*filters.Status) {
+		case domain.CampaignStatusScheduled:
+			where = fmt.Sprintf("%s > @now AND NOT %s", m_campaign.StartDate, campaignEndedExpr("@now"))
+		case domain.CampaignStatusRunning:
+			where = campaignRunningExpr("", "@now")
+		case domain.CampaignStatusEnded:
+			where = campaignEndedExpr("@now")
+		}
+	}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s ORDER BY %s DESC, %s LIMIT @limit OFFSET @offset",
+			buildCampaignColumns(),
+			m_campaign.TableName,
+			m_campaign.IndexCreatedAt,
+			where,
+			m_campaign.CreatedAt,
+			m_campaign.CampaignID,
+		),
+		Params: params,
+	}
+
+	campaigns, err := r.query(ctx, r.client.Single(), stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &contracts.CampaignListResult{}
+	if len(campaigns) > limit {
+		campaigns = campaigns[:limit]
+		result.HasMore = true
+	}
+
+	result.Campaigns = make([]*contracts.CampaignReadModel, len(campaigns))
+	for i, c := range campaigns {
+		result.Campaigns[i] = toCampaignReadModel(c, now)
+	}
+
+	return result, nil
+}
+
+// ListRunning retrieves the campaigns running at the given time.
+func (r *CampaignRepo) ListRunning(ctx context.Context, at time.Time) ([]*domain.Campaign, error) {
+	return r.ListRunningWithTxn(ctx, r.client.Single(), at)
+}
+
+// ListRunningWithTxn retrieves the campaigns running at the given time within
+// a read-only transaction.
+func (r *CampaignRepo) ListRunningWithTxn(ctx context.Context, txn *spanner.ReadOnlyTransaction, at time.Time) ([]*domain.Campaign, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s",
+			buildCampaignColumns(),
+			m_campaign.TableName,
+			m_campaign.IndexEndDate,
+			campaignRunningExpr("", "@at"),
+		),
+		Params: map[string]interface{}{
+			"at": at,
+		},
+	}
+
+	return r.query(ctx, txn, stmt)
+}
+
+// SelectingCategoriesWithTxn returns, for each of the categories, the IDs of
+// the campaigns not ended at now that select it, within a transaction.
+func (r *CampaignRepo) SelectingCategoriesWithTxn(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	categories []string,
+	now time.Time,
+) (map[string][]string, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %[1]s, %[2]s FROM %[3]s WHERE NOT %[4]s AND EXISTS (SELECT 1 FROM UNNEST(%[2]s) AS c WHERE c IN UNNEST(@categories))",
+			m_campaign.CampaignID,
+			m_campaign.Categories,
+			m_campaign.TableName,
+			campaignEndedExpr("@now"),
+		),
+		Params: map[string]interface{}{
+			"categories": categories,
+			"now":        now,
+		},
+	}
+
+	return groupByCategory(ctx, txn, stmt, categories)
+}
+
+func (r *CampaignRepo) query(ctx context.Context, txn *spanner.ReadOnlyTransaction, stmt spanner.Statement) ([]*domain.Campaign, error) {
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	campaigns := make([]*domain.Campaign, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		campaign, err := r.rowToCampaign(row)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, campaign)
+	}
+
+	return campaigns, nil
+}
+
+func (r *CampaignRepo) rowToCampaign(row *spanner.Row) (*domain.Campaign, error) {
+	var dbCampaign m_campaign.Campaign
+
+	err := row.Columns(
+		&dbCampaign.CampaignID,
+		&dbCampaign.Name,
+		&dbCampaign.DiscountPercent,
+		&dbCampaign.StartDate,
+		&dbCampaign.EndDate,
+		&dbCampaign.EndedAt,
+		&dbCampaign.ProductIDs,
+		&dbCampaign.Categories,
+		&dbCampaign.CreatedAt,
+		&dbCampaign.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := domain.NewDiscount(dbCampaign.DiscountPercent, dbCampaign.StartDate, dbCampaign.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstituteCampaign(
+		dbCampaign.CampaignID,
+		dbCampaign.Name,
+		discount,
+		domain.CampaignSelection{
+			ProductIDs: dbCampaign.ProductIDs,
+			Categories: dbCampaign.Categories,
+		},
+		dbCampaign.CreatedAt,
+		dbCampaign.UpdatedAt,
+		nullToTime(dbCampaign.EndedAt),
+	), nil
+}
+
+// toCampaignReadModel flattens a campaign, with its status at now.
+func toCampaignReadModel(c *domain.Campaign, now time.Time) *contracts.CampaignReadModel {
+	return &contracts.CampaignReadModel{
+		ID:              c.ID(),
+		Name:            c.Name(),
+		DiscountPercent: c.Discount().Percentage(),
+		StartDate:       c.Discount().StartDate(),
+		EndDate:         c.Discount().EndDate(),
+		EndedAt:         c.EndedAt(),
+		Status:          string(c.Status(now)),
+		ProductIDs:      c.Selection().ProductIDs,
+		Categories:      c.Selection().Categories,
+		CreatedAt:       c.CreatedAt(),
+		UpdatedAt:       c.UpdatedAt(),
+	}
+}
+
+func buildCampaignColumns() string {
+	return strings.Join(m_campaign.AllColumns(), ", ")
+}
+
+// campaignRunningExpr is true when a campaign row is running at the
+// timestamp parameter at, mirroring Campaign.IsRunningAt. Columns are
+// qualified with alias unless it is empty.
+func campaignRunningExpr(alias, at string) string {
+	col := func(name string) string {
+		if alias == "" {
+			return name
+		}
+		return alias + "." + name
+	}
+	return fmt.Sprintf("(%s <= %s AND %s >= %s AND (%s IS NULL OR %s > %s))",
+		col(m_campaign.StartDate), at,
+		col(m_campaign.EndDate), at,
+		col(m_campaign.EndedAt), col(m_campaign.EndedAt), at,
+	)
+}
+
+// groupByCategory reads (key, categories) rows and groups the keys by the
+// wanted categories they list.
+func groupByCategory(ctx context.Context, txn *spanner.ReadWriteTransaction, stmt spanner.Statement, wanted []string) (map[string][]string, error) {
+	isWanted := make(map[string]bool, len(wanted))
+	for _, category := range wanted {
+		isWanted[category] = true
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	keys := make(map[string][]string)
+	err := iter.Do(func(row *spanner.Row) error {
+		var (
+			key        string
+			categories []string
+		)
+		if err := row.Columns(&key, &categories); err != nil {
+			return err
+		}
+		for _, category := range categories {
+			if isWanted[category] {
+				keys[category] = append(keys[category], key)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// campaignEndedExpr is true when a campaign row has ended at the timestamp
+// parameter at, mirroring Campaign.Status.
+func campaignEndedExpr(at string) string {
+	return fmt.Sprintf("(%s < %s OR (%s IS NOT NULL AND %s <= %s))",
+		m_campaign.EndDate, at,
+		m_campaign.EndedAt, m_campaign.EndedAt, at,
+	)
+}
@@ -0,0 +1,418 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/models/m_category"
+)
+
+// CategoryRepo implements CategoryReadRepository for Spanner, along with the
+// Category aggregate's persistence.
+type CategoryRepo struct {
+	client *spanner.Client
+	model  *m_category.Model
+}
+
+// NewCategoryRepo creates a new CategoryRepo.
+func NewCategoryRepo(client *spanner.Client) *CategoryRepo {
+	return &CategoryRepo{
+		client: client,
+		model:  m_category.NewModel(),
+	}
+}
+
+// GetByID retrieves a category aggregate by its ID.
+func (r *CategoryRepo) GetByID(ctx context.Context, id string) (*domain.Category, error) {
+	row, err := r.client.Single().ReadRow(ctx, m_category.TableName, spanner.Key{id}, m_category.AllColumns())
+	return r.readCategory(row, err)
+}
+
+// GetByIDWithTxn retrieves a category aggregate by its ID within a transaction.
+func (r *CategoryRepo) GetByIDWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, id string) (*domain.Category, error) {
+	row, err := txn.ReadRow(ctx, m_category.TableName, spanner.Key{id}, m_category.AllColumns())
+	return r.readCategory(row, err)
+}
+
+// GetByIDs retrieves several categories using a single read. Categories
+// that do not exist are absent from the returned map.
+func (r *CategoryRepo) GetByIDs(ctx context.Context, ids []string) (map[string]*domain.Category, error) {
+	iter := r.client.Single().Read(ctx, m_category.TableName, categoryKeySet(ids), m_category.AllColumns())
+	return r.collectByID(iter, len(ids))
+}
+
+// GetByIDsWithTxn retrieves several categories within a transaction using a
+// single read. Categories that do not exist are absent from the returned map.
+func (r *CategoryRepo) GetByIDsWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, ids []string) (map[string]*domain.Category, error) {
+	iter := txn.Read(ctx, m_category.TableName, categoryKeySet(ids), m_category.AllColumns())
+	return r.collectByID(iter, len(ids))
+}
+
+func categoryKeySet(ids []string) spanner.KeySet {
+	keys := make([]spanner.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = spanner.Key{id}
+	}
+	return spanner.KeySetFromKeys(keys...)
+}
+
+// collectByID reads the categories of iter into a map by ID.
+func (r *CategoryRepo) collectByID(iter *spanner.RowIterator, size int) (map[string]*domain.Category, error) {
+	defer iter.Stop()
+
+	categories := make(map[string]*domain.Category, size)
+	err := iter.Do(func(row *spanner.Row) error {
+		category, err := r.rowToCategory(row)
+		if err != nil {
+			return err
+		}
+		categories[category.ID()] = category
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// GetBySlugWithTxn retrieves a category by its slug, in any case, within a
+// transaction.
+func (r *CategoryRepo) GetBySlugWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, slug string) (*domain.Category, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s = @slug",
+			buildCategoryColumns(),
+			m_category.TableName,
+			m_category.IndexSlug,
+			m_category.Slug,
+		),
+		Params: map[string]interface{}{
+			"slug": domain.NormalizeCategorySlug(slug),
+		},
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, domain.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.rowToCategory(row)
+}
+
+// PathWithTxn returns the category's ID followed by the IDs of its
+// ancestors up to the root, within a transaction.
+// Returns domain.ErrCategoryNotFound if the category does not exist.
+func (r *CategoryRepo) PathWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, id string) ([]string, error) {
+	path := make([]string, 0)
+	seen := make(map[string]bool)
+
+	// The walk stops at a category seen before so that a corrupt tree
+	// cannot loop forever.
+	for id != "" && !seen[id] {
+		row, err := txn.ReadRow(ctx, m_category.TableName, spanner.Key{id}, []string{m_category.ParentID})
+		if err != nil {
+			if spanner.ErrCode(err) == 5 { // NotFound
+				return nil, domain.ErrCategoryNotFound
+			}
+			return nil, err
+		}
+
+		var parentID spanner.NullString
+		if err := row.Columns(&parentID); err != nil {
+			return nil, err
+		}
+
+		path = append(path, id)
+		seen[id] = true
+		id = parentID.StringVal
+	}
+
+	return path, nil
+}
+
+// HasChildrenWithTxn reports whether any category has the given parent,
+// within a transaction.
+func (r *CategoryRepo) HasChildrenWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, id string) (bool, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s = @parentId LIMIT 1",
+			m_category.CategoryID,
+			m_category.TableName,
+			m_category.IndexParent,
+			m_category.ParentID,
+		),
+		Params: map[string]interface{}{
+			"parentId": id,
+		},
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	_, err := iter.Next()
+	if err == iterator.Done {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ChildrenWithTxn retrieves the categories whose parent is one of
+// parentIDs, within a transaction.
+func (r *CategoryRepo) ChildrenWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, parentIDs []string) ([]*domain.Category, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s IN UNNEST(@parentIds)",
+			buildCategoryColumns(),
+			m_category.TableName,
+			m_category.IndexParent,
+			m_category.ParentID,
+		),
+		Params: map[string]interface{}{
+			"parentIds": parentIDs,
+		},
+	}
+
+	children := make([]*domain.Category, 0)
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		child, err := r.rowToCategory(row)
+		if err != nil {
+			return err
+		}
+		children = append(children, child)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return children, nil
+}
+
+// InsertMut returns a mutation for inserting a new category.
+func (r *CategoryRepo) InsertMut(c *domain.Category) *spanner.Mutation {
+	if !c.IsNew() {
+		return nil
+	}
+
+	return r.model.InsertMut(&m_category.Category{
+		CategoryID:  c.ID(),
+		Slug:        c.Slug(),
+		ParentID:    stringToNull(c.ParentID()),
+		DisplayName: c.DisplayName(),
+		CreatedAt:   c.CreatedAt(),
+		UpdatedAt:   c.UpdatedAt(),
+	})
+}
+
+// UpdateMut returns a mutation saving the category's slug, parent and
+// display name.
+func (r *CategoryRepo) UpdateMut(c *domain.Category) *spanner.Mutation {
+	if c.IsNew() {
+		return nil
+	}
+
+	return r.model.UpdateMut(c.ID(), map[string]interface{}{
+		m_category.Slug:        c.Slug(),
+		m_category.ParentID:    stringToNull(c.ParentID()),
+		m_category.DisplayName: c.DisplayName(),
+		m_category.UpdatedAt:   c.UpdatedAt(),
+	})
+}
+
+// DeleteMut returns a mutation deleting a category.
+func (r *CategoryRepo) DeleteMut(categoryID string) *spanner.Mutation {
+	return r.model.DeleteMut(categoryID)
+}
+
+// Get retrieves a category read model by ID.
+func (r *CategoryRepo) Get(ctx context.Context, id string) (*contracts.CategoryReadModel, error) {
+	category, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategoryReadModel(category), nil
+}
+
+// List retrieves a page of categories, ordered by slug. One extra row is
+// read to learn whether another page exists.
+func (r *CategoryRepo) List(
+	ctx context.Context,
+	filters contracts.CategoryListFilters,
+	limit, offset int,
+) (*contracts.CategoryListResult, error) {
+	where := "1=1"
+	params := map[string]interface{}{
+		"limit":  int64(limit + 1),
+		"offset": int64(offset),
+	}
+
+	if filters.ParentID != nil {
+		if *filters.ParentID == "" {
+			where = fmt.Sprintf("%s IS NULL", m_category.ParentID)
+		} else {
+			where = fmt.Sprintf("%s = @parentId", m_category.ParentID)
+			params["parentId"] = *filters.ParentID
+		}
+	}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT @limit OFFSET @offset",
+			buildCategoryColumns(),
+			m_category.TableName,
+			where,
+			m_category.Slug,
+		),
+		Params: params,
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	categories := make([]*contracts.CategoryReadModel, 0, limit)
+	err := iter.Do(func(row *spanner.Row) error {
+		category, err := r.rowToCategory(row)
+		if err != nil {
+			return err
+		}
+		categories = append(categories, toCategoryReadModel(category))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &contracts.CategoryListResult{Categories: categories}
+	if len(categories) > limit {
+		result.Categories = categories[:limit]
+		result.HasMore = true
+	}
+
+	return result, nil
+}
+
+// SubtreeIDs returns the ID of the category followed by the IDs of all its
+// descendants, reading the tree one level at a time from a single snapshot.
+func (r *CategoryRepo) SubtreeIDs(ctx context.Context, id string) ([]string, error) {
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	if _, err := txn.ReadRow(ctx, m_category.TableName, spanner.Key{id}, []string{m_category.CategoryID}); err != nil {
+		if spanner.ErrCode(err) == 5 { // NotFound
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	ids := []string{id}
+	seen := map[string]bool{id: true}
+	level := []string{id}
+
+	for len(level) > 0 {
+		stmt := spanner.Statement{
+			SQL: fmt.Sprintf(
+				"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s IN UNNEST(@parentIds)",
+				m_category.CategoryID,
+				m_category.TableName,
+				m_category.IndexParent,
+				m_category.ParentID,
+			),
+			Params: map[string]interface{}{
+				"parentIds": level,
+			},
+		}
+
+		next := make([]string, 0)
+		err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+			var childID string
+			if err := row.Columns(&childID); err != nil {
+				return err
+			}
+			if !seen[childID] {
+				seen[childID] = true
+				next = append(next, childID)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, next...)
+		level = next
+	}
+
+	return ids, nil
+}
+
+// readCategory converts the result of a ReadRow into a category, mapping a
+// missing row to ErrCategoryNotFound.
+func (r *CategoryRepo) readCategory(row *spanner.Row, err error) (*domain.Category, error) {
+	if err != nil {
+		if spanner.ErrCode(err) == 5 { // NotFound
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return r.rowToCategory(row)
+}
+
+func (r *CategoryRepo) rowToCategory(row *spanner.Row) (*domain.Category, error) {
+	var dbCategory m_category.Category
+
+	err := row.Columns(
+		&dbCategory.CategoryID,
+		&dbCategory.Slug,
+		&dbCategory.ParentID,
+		&dbCategory.DisplayName,
+		&dbCategory.CreatedAt,
+		&dbCategory.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstituteCategory(
+		dbCategory.CategoryID,
+		dbCategory.Slug,
+		dbCategory.ParentID.StringVal,
+		dbCategory.DisplayName,
+		dbCategory.CreatedAt,
+		dbCategory.UpdatedAt,
+	), nil
+}
+
+// toCategoryReadModel flattens a category.
+func toCategoryReadModel(c *domain.Category) *contracts.CategoryReadModel {
+	return &contracts.CategoryReadModel{
+		ID:          c.ID(),
+		Slug:        c.Slug(),
+		ParentID:    c.ParentID(),
+		DisplayName: c.DisplayName(),
+		CreatedAt:   c.CreatedAt(),
+		UpdatedAt:   c.UpdatedAt(),
+	}
+}
+
+func buildCategoryColumns() string {
+	return strings.Join(m_category.AllColumns(), ", ")
+}
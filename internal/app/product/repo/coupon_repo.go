@@ -0,0 +1,214 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/models/m_coupon"
+	"github.com/product-catalog-service/internal/models/m_coupon_redemption"
+)
+
+// CouponRepo handles the Coupon aggregate's persistence and its redemptions.
+type CouponRepo struct {
+	client          *spanner.Client
+	model           *m_coupon.Model
+	redemptionModel *m_coupon_redemption.Model
+}
+
+// NewCouponRepo creates a new CouponRepo.
+func NewCouponRepo(client *spanner.Client) *CouponRepo {
+	return &CouponRepo{
+		client:          client,
+		model:           m_coupon.NewModel(),
+		redemptionModel: m_coupon_redemption.NewModel(),
+	}
+}
+
+// GetByCode retrieves a coupon by its code, in any case.
+func (r *CouponRepo) GetByCode(ctx context.Context, code string) (*domain.Coupon, error) {
+	iter := r.client.Single().Query(ctx, r.byCodeStmt(code))
+	return r.single(iter)
+}
+
+// GetByCodeWithTxn retrieves a coupon by its code within a transaction.
+func (r *CouponRepo) GetByCodeWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, code string) (*domain.Coupon, error) {
+	iter := txn.Query(ctx, r.byCodeStmt(code))
+	return r.single(iter)
+}
+
+// RedemptionExistsWithTxn reports whether the coupon was already redeemed for
+// the order, within a transaction.
+func (r *CouponRepo) RedemptionExistsWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, couponID, orderID string) (bool, error) {
+	_, err := txn.ReadRow(
+		ctx,
+		m_coupon_redemption.TableName,
+		spanner.Key{couponID, orderID},
+		[]string{m_coupon_redemption.OrderID},
+	)
+	if err != nil {
+		if spanner.ErrCode(err) == 5 { // NotFound
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// InsertMut returns a mutation for inserting a new coupon.
+func (r *CouponRepo) InsertMut(c *domain.Coupon) *spanner.Mutation {
+	if !c.IsNew() {
+		return nil
+	}
+
+	dbCoupon := &m_coupon.Coupon{
+		CouponID:        c.ID(),
+		Code:            c.Code(),
+		ProductIDs:      c.Eligibility().ProductIDs,
+		Categories:      c.Eligibility().Categories,
+		StartDate:       c.StartDate(),
+		EndDate:         c.EndDate(),
+		MaxRedemptions:  c.MaxRedemptions(),
+		RedemptionCount: c.RedemptionCount(),
+		CreatedAt:       c.CreatedAt(),
+		UpdatedAt:       c.UpdatedAt(),
+	}
+
+	rule := c.Rule()
+	if rule.IsFixed() {
+		dbCoupon.AmountOffNumerator = spanner.NullInt64{Int64: rule.AmountOff.Numerator(), Valid: true}
+		dbCoupon.AmountOffDenominator = spanner.NullInt64{Int64: rule.AmountOff.Denominator(), Valid: true}
+	} else {
+		dbCoupon.Percentage = spanner.NullInt64{Int64: rule.Percentage, Valid: true}
+	}
+
+	return r.model.InsertMut(dbCoupon)
+}
+
+// RedeemMuts returns the mutations saving a redemption: the coupon's new
+// redemption count and the redemption row.
+func (r *CouponRepo) RedeemMuts(c *domain.Coupon, redemption domain.CouponRedemption) []*spanner.Mutation {
+	return []*spanner.Mutation{
+		r.model.UpdateMut(c.ID(), map[string]interface{}{
+			m_coupon.RedemptionCount: c.RedemptionCount(),
+			m_coupon.UpdatedAt:       c.UpdatedAt(),
+		}),
+		r.redemptionModel.InsertMut(&m_coupon_redemption.CouponRedemption{
+			CouponID:   c.ID(),
+			OrderID:    redemption.OrderID,
+			ProductIDs: redemption.ProductIDs,
+			RedeemedAt: redemption.RedeemedAt,
+		}),
+	}
+}
+
+// ForCategoriesWithTxn returns, for each of the categories, the codes of the
+// coupons still redeemable after now that list it, within a transaction.
+func (r *CouponRepo) ForCategoriesWithTxn(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	categories []string,
+	now time.Time,
+) (map[string][]string, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %[1]s, %[2]s FROM %[3]s WHERE %[4]s >= @now AND (%[5]s = 0 OR %[6]s < %[5]s) AND EXISTS (SELECT 1 FROM UNNEST(%[2]s) AS c WHERE c IN UNNEST(@categories))",
+			m_coupon.Code,
+			m_coupon.Categories,
+			m_coupon.TableName,
+			m_coupon.EndDate,
+			m_coupon.MaxRedemptions,
+			m_coupon.RedemptionCount,
+		),
+		Params: map[string]interface{}{
+			"categories": categories,
+			"now":        now,
+		},
+	}
+
+	return groupByCategory(ctx, txn, stmt, categories)
+}
+
+func (r *CouponRepo) byCodeStmt(code string) spanner.Statement {
+	return spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s = @code",
+			strings.Join(m_coupon.AllColumns(), ", "),
+			m_coupon.TableName,
+			m_coupon.IndexCode,
+			m_coupon.Code,
+		),
+		Params: map[string]interface{}{
+			"code": domain.NormalizeCouponCode(code),
+		},
+	}
+}
+
+// single returns the only coupon read by iter, or ErrCouponNotFound.
+func (r *CouponRepo) single(iter *spanner.RowIterator) (*domain.Coupon, error) {
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return nil, domain.ErrCouponNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.rowToCoupon(row)
+}
+
+func (r *CouponRepo) rowToCoupon(row *spanner.Row) (*domain.Coupon, error) {
+	var dbCoupon m_coupon.Coupon
+
+	err := row.Columns(
+		&dbCoupon.CouponID,
+		&dbCoupon.Code,
+		&dbCoupon.Percentage,
+		&dbCoupon.AmountOffNumerator,
+		&dbCoupon.AmountOffDenominator,
+		&dbCoupon.ProductIDs,
+		&dbCoupon.Categories,
+		&dbCoupon.StartDate,
+		&dbCoupon.EndDate,
+		&dbCoupon.MaxRedemptions,
+		&dbCoupon.RedemptionCount,
+		&dbCoupon.CreatedAt,
+		&dbCoupon.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := domain.CouponRule{Percentage: dbCoupon.Percentage.Int64}
+	if dbCoupon.AmountOffNumerator.Valid {
+		amountOff, err := domain.NewMoney(dbCoupon.AmountOffNumerator.Int64, dbCoupon.AmountOffDenominator.Int64)
+		if err != nil {
+			return nil, err
+		}
+		rule = domain.CouponRule{AmountOff: amountOff}
+	}
+
+	return domain.ReconstituteCoupon(
+		dbCoupon.CouponID,
+		dbCoupon.Code,
+		rule,
+		domain.CouponEligibility{
+			ProductIDs: dbCoupon.ProductIDs,
+			Categories: dbCoupon.Categories,
+		},
+		dbCoupon.StartDate,
+		dbCoupon.EndDate,
+		dbCoupon.MaxRedemptions,
+		dbCoupon.RedemptionCount,
+		dbCoupon.CreatedAt,
+		dbCoupon.UpdatedAt,
+	), nil
+}
@@ -7,7 +7,10 @@
 // Key implementations:
 //   - ProductRepo: Handles product aggregate persistence with change tracking
 //   - OutboxRepo: Handles transactional outbox event persistence
+//   - OutboxReadRepo: Reads pending outbox events for the projections
 //   - ReadModelRepo: Optimized read-only queries for CQRS read side
+//   - ProductViewRepo: Writes the denormalized product_views read model
+//   - ProductSearchRepo: Full-text search over product_views
 //
 // Repositories use change tracking to generate targeted updates, only
 // persisting fields that have actually changed in the domain aggregate.
@@ -0,0 +1,125 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/models/m_outbox"
+)
+
+// OutboxReadRepo implements OutboxReadRepository for Spanner.
+type OutboxReadRepo struct {
+	client *spanner.Client
+	model  *m_outbox.Model
+}
+
+// NewOutboxReadRepo creates a new OutboxReadRepo.
+func NewOutboxReadRepo(client *spanner.Client) *OutboxReadRepo {
+	return &OutboxReadRepo{
+		client: client,
+		model:  m_outbox.NewModel(),
+	}
+}
+
+// ListUnprojected returns up to limit events not yet projected, oldest first.
+func (r *OutboxReadRepo) ListUnprojected(ctx context.Context, limit int) ([]*contracts.OutboxEvent, error) {
+	query := fmt.Sprintf(
+		"SELECT %s, %s, %s, %s, %s, %s FROM %s WHERE %s IS NULL ORDER BY %s, %s LIMIT @limit",
+		m_outbox.EventID,
+		m_outbox.EventType,
+		m_outbox.AggregateID,
+		m_outbox.Payload,
+		m_outbox.Status,
+		m_outbox.CreatedAt,
+		m_outbox.TableName,
+		m_outbox.ProjectedAt,
+		m_outbox.CreatedAt,
+		m_outbox.EventID,
+	)
+
+	stmt := spanner.Statement{
+		SQL: query,
+		Params: map[string]interface{}{
+			"limit": int64(limit),
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	events := make([]*contracts.OutboxEvent, 0)
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			event   contracts.OutboxEvent
+			payload spanner.NullJSON
+		)
+		if err := row.Columns(
+			&event.ID,
+			&event.EventType,
+			&event.AggregateID,
+			&payload,
+			&event.Status,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if payload.Valid {
+			event.Payload = []byte(payload.String())
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// OldestUnprojectedAt returns the creation time of the oldest event not yet
+// projected.
+func (r *OutboxReadRepo) OldestUnprojectedAt(ctx context.Context) (*time.Time, error) {
+	query := fmt.Sprintf(
+		"SELECT MIN(%s) FROM %s WHERE %s IS NULL",
+		m_outbox.CreatedAt,
+		m_outbox.TableName,
+		m_outbox.ProjectedAt,
+	)
+
+	stmt := spanner.Statement{SQL: query}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	var oldest spanner.NullTime
+	if err := row.Columns(&oldest); err != nil {
+		return nil, err
+	}
+	if !oldest.Valid {
+		return nil, nil
+	}
+
+	return &oldest.Time, nil
+}
+
+// MarkProjectedMut returns a mutation recording that an event was projected.
+// The event stays pending for the publisher.
+func (r *OutboxReadRepo) MarkProjectedMut(eventID string, projectedAt time.Time) *spanner.Mutation {
+	return r.model.MarkProjectedMut(eventID, projectedAt)
+}
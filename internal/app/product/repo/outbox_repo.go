@@ -45,7 +45,7 @@ func (r *OutboxRepo) InsertMut(event *contracts.OutboxEvent) *spanner.Mutation {
 
 // InsertFromDomainEventMut creates an outbox event from a domain event.
 func (r *OutboxRepo) InsertFromDomainEventMut(event domain.DomainEvent) (*spanner.Mutation, error) {
-	payload, err := r.serializeEvent(event)
+	payload, err := r.SerializeEvent(event)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +61,8 @@ func (r *OutboxRepo) InsertFromDomainEventMut(event domain.DomainEvent) (*spanne
 	return r.InsertMut(outboxEvent), nil
 }
 
-func (r *OutboxRepo) serializeEvent(event domain.DomainEvent) ([]byte, error) {
+// SerializeEvent returns the JSON payload an event is stored with in the outbox.
+func (r *OutboxRepo) SerializeEvent(event domain.DomainEvent) ([]byte, error) {
 	eventData := map[string]interface{}{
 		"event_type":   event.EventType(),
 		"aggregate_id": event.AggregateID(),
@@ -77,11 +78,33 @@ func (r *OutboxRepo) serializeEvent(event domain.DomainEvent) ([]byte, error) {
 			"numerator":   e.BasePrice.Numerator(),
 			"denominator": e.BasePrice.Denominator(),
 		}
+		if e.ExternalKey != "" {
+			eventData["external_key"] = e.ExternalKey
+		}
+		if e.CategoryID != "" {
+			eventData["category_id"] = e.CategoryID
+		}
 
 	case *domain.ProductUpdatedEvent:
 		eventData["name"] = e.Name
 		eventData["description"] = e.Description
 		eventData["category"] = e.Category
+		if e.CategoryID != "" {
+			eventData["category_id"] = e.CategoryID
+		}
+		if len(e.Attributes) > 0 {
+			eventData["attributes"] = e.Attributes
+		}
+
+	case *domain.ProductBasePriceChangedEvent:
+		eventData["old_price"] = map[string]int64{
+			"numerator":   e.OldPrice.Numerator(),
+			"denominator": e.OldPrice.Denominator(),
+		}
+		eventData["new_price"] = map[string]int64{
+			"numerator":   e.NewPrice.Numerator(),
+			"denominator": e.NewPrice.Denominator(),
+		}
 
 	case *domain.ProductActivatedEvent:
 		// No additional data
@@ -92,13 +115,110 @@ func (r *OutboxRepo) serializeEvent(event domain.DomainEvent) ([]byte, error) {
 	case *domain.ProductArchivedEvent:
 		// No additional data
 
+	case *domain.ProductStatusChangedEvent:
+		eventData["from_status"] = string(e.FromStatus)
+		eventData["to_status"] = string(e.ToStatus)
+
+	case *domain.ProductScheduledEvent:
+		eventData["publish_at"] = e.PublishAt
+		eventData["unpublish_at"] = e.UnpublishAt
+
+	case *domain.ProductScheduleFailedEvent:
+		eventData["transition"] = e.Transition
+		eventData["status"] = string(e.Status)
+		violations := make([]map[string]string, len(e.Violations))
+		for i, v := range e.Violations {
+			violations[i] = map[string]string{
+				"rule":        v.Rule,
+				"field":       v.Field,
+				"description": v.Description,
+			}
+		}
+		eventData["violations"] = violations
+
+	case *domain.ProductRestoredEvent:
+		// No additional data
+
+	case *domain.ProductPurgedEvent:
+		// No additional data
+
 	case *domain.DiscountAppliedEvent:
 		eventData["percentage"] = e.Percentage
 		eventData["start_date"] = e.StartDate
 		eventData["end_date"] = e.EndDate
 
+	case *domain.DiscountStartedEvent:
+		eventData["percentage"] = e.Percentage
+		eventData["effective_price"] = map[string]int64{
+			"numerator":   e.EffectivePrice.Numerator(),
+			"denominator": e.EffectivePrice.Denominator(),
+		}
+
+	case *domain.DiscountExpiredEvent:
+		eventData["effective_price"] = map[string]int64{
+			"numerator":   e.EffectivePrice.Numerator(),
+			"denominator": e.EffectivePrice.Denominator(),
+		}
+
 	case *domain.DiscountRemovedEvent:
 		// No additional data
+
+	case *domain.CampaignCreatedEvent:
+		eventData["name"] = e.Name
+		eventData["percentage"] = e.Percentage
+		eventData["start_date"] = e.StartDate
+		eventData["end_date"] = e.EndDate
+		eventData["product_ids"] = e.Selection.ProductIDs
+		eventData["categories"] = e.Selection.Categories
+
+	case *domain.CampaignEndedEvent:
+		eventData["scheduled_end_date"] = e.ScheduledEndDate
+
+	case *domain.CouponCreatedEvent:
+		eventData["code"] = e.Code
+		if e.Rule.IsFixed() {
+			eventData["amount_off"] = map[string]int64{
+				"numerator":   e.Rule.AmountOff.Numerator(),
+				"denominator": e.Rule.AmountOff.Denominator(),
+			}
+		} else {
+			eventData["percentage"] = e.Rule.Percentage
+		}
+		eventData["product_ids"] = e.Eligibility.ProductIDs
+		eventData["categories"] = e.Eligibility.Categories
+		eventData["start_date"] = e.StartDate
+		eventData["end_date"] = e.EndDate
+		eventData["max_redemptions"] = e.MaxRedemptions
+
+	case *domain.CouponRedeemedEvent:
+		eventData["code"] = e.Code
+		eventData["order_id"] = e.OrderID
+		eventData["product_ids"] = e.ProductIDs
+		eventData["redemption_count"] = e.RedemptionCount
+
+	case *domain.CategoryCreatedEvent:
+		eventData["slug"] = e.Slug
+		eventData["display_name"] = e.DisplayName
+		if e.ParentID != "" {
+			eventData["parent_id"] = e.ParentID
+		}
+
+	case *domain.CategoryUpdatedEvent:
+		eventData["slug"] = e.Slug
+		if e.ParentID != "" {
+			eventData["parent_id"] = e.ParentID
+		}
+
+	case *domain.CategoryDeletedEvent:
+		eventData["slug"] = e.Slug
+
+	case *domain.CategoryRenamedEvent:
+		eventData["old_display_name"] = e.OldDisplayName
+		eventData["display_name"] = e.DisplayName
+
+	case *domain.CategoryMergedEvent:
+		eventData["slug"] = e.Slug
+		eventData["target_id"] = e.TargetID
 	}
 
 	return json.Marshal(eventData)
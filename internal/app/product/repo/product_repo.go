@@ -2,10 +2,13 @@ package repo
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
 
 	"github.com/product-catalog-service/internal/app/product/domain"
 	"github.com/product-catalog-service/internal/models/m_product"
@@ -97,6 +100,19 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) *spanner.Mutation {
 		updates[m_product.Category] = product.Category()
 	}
 
+	if changes.Dirty(domain.FieldCategoryID) {
+		updates[m_product.CategoryID] = stringToNull(product.CategoryID())
+	}
+
+	if changes.Dirty(domain.FieldAttributes) {
+		updates[m_product.Attributes] = attributesToNull(product.Attributes())
+	}
+
+	if changes.Dirty(domain.FieldBasePrice) {
+		updates[m_product.BasePriceNumerator] = product.BasePrice().Numerator()
+		updates[m_product.BasePriceDenominator] = product.BasePrice().Denominator()
+	}
+
 	if changes.Dirty(domain.FieldStatus) {
 		updates[m_product.Status] = string(product.Status())
 	}
@@ -133,15 +149,276 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) *spanner.Mutation {
 		}
 	}
 
+	if changes.Dirty(domain.FieldDiscountPhase) {
+		updates[m_product.DiscountPhase] = string(product.DiscountPhase())
+	}
+
+	if changes.Dirty(domain.FieldPublishAt) {
+		updates[m_product.PublishAt] = timeToNull(product.PublishAt())
+	}
+
+	if changes.Dirty(domain.FieldUnpublishAt) {
+		updates[m_product.UnpublishAt] = timeToNull(product.UnpublishAt())
+	}
+
 	if len(updates) == 0 {
 		return nil
 	}
 
 	updates[m_product.UpdatedAt] = product.UpdatedAt()
+	updates[m_product.Version] = product.Version()
 
 	return r.model.UpdateMut(product.ID(), updates)
 }
 
+// DeleteMut returns a mutation permanently deleting a product. Child rows
+// interleaved with ON DELETE CASCADE are removed with it.
+func (r *ProductRepo) DeleteMut(productID string) *spanner.Mutation {
+	return r.model.DeleteMut(productID)
+}
+
+// GetByIDs retrieves several products using a single read. Products that do
+// not exist are absent from the returned map.
+func (r *ProductRepo) GetByIDs(ctx context.Context, ids []string) (map[string]*domain.Product, error) {
+	iter := r.client.Single().Read(ctx, m_product.TableName, productKeySet(ids), m_product.AllColumns())
+	return r.collectByID(iter, len(ids))
+}
+
+// GetByIDsWithTxn retrieves several products within a transaction using a single read.
+// Products that do not exist are absent from the returned map.
+func (r *ProductRepo) GetByIDsWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, ids []string) (map[string]*domain.Product, error) {
+	iter := txn.Read(ctx, m_product.TableName, productKeySet(ids), m_product.AllColumns())
+	return r.collectByID(iter, len(ids))
+}
+
+func productKeySet(ids []string) spanner.KeySet {
+	keys := make([]spanner.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = spanner.Key{id}
+	}
+	return spanner.KeySetFromKeys(keys...)
+}
+
+// collectByID reads the products of iter into a map by ID.
+func (r *ProductRepo) collectByID(iter *spanner.RowIterator, size int) (map[string]*domain.Product, error) {
+	defer iter.Stop()
+
+	products := make(map[string]*domain.Product, size)
+	err := iter.Do(func(row *spanner.Row) error {
+		product, err := r.rowToProduct(row)
+		if err != nil {
+			return err
+		}
+		products[product.ID()] = product
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// GetByExternalKeys retrieves the products carrying the given external keys
+// using a single read-only query, without taking locks. Keys without a
+// product are absent from the map.
+func (r *ProductRepo) GetByExternalKeys(ctx context.Context, keys []string) (map[string]*domain.Product, error) {
+	iter := r.client.Single().Query(ctx, externalKeysStmt(keys))
+	return r.collectByExternalKey(iter, len(keys))
+}
+
+// GetByExternalKeysWithTxn retrieves the products carrying the given external
+// keys within a transaction. Keys without a product are absent from the map.
+func (r *ProductRepo) GetByExternalKeysWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, keys []string) (map[string]*domain.Product, error) {
+	iter := txn.Query(ctx, externalKeysStmt(keys))
+	return r.collectByExternalKey(iter, len(keys))
+}
+
+func externalKeysStmt(keys []string) spanner.Statement {
+	return spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s IN UNNEST(@keys)",
+			strings.Join(m_product.AllColumns(), ", "),
+			m_product.TableName,
+			m_product.IndexExternalKey,
+			m_product.ExternalKey,
+		),
+		Params: map[string]interface{}{
+			"keys": keys,
+		},
+	}
+}
+
+// collectByExternalKey reads the products of iter into a map by external key.
+func (r *ProductRepo) collectByExternalKey(iter *spanner.RowIterator, size int) (map[string]*domain.Product, error) {
+	defer iter.Stop()
+
+	products := make(map[string]*domain.Product, size)
+	err := iter.Do(func(row *spanner.Row) error {
+		product, err := r.rowToProduct(row)
+		if err != nil {
+			return err
+		}
+		products[product.ExternalKey()] = product
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// AnyInCategoryWithTxn reports whether any product is assigned to the
+// category, within a transaction.
+func (r *ProductRepo) AnyInCategoryWithTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, categoryID string) (bool, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s@{FORCE_INDEX=%s} WHERE %s = @categoryId LIMIT 1",
+			m_product.ProductID,
+			m_product.TableName,
+			m_product.IndexCategoryID,
+			m_product.CategoryID,
+		),
+		Params: map[string]interface{}{
+			"categoryId": categoryID,
+		},
+	}
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	_, err := iter.Next()
+	if err == iterator.Done {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ForEachID streams the ID of every product to fn, stopping at the first error.
+func (r *ProductRepo) ForEachID(ctx context.Context, fn func(id string) error) error {
+	iter := r.client.Single().Read(ctx, m_product.TableName, spanner.AllKeys(), []string{m_product.ProductID})
+	defer iter.Stop()
+
+	return iter.Do(func(row *spanner.Row) error {
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return err
+		}
+		return fn(id)
+	})
+}
+
+// ForEachArchivedBefore streams products archived at or before the given
+// time to fn, oldest first, stopping at the first error.
+func (r *ProductRepo) ForEachArchivedBefore(ctx context.Context, before time.Time, fn func(product *domain.Product) error) error {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %s FROM %s WHERE %s = @status AND %s <= @before ORDER BY %s, %s",
+			strings.Join(m_product.AllColumns(), ", "),
+			m_product.TableName,
+			m_product.Status,
+			m_product.ArchivedAt,
+			m_product.ArchivedAt,
+			m_product.ProductID,
+		),
+		Params: map[string]interface{}{
+			"status": string(domain.ProductStatusArchived),
+			"before": before,
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	return iter.Do(func(row *spanner.Row) error {
+		product, err := r.rowToProduct(row)
+		if err != nil {
+			return err
+		}
+		return fn(product)
+	})
+}
+
+// ForEachScheduleDue streams the IDs of products whose publish or unpublish
+// time is at or before now to fn, stopping at the first error.
+func (r *ProductRepo) ForEachScheduleDue(ctx context.Context, now time.Time, fn func(id string) error) error {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %[1]s FROM %[2]s@{FORCE_INDEX=%[3]s} WHERE %[4]s <= @now "+
+				"UNION DISTINCT "+
+				"SELECT %[1]s FROM %[2]s@{FORCE_INDEX=%[5]s} WHERE %[6]s <= @now",
+			m_product.ProductID,
+			m_product.TableName,
+			m_product.IndexPublishAt,
+			m_product.PublishAt,
+			m_product.IndexUnpublishAt,
+			m_product.UnpublishAt,
+		),
+		Params: map[string]interface{}{
+			"now": now,
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	return iter.Do(func(row *spanner.Row) error {
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return err
+		}
+		return fn(id)
+	})
+}
+
+// ForEachDiscountBoundaryDue streams the IDs of products whose discount has
+// started or ended at or before now without that boundary being announced.
+func (r *ProductRepo) ForEachDiscountBoundaryDue(ctx context.Context, now time.Time, fn func(id string) error) error {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"SELECT %[1]s FROM %[2]s@{FORCE_INDEX=%[3]s} WHERE %[4]s IN UNNEST(@unstarted) AND %[5]s <= @now "+
+				"UNION DISTINCT "+
+				"SELECT %[1]s FROM %[2]s@{FORCE_INDEX=%[6]s} WHERE %[4]s IN UNNEST(@unexpired) AND %[7]s < @now",
+			m_product.ProductID,
+			m_product.TableName,
+			m_product.IndexDiscountStart,
+			m_product.DiscountPhase,
+			m_product.DiscountStartDate,
+			m_product.IndexDiscountEnd,
+			m_product.DiscountEndDate,
+		),
+		Params: map[string]interface{}{
+			"now": now,
+			// Rows written before phases were tracked hold "none" with a discount
+			"unstarted": []string{
+				string(domain.DiscountPhaseNone),
+				string(domain.DiscountPhasePending),
+			},
+			"unexpired": []string{
+				string(domain.DiscountPhaseNone),
+				string(domain.DiscountPhasePending),
+				string(domain.DiscountPhaseActive),
+			},
+		},
+	}
+
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	return iter.Do(func(row *spanner.Row) error {
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return err
+		}
+		return fn(id)
+	})
+}
+
 func (r *ProductRepo) productToDBModel(p *domain.Product) *m_product.Product {
 	dbProduct := &m_product.Product{
 		ProductID:            p.ID(),
@@ -150,11 +427,20 @@ func (r *ProductRepo) productToDBModel(p *domain.Product) *m_product.Product {
 		Category:             p.Category(),
 		BasePriceNumerator:   p.BasePrice().Numerator(),
 		BasePriceDenominator: p.BasePrice().Denominator(),
+		DiscountPhase:        string(p.DiscountPhase()),
 		Status:               string(p.Status()),
 		CreatedAt:            p.CreatedAt(),
 		UpdatedAt:            p.UpdatedAt(),
+		Version:              p.Version(),
 	}
 
+	if key := p.ExternalKey(); key != "" {
+		dbProduct.ExternalKey = spanner.NullString{StringVal: key, Valid: true}
+	}
+
+	dbProduct.CategoryID = stringToNull(p.CategoryID())
+	dbProduct.Attributes = attributesToNull(p.Attributes())
+
 	if d := p.Discount(); d != nil {
 		dbProduct.DiscountPercent = spanner.NullNumeric{
 			Numeric: *big.NewRat(d.Percentage(), 1),
@@ -177,6 +463,9 @@ func (r *ProductRepo) productToDBModel(p *domain.Product) *m_product.Product {
 		}
 	}
 
+	dbProduct.PublishAt = timeToNull(p.PublishAt())
+	dbProduct.UnpublishAt = timeToNull(p.UnpublishAt())
+
 	return dbProduct
 }
 
@@ -191,10 +480,17 @@ func (r *ProductRepo) rowToProduct(row *spanner.Row) (*domain.Product, error) {
 		discountPercent      spanner.NullNumeric
 		discountStartDate    spanner.NullTime
 		discountEndDate      spanner.NullTime
+		discountPhase        string
 		status               string
 		createdAt            time.Time
 		updatedAt            time.Time
 		archivedAt           spanner.NullTime
+		publishAt            spanner.NullTime
+		unpublishAt          spanner.NullTime
+		version              int64
+		externalKey          spanner.NullString
+		categoryID           spanner.NullString
+		attributes           spanner.NullJSON
 	)
 
 	err := row.Columns(
@@ -207,10 +503,17 @@ func (r *ProductRepo) rowToProduct(row *spanner.Row) (*domain.Product, error) {
 		&discountPercent,
 		&discountStartDate,
 		&discountEndDate,
+		&discountPhase,
 		&status,
 		&createdAt,
 		&updatedAt,
 		&archivedAt,
+		&publishAt,
+		&unpublishAt,
+		&version,
+		&externalKey,
+		&categoryID,
+		&attributes,
 	)
 	if err != nil {
 		return nil, err
@@ -242,9 +545,61 @@ func (r *ProductRepo) rowToProduct(row *spanner.Row) (*domain.Product, error) {
 		category,
 		basePrice,
 		discount,
+		domain.DiscountPhase(discountPhase),
 		domain.ProductStatus(status),
 		createdAt,
 		updatedAt,
 		archivedAtPtr,
+		nullToTime(publishAt),
+		nullToTime(unpublishAt),
+		version,
+		externalKey.StringVal,
+		categoryID.StringVal,
+		nullToAttributes(attributes),
 	), nil
 }
+
+// stringToNull converts an optional string, empty when absent, to a
+// nullable column value.
+func stringToNull(s string) spanner.NullString {
+	return spanner.NullString{StringVal: s, Valid: s != ""}
+}
+
+// timeToNull converts an optional time to a nullable column value.
+func timeToNull(t *time.Time) spanner.NullTime {
+	if t == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: *t, Valid: true}
+}
+
+// attributesToNull converts product attributes, nil when there are none, to
+// a nullable JSON column value.
+func attributesToNull(attributes map[string]string) spanner.NullJSON {
+	return spanner.NullJSON{Value: attributes, Valid: len(attributes) > 0}
+}
+
+// nullToAttributes converts a nullable JSON column value to product
+// attributes. Spanner decodes the object into a map of interface values;
+// values that are not strings are skipped.
+func nullToAttributes(j spanner.NullJSON) map[string]string {
+	object, ok := j.Value.(map[string]interface{})
+	if !j.Valid || !ok || len(object) == 0 {
+		return nil
+	}
+	attributes := make(map[string]string, len(object))
+	for name, value := range object {
+		if s, ok := value.(string); ok {
+			attributes[name] = s
+		}
+	}
+	return attributes
+}
+
+// nullToTime converts a nullable column value to an optional time.
+func nullToTime(t spanner.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,175 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/models/m_product"
+	"github.com/product-catalog-service/internal/models/m_product_view"
+	"github.com/product-catalog-service/internal/pkg/clock"
+)
+
+// minSubstringLength is the ngram_size_min of text_ngram_tokens: shorter words
+// have no n-grams to match on.
+const minSubstringLength = 3
+
+// ProductSearchRepo implements ProductSearchRepository using the Spanner search
+// index on product_views. Search always reads the projected view, regardless of
+// the configured ReadModelSource, so results trail writes by the projector lag.
+type ProductSearchRepo struct {
+	client *spanner.Client
+	views  *ReadModelRepo
+}
+
+// NewProductSearchRepo creates a new ProductSearchRepo.
+func NewProductSearchRepo(client *spanner.Client, clock clock.Clock) *ProductSearchRepo {
+	return &ProductSearchRepo{
+		client: client,
+		views:  NewReadModelRepo(client, clock, ReadModelSourceProductViews),
+	}
+}
+
+// Search finds products matching text, most relevant first.
+// A row matches when every word is found in one of the full-text fields, every
+// word is a prefix of some word, or enough of the text's n-grams overlap (typos).
+// Prefix and typo matching need every word to be at least minSubstringLength
+// characters; a text with a shorter word matches whole words only.
+func (r *ProductSearchRepo) Search(
+	ctx context.Context,
+	text string,
+	filters contracts.ProductListFilters,
+	pagination contracts.Pagination,
+) (*contracts.ProductSearchResult, error) {
+	now := r.views.clock.Now()
+	where, params := r.views.buildListFilters(filters, now)
+	substring := substringSearchable(text)
+	where += " AND " + searchMatchExpr(substring)
+	params["query"] = text
+
+	// Count total
+	var totalCount int64
+	if !pagination.SkipTotalCount {
+		count, err := r.views.count(ctx, where, params)
+		if err != nil {
+			return nil, err
+		}
+		totalCount = count
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	query := fmt.Sprintf(
+		"SELECT %s, %s AS relevance FROM %s WHERE %s ORDER BY relevance DESC, %s LIMIT @limit OFFSET @offset",
+		buildSelectColumns(),
+		searchScoreExpr(substring),
+		m_product_view.TableName,
+		where,
+		m_product.ProductID,
+	)
+
+	pageParams := make(map[string]interface{}, len(params)+2)
+	for k, v := range params {
+		pageParams[k] = v
+	}
+	pageParams["limit"] = int64(pagination.Limit + 1)
+	pageParams["offset"] = int64(pagination.Offset)
+
+	iter := r.client.Single().Query(ctx, spanner.Statement{
+		SQL:    query,
+		Params: pageParams,
+	})
+	defer iter.Stop()
+
+	hits := make([]*contracts.ProductSearchHit, 0, pagination.Limit+1)
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var score float64
+		product, err := r.views.rowToReadModelAt(row, now, &score)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, &contracts.ProductSearchHit{
+			Product: product,
+			Score:   score,
+		})
+	}
+
+	result := &contracts.ProductSearchResult{
+		TotalCount: totalCount,
+	}
+
+	if len(hits) > pagination.Limit {
+		hits = hits[:pagination.Limit]
+		result.HasMore = true
+	}
+	result.Hits = hits
+
+	products := make([]*contracts.ProductReadModel, len(hits))
+	for i, hit := range hits {
+		products[i] = hit.Product
+	}
+	if err := r.views.applyRunningCampaigns(ctx, products, now); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// substringSearchable reports whether every word of text is long enough for
+// prefix and typo matching.
+func substringSearchable(text string) bool {
+	for _, word := range strings.Fields(text) {
+		if utf8.RuneCountInString(word) < minSubstringLength {
+			return false
+		}
+	}
+	return true
+}
+
+// searchMatchExpr selects rows matching @query on any search column. Without
+// substring only whole words match.
+func searchMatchExpr(substring bool) string {
+	expr := fmt.Sprintf(
+		"SEARCH(%s, @query) OR SEARCH(%s, @query) OR SEARCH(%s, @query)",
+		m_product_view.NameTokens,
+		m_product_view.DescriptionTokens,
+		m_product_view.CategoryTokens,
+	)
+	if substring {
+		expr += fmt.Sprintf(
+			" OR SEARCH_SUBSTRING(%s, @query, relative_search_type=>'word_prefix')"+
+				" OR SEARCH_NGRAMS(%s, @query, min_ngrams_percent=>40)",
+			m_product_view.TextNgramTokens,
+			m_product_view.TextNgramTokens,
+		)
+	}
+	return "(" + expr + ")"
+}
+
+// searchScoreExpr ranks matches: name hits outweigh category hits, which
+// outweigh description hits; n-gram similarity orders fuzzy matches.
+func searchScoreExpr(substring bool) string {
+	expr := fmt.Sprintf(
+		"SCORE(%s, @query) * 3 + SCORE(%s, @query) * 2 + SCORE(%s, @query)",
+		m_product_view.NameTokens,
+		m_product_view.CategoryTokens,
+		m_product_view.DescriptionTokens,
+	)
+	if substring {
+		expr += fmt.Sprintf(" + SCORE_NGRAMS(%s, @query)", m_product_view.TextNgramTokens)
+	}
+	return expr
+}
@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/models/m_product_view"
+)
+
+// ProductViewRepo writes the denormalized product_views read model.
+// Only the projector writes to this table; queries read it through ReadModelRepo.
+type ProductViewRepo struct {
+	client *spanner.Client
+	model  *m_product_view.Model
+}
+
+// NewProductViewRepo creates a new ProductViewRepo.
+func NewProductViewRepo(client *spanner.Client) *ProductViewRepo {
+	return &ProductViewRepo{
+		client: client,
+		model:  m_product_view.NewModel(),
+	}
+}
+
+// UpsertMut returns a mutation replacing the view row with the current product state.
+// The effective price is not stored: it depends on the time and on running
+// campaigns, so queries compute it from the base and discounted prices.
+func (r *ProductViewRepo) UpsertMut(p *domain.Product, projectedAt time.Time) *spanner.Mutation {
+	view := &m_product_view.ProductView{
+		ProductID:            p.ID(),
+		Name:                 p.Name(),
+		Description:          p.Description(),
+		Category:             p.Category(),
+		BasePriceNumerator:   p.BasePrice().Numerator(),
+		BasePriceDenominator: p.BasePrice().Denominator(),
+		DiscountPhase:        string(p.DiscountPhase()),
+		Status:               string(p.Status()),
+		CreatedAt:            p.CreatedAt(),
+		UpdatedAt:            p.UpdatedAt(),
+		Version:              p.Version(),
+		BasePrice:            moneyToNumeric(p.BasePrice()),
+		ProjectedAt:          projectedAt,
+	}
+
+	if d := p.Discount(); d != nil {
+		view.DiscountPercent = spanner.NullNumeric{
+			Numeric: *big.NewRat(d.Percentage(), 1),
+			Valid:   true,
+		}
+		view.DiscountStartDate = spanner.NullTime{Time: d.StartDate(), Valid: true}
+		view.DiscountEndDate = spanner.NullTime{Time: d.EndDate(), Valid: true}
+		view.DiscountedPrice = moneyToNumeric(d.Apply(p.BasePrice()))
+	}
+
+	if key := p.ExternalKey(); key != "" {
+		view.ExternalKey = spanner.NullString{StringVal: key, Valid: true}
+	}
+
+	view.CategoryID = stringToNull(p.CategoryID())
+	view.Attributes = attributesToNull(p.Attributes())
+
+	if archivedAt := p.ArchivedAt(); archivedAt != nil {
+		view.ArchivedAt = spanner.NullTime{Time: *archivedAt, Valid: true}
+	}
+
+	view.PublishAt = timeToNull(p.PublishAt())
+	view.UnpublishAt = timeToNull(p.UnpublishAt())
+
+	return r.model.InsertOrUpdateMut(view)
+}
+
+// DeleteMut returns a mutation removing the view row of a product.
+func (r *ProductViewRepo) DeleteMut(productID string) *spanner.Mutation {
+	return r.model.DeleteMut(productID)
+}
+
+// DeleteProjectedBefore removes view rows that were not projected since the given time.
+// It is used after a replay to sweep rows whose products no longer exist.
+func (r *ProductViewRepo) DeleteProjectedBefore(ctx context.Context, before time.Time) (int64, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(
+			"DELETE FROM %s WHERE %s < @before",
+			m_product_view.TableName,
+			m_product_view.ProjectedAt,
+		),
+		Params: map[string]interface{}{"before": before},
+	}
+
+	return r.client.PartitionedUpdate(ctx, stmt)
+}
+
+func moneyToNumeric(m *domain.Money) spanner.NullNumeric {
+	return spanner.NullNumeric{
+		Numeric: *m.Amount(),
+		Valid:   true,
+	}
+}
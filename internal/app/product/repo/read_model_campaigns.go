@@ -0,0 +1,94 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/models/m_campaign"
+	"github.com/product-catalog-service/internal/models/m_product"
+)
+
+// campaignSelectsExpr is true when campaign row c selects the current row of
+// table, mirroring Campaign.Selects.
+func campaignSelectsExpr(table string) string {
+	return fmt.Sprintf("(%[1]s.%[2]s IN UNNEST(c.%[3]s) OR %[1]s.%[4]s IN UNNEST(c.%[5]s))",
+		table,
+		m_product.ProductID, m_campaign.ProductIDs,
+		m_product.Category, m_campaign.Categories,
+	)
+}
+
+// campaignPercentExpr returns the highest percentage among the campaigns
+// running at @now that select the current row of table, or NULL.
+func campaignPercentExpr(table string) string {
+	return fmt.Sprintf("(SELECT MAX(c.%s) FROM %s AS c WHERE %s AND %s)",
+		m_campaign.DiscountPercent,
+		m_campaign.TableName,
+		campaignRunningExpr("c", "@now"),
+		campaignSelectsExpr(table),
+	)
+}
+
+// withCampaignsExpr lowers a row's effective price to its base price less
+// the best campaign running at @now, when that is cheaper. The discounts do
+// not stack, so the lower of the two prices is the highest percentage.
+func (r *ReadModelRepo) withCampaignsExpr(effectivePrice string) string {
+	return fmt.Sprintf("LEAST(%s, %s * (100 - IFNULL(%s, 0)) / 100)",
+		effectivePrice,
+		r.basePriceExpr(),
+		campaignPercentExpr(r.table()),
+	)
+}
+
+// campaignFilterExpr is true when the campaign @campaignId selects the
+// current row, whether or not it is running.
+func (r *ReadModelRepo) campaignFilterExpr() string {
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s AS c WHERE c.%s = @campaignId AND %s)",
+		m_campaign.TableName,
+		m_campaign.CampaignID,
+		campaignSelectsExpr(r.table()),
+	)
+}
+
+// applyRunningCampaigns loads the campaigns running at now and applies them
+// to the products' effective prices.
+func (r *ReadModelRepo) applyRunningCampaigns(ctx context.Context, products []*contracts.ProductReadModel, now time.Time) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	campaigns, err := r.campaigns.ListRunning(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	applyCampaigns(products, campaigns, now)
+	return nil
+}
+
+// applyCampaigns lowers the effective price of every product selected by one
+// of the campaigns running at now whose percentage beats the product's own
+// running discount.
+func applyCampaigns(products []*contracts.ProductReadModel, campaigns []*domain.Campaign, now time.Time) {
+	if len(campaigns) == 0 {
+		return
+	}
+
+	for _, p := range products {
+		c := domain.BestCampaign(campaigns, p.ID, p.Category, now)
+		if c == nil {
+			continue
+		}
+
+		num := p.BasePriceNumerator * (100 - c.Discount().Percentage())
+		denom := p.BasePriceDenominator * 100
+		if big.NewRat(num, denom).Cmp(big.NewRat(p.EffectivePriceNum, p.EffectivePriceDenom)) < 0 {
+			p.EffectivePriceNum = num
+			p.EffectivePriceDenom = denom
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+// exportBatchSize is how many rows a partition reader hands over at a time.
+const exportBatchSize = 500
+
+// Export streams the products matching filters to fn in batches. The rows
+// are read in a batch read-only transaction, so they all come from a single
+// read timestamp however long the export runs; effective prices are evaluated
+// at that timestamp too, with the campaigns running then, and it is returned.
+// Effective price filters are applied to the rows after they are read.
+// The query is split into Spanner partitions, read by up to parallelism
+// workers at once. Batches arrive in no particular order, but fn is never
+// called concurrently.
+func (r *ReadModelRepo) Export(
+	ctx context.Context,
+	filters contracts.ProductListFilters,
+	parallelism int,
+	fn func(readTimestamp time.Time, products []*contracts.ProductReadModel) error,
+) (time.Time, error) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	txn, err := r.client.BatchReadOnlyTransaction(ctx, spanner.StrongRead())
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer txn.Cleanup(context.WithoutCancel(ctx))
+
+	readTimestamp, err := txn.Timestamp()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	campaigns, err := r.campaigns.ListRunningWithTxn(ctx, &txn.ReadOnlyTransaction, readTimestamp)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Partitioned queries must be root-partitionable, so no ORDER BY or LIMIT,
+	// nor the correlated campaigns subquery the effective price filters need
+	prices := effectivePriceRange{min: filters.MinEffectivePrice, max: filters.MaxEffectivePrice}
+	filters.MinEffectivePrice, filters.MaxEffectivePrice = nil, nil
+	where, params := r.buildListFilters(filters, readTimestamp)
+	stmt := spanner.Statement{
+		SQL:    fmt.Sprintf("SELECT %s FROM %s WHERE %s", buildSelectColumns(), r.table(), where),
+		Params: params,
+	}
+
+	partitions, err := txn.PartitionQuery(ctx, stmt, spanner.PartitionOptions{})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	work := make(chan *spanner.Partition)
+	batches := make(chan []*contracts.ProductReadModel)
+	errs := make(chan error, parallelism)
+
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partition := range work {
+				if err := r.exportPartition(ctx, txn, partition, readTimestamp, campaigns, prices, batches); err != nil {
+					errs <- err
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(work)
+		for _, partition := range partitions {
+			select {
+			case work <- partition:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(batches)
+	}()
+
+	// Keep draining after a failure so no worker stays blocked on a send
+	var fnErr error
+	for batch := range batches {
+		if fnErr != nil {
+			continue
+		}
+		if fnErr = fn(readTimestamp, batch); fnErr != nil {
+			cancel()
+		}
+	}
+	if fnErr != nil {
+		return time.Time{}, fnErr
+	}
+
+	select {
+	case err := <-errs:
+		return time.Time{}, err
+	default:
+		return readTimestamp, nil
+	}
+}
+
+// exportPartition reads one partition and sends its rows within prices in
+// batches.
+func (r *ReadModelRepo) exportPartition(
+	ctx context.Context,
+	txn *spanner.BatchReadOnlyTransaction,
+	partition *spanner.Partition,
+	readTimestamp time.Time,
+	campaigns []*domain.Campaign,
+	prices effectivePriceRange,
+	batches chan<- []*contracts.ProductReadModel,
+) error {
+	iter := txn.Execute(ctx, partition)
+	defer iter.Stop()
+
+	send := func(batch []*contracts.ProductReadModel) error {
+		select {
+		case batches <- batch:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	batch := make([]*contracts.ProductReadModel, 0, exportBatchSize)
+	err := iter.Do(func(row *spanner.Row) error {
+		product, err := r.rowToReadModelAt(row, readTimestamp)
+		if err != nil {
+			return err
+		}
+		applyCampaigns([]*contracts.ProductReadModel{product}, campaigns, readTimestamp)
+		if !prices.contains(product) {
+			return nil
+		}
+
+		batch = append(batch, product)
+		if len(batch) < exportBatchSize {
+			return nil
+		}
+		if err := send(batch); err != nil {
+			return err
+		}
+		batch = make([]*contracts.ProductReadModel, 0, exportBatchSize)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		return send(batch)
+	}
+	return nil
+}
+
+// effectivePriceRange is an inclusive range of effective prices. A nil bound
+// is open.
+type effectivePriceRange struct {
+	min, max *big.Rat
+}
+
+// contains reports whether the product's effective price is within the range.
+func (pr effectivePriceRange) contains(p *contracts.ProductReadModel) bool {
+	if pr.min == nil && pr.max == nil {
+		return true
+	}
+	price := big.NewRat(p.EffectivePriceNum, p.EffectivePriceDenom)
+	if pr.min != nil && price.Cmp(pr.min) < 0 {
+		return false
+	}
+	return pr.max == nil || price.Cmp(pr.max) <= 0
+}
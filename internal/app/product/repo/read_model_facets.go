@@ -0,0 +1,171 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/models/m_product"
+)
+
+// Facets aggregates the products matching filters. All aggregations run in a
+// single read-only transaction so the counts describe the same snapshot.
+func (r *ReadModelRepo) Facets(
+	ctx context.Context,
+	filters contracts.ProductListFilters,
+	bandBoundaries []*big.Rat,
+) (*contracts.ProductFacets, error) {
+	now := r.clock.Now()
+	where, params := r.buildListFilters(filters, now)
+
+	// Discount and price band expressions depend on the current time
+	aggParams := make(map[string]interface{}, len(params)+len(bandBoundaries)+1)
+	for k, v := range params {
+		aggParams[k] = v
+	}
+	aggParams["now"] = now
+
+	bandExpr := r.priceBandExpr(bandBoundaries)
+	for i, b := range bandBoundaries {
+		aggParams[fmt.Sprintf("band%d", i)] = b
+	}
+
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	categories, err := r.groupCount(ctx, txn, m_product.Category, where, params)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := r.groupCount(ctx, txn, m_product.Status, where, params)
+	if err != nil {
+		return nil, err
+	}
+
+	discounts, err := r.groupCount(ctx, txn, discountBucketExpr(), where, aggParams)
+	if err != nil {
+		return nil, err
+	}
+
+	bands, err := r.groupCount(ctx, txn, bandExpr, where, aggParams)
+	if err != nil {
+		return nil, err
+	}
+
+	facets := &contracts.ProductFacets{
+		Categories: categories,
+		Statuses:   statuses,
+	}
+
+	// Every row has a status, so the status counts add up to the total
+	for _, s := range statuses {
+		facets.TotalCount += s.Count
+	}
+
+	discountCounts := facetCountMap(discounts)
+	for _, bucket := range contracts.DiscountBuckets() {
+		facets.DiscountBuckets = append(facets.DiscountBuckets, contracts.FacetCount{
+			Value: bucket,
+			Count: discountCounts[bucket],
+		})
+	}
+
+	bandCounts := facetCountMap(bands)
+	for i := 0; i <= len(bandBoundaries); i++ {
+		band := contracts.PriceBandCount{Count: bandCounts[strconv.Itoa(i)]}
+		if i > 0 {
+			band.Min = bandBoundaries[i-1]
+		}
+		if i < len(bandBoundaries) {
+			band.Max = bandBoundaries[i]
+		}
+		facets.PriceBands = append(facets.PriceBands, band)
+	}
+
+	return facets, nil
+}
+
+// groupCount counts rows matching where per value of expr, most frequent first.
+func (r *ReadModelRepo) groupCount(
+	ctx context.Context,
+	txn *spanner.ReadOnlyTransaction,
+	expr string,
+	where string,
+	params map[string]interface{},
+) ([]contracts.FacetCount, error) {
+	query := fmt.Sprintf(
+		"SELECT CAST(%s AS STRING) AS value, COUNT(*) AS n FROM %s WHERE %s GROUP BY value ORDER BY n DESC, value",
+		expr,
+		r.table(),
+		where,
+	)
+
+	iter := txn.Query(ctx, spanner.Statement{
+		SQL:    query,
+		Params: params,
+	})
+	defer iter.Stop()
+
+	counts := make([]contracts.FacetCount, 0)
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var fc contracts.FacetCount
+		if err := row.Columns(&fc.Value, &fc.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, fc)
+	}
+
+	return counts, nil
+}
+
+// priceBandExpr returns the index of the band containing a row's effective
+// price. Band i covers [@band{i-1}, @band{i}).
+func (r *ReadModelRepo) priceBandExpr(bandBoundaries []*big.Rat) string {
+	if len(bandBoundaries) == 0 {
+		return "0"
+	}
+
+	expr := "CASE"
+	for i := range bandBoundaries {
+		expr += fmt.Sprintf(" WHEN %s < @band%d THEN %d", r.effectivePriceExpr(), i, i)
+	}
+	expr += fmt.Sprintf(" ELSE %d END", len(bandBoundaries))
+
+	return expr
+}
+
+// discountBucketExpr labels a row with the bucket of its discount running at @now.
+func discountBucketExpr() string {
+	return fmt.Sprintf(
+		"CASE WHEN %s THEN CASE WHEN %s < 25 THEN '%s' WHEN %s < 50 THEN '%s' WHEN %s < 75 THEN '%s' ELSE '%s' END ELSE '%s' END",
+		discountActiveExpr("@now"),
+		m_product.DiscountPercent, contracts.DiscountBucket1To24,
+		m_product.DiscountPercent, contracts.DiscountBucket25To49,
+		m_product.DiscountPercent, contracts.DiscountBucket50To74,
+		contracts.DiscountBucket75To100,
+		contracts.DiscountBucketNone,
+	)
+}
+
+func facetCountMap(counts []contracts.FacetCount) map[string]int64 {
+	m := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		m[c.Value] = c.Count
+	}
+	return m
+}
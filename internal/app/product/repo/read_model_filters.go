@@ -0,0 +1,150 @@
+package repo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/models/m_product"
+	"github.com/product-catalog-service/internal/models/m_product_view"
+)
+
+// buildListFilters translates list filters into a WHERE clause and its params.
+// The clause is shared by the page and count queries, so every filter is
+// evaluated by Spanner and total_count/has_more agree with the returned rows.
+// Time-dependent predicates are evaluated at now.
+func (r *ReadModelRepo) buildListFilters(
+	filters contracts.ProductListFilters,
+	now time.Time,
+) (string, map[string]interface{}) {
+	where := "1=1"
+	params := make(map[string]interface{})
+
+	if filters.ActiveOnly {
+		where += fmt.Sprintf(" AND %s = @status", m_product.Status)
+		params["status"] = string(domain.ProductStatusActive)
+	} else if filters.Status != nil {
+		where += fmt.Sprintf(" AND %s = @status", m_product.Status)
+		params["status"] = *filters.Status
+	}
+
+	if filters.Category != nil && *filters.Category != "" {
+		where += fmt.Sprintf(" AND %s = @category", m_product.Category)
+		params["category"] = *filters.Category
+	}
+
+	if len(filters.CategoryIDs) > 0 {
+		where += fmt.Sprintf(" AND %s IN UNNEST(@categoryIds)", m_product.CategoryID)
+		params["categoryIds"] = filters.CategoryIDs
+	}
+
+	if filters.CampaignID != nil {
+		where += " AND " + r.campaignFilterExpr()
+		params["campaignId"] = *filters.CampaignID
+	}
+
+	// Price ranges (inclusive)
+	if filters.MinBasePrice != nil {
+		where += fmt.Sprintf(" AND %s >= @minBasePrice", r.basePriceExpr())
+		params["minBasePrice"] = filters.MinBasePrice
+	}
+	if filters.MaxBasePrice != nil {
+		where += fmt.Sprintf(" AND %s <= @maxBasePrice", r.basePriceExpr())
+		params["maxBasePrice"] = filters.MaxBasePrice
+	}
+	if filters.MinEffectivePrice != nil {
+		where += fmt.Sprintf(" AND %s >= @minEffectivePrice", r.effectivePriceExpr())
+		params["minEffectivePrice"] = filters.MinEffectivePrice
+		params["now"] = now
+	}
+	if filters.MaxEffectivePrice != nil {
+		where += fmt.Sprintf(" AND %s <= @maxEffectivePrice", r.effectivePriceExpr())
+		params["maxEffectivePrice"] = filters.MaxEffectivePrice
+		params["now"] = now
+	}
+
+	// Discount filters: a minimum percentage implies the discount is running,
+	// at on_sale_at when given and now otherwise
+	if filters.OnSaleAt != nil || filters.MinDiscountPercent != nil {
+		saleAt := now
+		if filters.OnSaleAt != nil {
+			saleAt = *filters.OnSaleAt
+		}
+		where += " AND " + discountActiveExpr("@onSaleAt")
+		params["onSaleAt"] = saleAt
+	}
+	if filters.MinDiscountPercent != nil {
+		where += fmt.Sprintf(" AND %s >= @minDiscountPercent", m_product.DiscountPercent)
+		params["minDiscountPercent"] = *filters.MinDiscountPercent
+	}
+
+	// Exclude archived by default
+	switch filters.ArchivedMode {
+	case contracts.ArchivedModeInclude:
+		// No restriction on status
+	case contracts.ArchivedModeOnly:
+		where += fmt.Sprintf(" AND %s = @archivedStatus", m_product.Status)
+		params["archivedStatus"] = string(domain.ProductStatusArchived)
+	default:
+		where += fmt.Sprintf(" AND %s != @archivedStatus", m_product.Status)
+		params["archivedStatus"] = string(domain.ProductStatusArchived)
+	}
+
+	return where, params
+}
+
+// basePriceExpr returns the base price of a row as NUMERIC.
+func (r *ReadModelRepo) basePriceExpr() string {
+	if r.source == ReadModelSourceProductViews {
+		return m_product_view.BasePrice
+	}
+	return productsBasePriceExpr()
+}
+
+// effectivePriceExpr returns the effective price of a row at @now as NUMERIC,
+// including the campaigns running at @now.
+// On product_views it is derived from the stored base and discounted prices.
+func (r *ReadModelRepo) effectivePriceExpr() string {
+	if r.source == ReadModelSourceProductViews {
+		return r.withCampaignsExpr(fmt.Sprintf("CASE WHEN %s THEN %s ELSE %s END",
+			discountActiveExpr("@now"),
+			m_product_view.DiscountedPrice,
+			m_product_view.BasePrice,
+		))
+	}
+	return r.withCampaignsExpr(productsEffectivePriceExpr())
+}
+
+// discountActiveExpr is true when a row's discount window contains the
+// timestamp parameter at, mirroring the inclusive check in rowToReadModelAt.
+// Both tables share the discount column names.
+func discountActiveExpr(at string) string {
+	return fmt.Sprintf("(%s IS NOT NULL AND %s <= %s AND %s >= %s)",
+		m_product.DiscountPercent,
+		m_product.DiscountStartDate, at,
+		m_product.DiscountEndDate, at,
+	)
+}
+
+// productsBasePriceExpr computes the base price of a products row as NUMERIC.
+func productsBasePriceExpr() string {
+	return fmt.Sprintf("CAST(%s AS NUMERIC) / CAST(%s AS NUMERIC)",
+		m_product.BasePriceNumerator,
+		m_product.BasePriceDenominator,
+	)
+}
+
+// productsEffectivePriceExpr computes the effective price of a products row at @now.
+func productsEffectivePriceExpr() string {
+	return fmt.Sprintf(
+		"CASE WHEN %s "+
+			"THEN CAST(%s AS NUMERIC) * (100 - %s) / (CAST(%s AS NUMERIC) * 100) "+
+			"ELSE %s END",
+		discountActiveExpr("@now"),
+		m_product.BasePriceNumerator,
+		m_product.DiscountPercent,
+		m_product.BasePriceDenominator,
+		productsBasePriceExpr(),
+	)
+}
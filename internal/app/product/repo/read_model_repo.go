@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"google.golang.org/api/iterator"
@@ -10,28 +11,72 @@ import (
 	"github.com/product-catalog-service/internal/app/product/contracts"
 	"github.com/product-catalog-service/internal/app/product/domain"
 	"github.com/product-catalog-service/internal/models/m_product"
+	"github.com/product-catalog-service/internal/models/m_product_view"
 	"github.com/product-catalog-service/internal/pkg/clock"
 )
 
+// ReadModelSource selects the table the read side queries.
+type ReadModelSource string
+
+const (
+	// ReadModelSourceProducts reads the write table directly.
+	ReadModelSourceProducts ReadModelSource = "products"
+	// ReadModelSourceProductViews reads the denormalized table maintained by the projector.
+	ReadModelSourceProductViews ReadModelSource = "product_views"
+)
+
+// IsValid checks if the source is a known ReadModelSource.
+func (s ReadModelSource) IsValid() bool {
+	switch s {
+	case ReadModelSourceProducts, ReadModelSourceProductViews:
+		return true
+	}
+	return false
+}
+
 // ReadModelRepo implements ProductReadModelRepository for Spanner.
 type ReadModelRepo struct {
 	client *spanner.Client
 	clock  clock.Clock
+	source ReadModelSource
+
+	// campaigns supplies the running campaigns effective prices include.
+	campaigns *CampaignRepo
 }
 
-// NewReadModelRepo creates a new ReadModelRepo.
-func NewReadModelRepo(client *spanner.Client, clock clock.Clock) *ReadModelRepo {
+// NewReadModelRepo creates a new ReadModelRepo reading from the given source.
+// An empty source defaults to the products table.
+func NewReadModelRepo(client *spanner.Client, clock clock.Clock, source ReadModelSource) *ReadModelRepo {
+	if source == "" {
+		source = ReadModelSourceProducts
+	}
 	return &ReadModelRepo{
-		client: client,
-		clock:  clock,
+		client:    client,
+		clock:     clock,
+		source:    source,
+		campaigns: NewCampaignRepo(client, clock),
+	}
+}
+
+// Source returns the table the repository reads from.
+func (r *ReadModelRepo) Source() ReadModelSource {
+	return r.source
+}
+
+// table returns the name of the table backing the configured source.
+// Both tables share the column names returned by m_product.AllColumns.
+func (r *ReadModelRepo) table() string {
+	if r.source == ReadModelSourceProductViews {
+		return m_product_view.TableName
 	}
+	return m_product.TableName
 }
 
 // GetByID retrieves a product read model by ID.
 func (r *ReadModelRepo) GetByID(ctx context.Context, id string) (*contracts.ProductReadModel, error) {
 	row, err := r.client.Single().ReadRow(
 		ctx,
-		m_product.TableName,
+		r.table(),
 		spanner.Key{id},
 		m_product.AllColumns(),
 	)
@@ -42,85 +87,128 @@ func (r *ReadModelRepo) GetByID(ctx context.Context, id string) (*contracts.Prod
 		return nil, err
 	}
 
-	return r.rowToReadModel(row)
+	now := r.clock.Now()
+	product, err := r.rowToReadModelAt(row, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.applyRunningCampaigns(ctx, []*contracts.ProductReadModel{product}, now); err != nil {
+		return nil, err
+	}
+
+	return product, nil
 }
 
-// List retrieves a paginated list of products with optional filters.
+// GetByIDs retrieves several product read models using a single KeySet read.
+// Products that do not exist are absent from the returned map.
+func (r *ReadModelRepo) GetByIDs(ctx context.Context, ids []string) (map[string]*contracts.ProductReadModel, error) {
+	keys := make([]spanner.Key, len(ids))
+	for i, id := range ids {
+		keys[i] = spanner.Key{id}
+	}
+
+	iter := r.client.Single().Read(ctx, r.table(), spanner.KeySetFromKeys(keys...), m_product.AllColumns())
+	defer iter.Stop()
+
+	now := r.clock.Now()
+	products := make(map[string]*contracts.ProductReadModel, len(ids))
+	found := make([]*contracts.ProductReadModel, 0, len(ids))
+	err := iter.Do(func(row *spanner.Row) error {
+		product, err := r.rowToReadModelAt(row, now)
+		if err != nil {
+			return err
+		}
+		products[product.ID] = product
+		found = append(found, product)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.applyRunningCampaigns(ctx, found, now); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// List retrieves a page of products with optional filters.
+// Rows are ordered by the requested sort field with product_id as a tiebreaker,
+// so the order is total and a cursor taken from the last row resumes exactly after it.
 func (r *ReadModelRepo) List(
 	ctx context.Context,
 	filters contracts.ProductListFilters,
 	pagination contracts.Pagination,
 ) (*contracts.ProductListResult, error) {
-	// Build query
-	query := fmt.Sprintf("SELECT %s FROM %s WHERE 1=1",
-		buildSelectColumns(),
-		m_product.TableName,
-	)
-
-	params := make(map[string]interface{})
+	now := r.clock.Now()
+	where, params := r.buildListFilters(filters, now)
 
-	// Apply filters
-	if filters.ActiveOnly {
-		query += fmt.Sprintf(" AND %s = @status", m_product.Status)
-		params["status"] = string(domain.ProductStatusActive)
-	} else if filters.Status != nil {
-		query += fmt.Sprintf(" AND %s = @status", m_product.Status)
-		params["status"] = *filters.Status
+	// Count total
+	var totalCount int64
+	if !pagination.SkipTotalCount {
+		count, err := r.count(ctx, where, params)
+		if err != nil {
+			return nil, err
+		}
+		totalCount = count
 	}
 
-	if filters.Category != nil && *filters.Category != "" {
-		query += fmt.Sprintf(" AND %s = @category", m_product.Category)
-		params["category"] = *filters.Category
+	// Build page query
+	sort := r.sortColumn(filters.OrderBy.Field)
+	direction, cmp := "DESC", "<"
+	if filters.OrderBy.Ascending {
+		direction, cmp = "ASC", ">"
 	}
 
-	// Exclude archived by default
-	query += fmt.Sprintf(" AND %s != @archivedStatus", m_product.Status)
-	params["archivedStatus"] = string(domain.ProductStatusArchived)
-
-	// Count total
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE 1=1", m_product.TableName)
-	if filters.ActiveOnly {
-		countQuery += fmt.Sprintf(" AND %s = @status", m_product.Status)
-	} else if filters.Status != nil {
-		countQuery += fmt.Sprintf(" AND %s = @status", m_product.Status)
+	pageParams := make(map[string]interface{}, len(params)+5)
+	for k, v := range params {
+		pageParams[k] = v
 	}
-	if filters.Category != nil && *filters.Category != "" {
-		countQuery += fmt.Sprintf(" AND %s = @category", m_product.Category)
+	if sort.usesNow {
+		pageParams["now"] = now
 	}
-	countQuery += fmt.Sprintf(" AND %s != @archivedStatus", m_product.Status)
 
-	var totalCount int64
-	countRow := r.client.Single().Query(ctx, spanner.Statement{
-		SQL:    countQuery,
-		Params: params,
-	})
-	defer countRow.Stop()
+	query := fmt.Sprintf("SELECT %s, %s AS sort_key FROM %s WHERE %s",
+		buildSelectColumns(),
+		sort.expr,
+		r.table(),
+		where,
+	)
 
-	countRowData, err := countRow.Next()
-	if err != nil && err != iterator.Done {
-		return nil, err
-	}
-	if countRowData != nil {
-		if err := countRowData.Columns(&totalCount); err != nil {
+	if pagination.After != nil {
+		afterKey, err := sort.decodeKey(pagination.After.SortKey)
+		if err != nil {
 			return nil, err
 		}
+		query += fmt.Sprintf(" AND (%s %s @afterSortKey OR (%s = @afterSortKey AND %s %s @afterProductID))",
+			sort.expr, cmp,
+			sort.expr,
+			m_product.ProductID, cmp,
+		)
+		pageParams["afterSortKey"] = afterKey
+		pageParams["afterProductID"] = pagination.After.ProductID
 	}
 
-	// Add ordering and pagination
-	query += fmt.Sprintf(" ORDER BY %s DESC", m_product.CreatedAt)
-	query += " LIMIT @limit OFFSET @offset"
-	params["limit"] = int64(pagination.Limit)
-	params["offset"] = int64(pagination.Offset)
+	// Fetch one extra row to learn whether another page exists
+	query += fmt.Sprintf(" ORDER BY sort_key %s, %s %s", direction, m_product.ProductID, direction)
+	query += " LIMIT @limit"
+	pageParams["limit"] = int64(pagination.Limit + 1)
 
-	stmt := spanner.Statement{
-		SQL:    query,
-		Params: params,
+	if pagination.After == nil && pagination.Offset > 0 {
+		query += " OFFSET @offset"
+		pageParams["offset"] = int64(pagination.Offset)
 	}
 
-	iter := r.client.Single().Query(ctx, stmt)
+	iter := r.client.Single().Query(ctx, spanner.Statement{
+		SQL:    query,
+		Params: pageParams,
+	})
 	defer iter.Stop()
 
-	products := make([]*contracts.ProductReadModel, 0)
+	products := make([]*contracts.ProductReadModel, 0, pagination.Limit+1)
+	sortKeys := make([]string, 0, pagination.Limit+1)
 
 	for {
 		row, err := iter.Next()
@@ -131,27 +219,69 @@ func (r *ReadModelRepo) List(
 			return nil, err
 		}
 
-		product, err := r.rowToReadModel(row)
+		dest := sort.newDest()
+		product, err := r.rowToReadModelAt(row, now, dest)
 		if err != nil {
 			return nil, err
 		}
 		products = append(products, product)
+		sortKeys = append(sortKeys, sort.encodeKey(dest))
 	}
 
-	hasMore := int64(pagination.Offset+len(products)) < totalCount
-
-	return &contracts.ProductListResult{
-		Products:   products,
+	result := &contracts.ProductListResult{
 		TotalCount: totalCount,
-		HasMore:    hasMore,
-	}, nil
+	}
+
+	if len(products) > pagination.Limit {
+		products = products[:pagination.Limit]
+		result.HasMore = true
+	}
+	result.Products = products
+
+	if err := r.applyRunningCampaigns(ctx, products, now); err != nil {
+		return nil, err
+	}
+
+	if result.HasMore && len(products) > 0 {
+		last := len(products) - 1
+		result.NextCursor = &contracts.ProductCursor{
+			SortKey:   sortKeys[last],
+			ProductID: products[last].ID,
+		}
+	}
+
+	return result, nil
+}
+
+// count returns the number of rows matching the where clause.
+func (r *ReadModelRepo) count(ctx context.Context, where string, params map[string]interface{}) (int64, error) {
+	iter := r.client.Single().Query(ctx, spanner.Statement{
+		SQL:    fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", r.table(), where),
+		Params: params,
+	})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
 }
 
 // CountByCategory counts products in a category.
 func (r *ReadModelRepo) CountByCategory(ctx context.Context, category string) (int64, error) {
 	query := fmt.Sprintf(
 		"SELECT COUNT(*) FROM %s WHERE %s = @category AND %s != @archivedStatus",
-		m_product.TableName,
+		r.table(),
 		m_product.Category,
 		m_product.Status,
 	)
@@ -180,10 +310,13 @@ func (r *ReadModelRepo) CountByCategory(ctx context.Context, category string) (i
 	return count, nil
 }
 
-func (r *ReadModelRepo) rowToReadModel(row *spanner.Row) (*contracts.ProductReadModel, error) {
+// rowToReadModelAt decodes the product columns of a row, with the effective
+// price of the product's own discount evaluated at now. Columns selected
+// after them are decoded into extra.
+func (r *ReadModelRepo) rowToReadModelAt(row *spanner.Row, now time.Time, extra ...interface{}) (*contracts.ProductReadModel, error) {
 	var dbProduct m_product.Product
 
-	err := row.Columns(
+	dest := []interface{}{
 		&dbProduct.ProductID,
 		&dbProduct.Name,
 		&dbProduct.Description,
@@ -193,12 +326,20 @@ func (r *ReadModelRepo) rowToReadModel(row *spanner.Row) (*contracts.ProductRead
 		&dbProduct.DiscountPercent,
 		&dbProduct.DiscountStartDate,
 		&dbProduct.DiscountEndDate,
+		&dbProduct.DiscountPhase,
 		&dbProduct.Status,
 		&dbProduct.CreatedAt,
 		&dbProduct.UpdatedAt,
 		&dbProduct.ArchivedAt,
-	)
-	if err != nil {
+		&dbProduct.PublishAt,
+		&dbProduct.UnpublishAt,
+		&dbProduct.Version,
+		&dbProduct.ExternalKey,
+		&dbProduct.CategoryID,
+		&dbProduct.Attributes,
+	}
+
+	if err := row.Columns(append(dest, extra...)...); err != nil {
 		return nil, err
 	}
 
@@ -212,6 +353,10 @@ func (r *ReadModelRepo) rowToReadModel(row *spanner.Row) (*contracts.ProductRead
 		Status:               dbProduct.Status,
 		CreatedAt:            dbProduct.CreatedAt,
 		UpdatedAt:            dbProduct.UpdatedAt,
+		Version:              dbProduct.Version,
+		ExternalKey:          dbProduct.ExternalKey.StringVal,
+		CategoryID:           dbProduct.CategoryID.StringVal,
+		Attributes:           nullToAttributes(dbProduct.Attributes),
 	}
 
 	// Calculate effective price
@@ -224,7 +369,6 @@ func (r *ReadModelRepo) rowToReadModel(row *spanner.Row) (*contracts.ProductRead
 		readModel.DiscountPercent = &pct
 
 		// Check if discount is active
-		now := r.clock.Now()
 		if dbProduct.DiscountStartDate.Valid && dbProduct.DiscountEndDate.Valid {
 			startDate := dbProduct.DiscountStartDate.Time
 			endDate := dbProduct.DiscountEndDate.Time
@@ -243,6 +387,9 @@ func (r *ReadModelRepo) rowToReadModel(row *spanner.Row) (*contracts.ProductRead
 		readModel.ArchivedAt = &dbProduct.ArchivedAt.Time
 	}
 
+	readModel.PublishAt = nullToTime(dbProduct.PublishAt)
+	readModel.UnpublishAt = nullToTime(dbProduct.UnpublishAt)
+
 	return readModel, nil
 }
 
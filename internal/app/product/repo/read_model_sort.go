@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/models/m_product"
+	"github.com/product-catalog-service/internal/models/m_product_view"
+)
+
+// sortKeyKind is the Spanner type of a sort expression.
+type sortKeyKind int
+
+const (
+	sortKeyTime sortKeyKind = iota
+	sortKeyString
+	sortKeyNumeric
+)
+
+// sortColumn maps a sort field onto SQL for the configured source.
+type sortColumn struct {
+	expr    string
+	kind    sortKeyKind
+	usesNow bool // expr references the @now parameter
+}
+
+// sortColumn returns the sort expression for a field. Unknown fields fall back
+// to created_at. The effective price is sorted on the expression the filters
+// use, evaluated at @now with the running campaigns, so the order always
+// agrees with the prices filtered on; on product_views the base price comes
+// from the stored (indexed) column.
+func (r *ReadModelRepo) sortColumn(field contracts.ProductSortField) sortColumn {
+	switch field {
+	case contracts.SortByUpdatedAt:
+		return sortColumn{expr: m_product.UpdatedAt, kind: sortKeyTime}
+	case contracts.SortByName:
+		return sortColumn{expr: m_product.Name, kind: sortKeyString}
+	case contracts.SortByCategory:
+		return sortColumn{expr: m_product.Category, kind: sortKeyString}
+	case contracts.SortByBasePrice:
+		if r.source == ReadModelSourceProductViews {
+			return sortColumn{expr: m_product_view.BasePrice, kind: sortKeyNumeric}
+		}
+		return sortColumn{expr: productsBasePriceExpr(), kind: sortKeyNumeric}
+	case contracts.SortByEffectivePrice:
+		return sortColumn{expr: r.effectivePriceExpr(), kind: sortKeyNumeric, usesNow: true}
+	default:
+		return sortColumn{expr: m_product.CreatedAt, kind: sortKeyTime}
+	}
+}
+
+// newDest returns a pointer suitable for decoding the sort column.
+func (c sortColumn) newDest() interface{} {
+	switch c.kind {
+	case sortKeyString:
+		return new(string)
+	case sortKeyNumeric:
+		return new(spanner.NullNumeric)
+	default:
+		return new(time.Time)
+	}
+}
+
+// encodeKey serializes a decoded sort value for use in a cursor.
+func (c sortColumn) encodeKey(dest interface{}) string {
+	switch v := dest.(type) {
+	case *string:
+		return *v
+	case *spanner.NullNumeric:
+		return spanner.NumericString(&v.Numeric)
+	case *time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	}
+	return ""
+}
+
+// decodeKey parses a cursor sort key into a query parameter value.
+func (c sortColumn) decodeKey(key string) (interface{}, error) {
+	switch c.kind {
+	case sortKeyString:
+		return key, nil
+	case sortKeyNumeric:
+		n, ok := new(big.Rat).SetString(key)
+		if !ok {
+			return nil, contracts.ErrInvalidCursor
+		}
+		return n, nil
+	default:
+		t, err := time.Parse(time.RFC3339Nano, key)
+		if err != nil {
+			return nil, contracts.ErrInvalidCursor
+		}
+		return t, nil
+	}
+}
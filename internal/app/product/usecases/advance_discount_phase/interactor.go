@@ -0,0 +1,92 @@
+package advance_discount_phase
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for advancing a product's discount phase.
+type Request struct {
+	ProductID string
+}
+
+// Interactor handles the advance discount phase use case.
+type Interactor struct {
+	productRepo  *repo.ProductRepo
+	outboxRepo   *repo.OutboxRepo
+	campaignRepo *repo.CampaignRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new advance discount phase interactor.
+func NewInteractor(
+	productRepo *repo.ProductRepo,
+	outboxRepo *repo.OutboxRepo,
+	campaignRepo *repo.CampaignRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		productRepo:  productRepo,
+		outboxRepo:   outboxRepo,
+		campaignRepo: campaignRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute announces the discount boundaries of a product that have passed and
+// reports whether any was announced. The phase is read and advanced in one
+// read-write transaction, so replicas racing on the same product emit each
+// boundary event once. The events price the product with the campaigns
+// running at the boundary.
+func (it *Interactor) Execute(ctx context.Context, req Request) (bool, error) {
+	now := it.clock.Now()
+	campaigns, err := it.campaignRepo.ListRunning(ctx, now)
+	if err != nil {
+		return false, err
+	}
+
+	var advanced bool
+
+	err = it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load existing product aggregate
+		product, err := it.productRepo.GetByIDWithTxn(ctx, txn, req.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Apply domain logic
+		advanced = product.AdvanceDiscountPhase(now, campaigns...)
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get update mutation from repository
+		if mut := it.productRepo.UpdateMut(product); mut != nil {
+			plan.Add(mut)
+		}
+
+		// 5. Add outbox events
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		return plan, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return advanced, nil
+}
@@ -0,0 +1,61 @@
+package command_result
+
+import (
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+)
+
+// CampaignResult is the outcome of a campaign command: the campaign after it
+// ran and the events it emitted.
+type CampaignResult struct {
+	Campaign *CampaignDTO
+	Events   []EventDTO
+}
+
+// CampaignDTO is the state of a campaign after a command committed. Status
+// is evaluated at the time the command ran.
+type CampaignDTO struct {
+	ID              string
+	Name            string
+	DiscountPercent int64
+	StartDate       time.Time
+	EndDate         time.Time
+	EndedAt         *time.Time
+	Status          string
+	ProductIDs      []string
+	Categories      []string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewCampaign builds the result of a campaign command from its aggregate.
+func NewCampaign(c *domain.Campaign, now time.Time, outbox *repo.OutboxRepo) (*CampaignResult, error) {
+	events, err := FromEvents(c.DomainEvents(), outbox)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CampaignResult{
+		Campaign: FromCampaign(c, now),
+		Events:   events,
+	}, nil
+}
+
+// FromCampaign builds the DTO from the committed aggregate.
+func FromCampaign(c *domain.Campaign, now time.Time) *CampaignDTO {
+	return &CampaignDTO{
+		ID:              c.ID(),
+		Name:            c.Name(),
+		DiscountPercent: c.Discount().Percentage(),
+		StartDate:       c.Discount().StartDate(),
+		EndDate:         c.Discount().EndDate(),
+		EndedAt:         c.EndedAt(),
+		Status:          string(c.Status(now)),
+		ProductIDs:      c.Selection().ProductIDs,
+		Categories:      c.Selection().Categories,
+		CreatedAt:       c.CreatedAt(),
+		UpdatedAt:       c.UpdatedAt(),
+	}
+}
@@ -0,0 +1,82 @@
+package command_result
+
+import (
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+)
+
+// CategoryResult is the outcome of a category command: the category after it
+// ran and the events it emitted.
+type CategoryResult struct {
+	Category *CategoryDTO
+	Events   []EventDTO
+}
+
+// CategoryOperationResult is the outcome of a category command that moves
+// products: the category the products end up in, the events the command
+// emitted and the bulk operation started to rewrite the products. Operation
+// is nil when no product has to change.
+type CategoryOperationResult struct {
+	Category  *CategoryDTO
+	Events    []EventDTO
+	Operation *BulkOperationDTO
+}
+
+// BulkOperationDTO is a bulk operation as it was started. ID is empty for
+// validate-only runs.
+type BulkOperationDTO struct {
+	ID           string
+	Command      domain.BulkCommand
+	Status       contracts.BulkOperationStatus
+	MatchedCount int64
+	CreatedAt    time.Time
+}
+
+// CategoryDTO is the state of a category after a command committed.
+type CategoryDTO struct {
+	ID          string
+	Slug        string
+	ParentID    string
+	DisplayName string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewCategory builds the result of a category command from its aggregate.
+func NewCategory(c *domain.Category, outbox *repo.OutboxRepo) (*CategoryResult, error) {
+	events, err := FromEvents(c.DomainEvents(), outbox)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CategoryResult{
+		Category: FromCategory(c),
+		Events:   events,
+	}, nil
+}
+
+// FromCategory builds the DTO from the committed aggregate.
+func FromCategory(c *domain.Category) *CategoryDTO {
+	return &CategoryDTO{
+		ID:          c.ID(),
+		Slug:        c.Slug(),
+		ParentID:    c.ParentID(),
+		DisplayName: c.DisplayName(),
+		CreatedAt:   c.CreatedAt(),
+		UpdatedAt:   c.UpdatedAt(),
+	}
+}
+
+// FromBulkOperation builds the DTO from a started bulk operation.
+func FromBulkOperation(op *contracts.BulkOperation) *BulkOperationDTO {
+	return &BulkOperationDTO{
+		ID:           op.ID,
+		Command:      op.Action.Command(),
+		Status:       op.Status,
+		MatchedCount: op.MatchedCount,
+		CreatedAt:    op.CreatedAt,
+	}
+}
@@ -0,0 +1,104 @@
+package command_result
+
+import (
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/domain/services"
+	"github.com/product-catalog-service/internal/app/product/repo"
+)
+
+// CouponResult is the outcome of a coupon command: the coupon after it ran,
+// the products it priced, if any, and the events it emitted.
+type CouponResult struct {
+	Coupon *CouponDTO
+	Lines  []CouponLineDTO
+	Events []EventDTO
+}
+
+// CouponDTO is the state of a coupon after a command committed. Percentage
+// is zero for a fixed amount off, and the amount is zero otherwise.
+type CouponDTO struct {
+	ID                   string
+	Code                 string
+	Percentage           int64
+	AmountOffNumerator   int64
+	AmountOffDenominator int64
+	ProductIDs           []string
+	Categories           []string
+	StartDate            time.Time
+	EndDate              time.Time
+	MaxRedemptions       int64
+	RedemptionCount      int64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// CouponLineDTO is the price of one requested product with a coupon. Prices
+// are zero when the product does not exist.
+type CouponLineDTO struct {
+	ProductID            string
+	EffectivePriceNum    int64
+	EffectivePriceDenom  int64
+	DiscountedPriceNum   int64
+	DiscountedPriceDenom int64
+	// Err is why the coupon does not apply to the product, or nil.
+	Err error
+}
+
+// NewCoupon builds the result of a coupon command from its aggregate and
+// the priced products.
+func NewCoupon(c *domain.Coupon, lines []services.CouponLine, outbox *repo.OutboxRepo) (*CouponResult, error) {
+	events, err := FromEvents(c.DomainEvents(), outbox)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CouponResult{
+		Coupon: FromCoupon(c),
+		Lines:  FromCouponLines(lines),
+		Events: events,
+	}, nil
+}
+
+// FromCoupon builds the DTO from the committed aggregate.
+func FromCoupon(c *domain.Coupon) *CouponDTO {
+	dto := &CouponDTO{
+		ID:              c.ID(),
+		Code:            c.Code(),
+		Percentage:      c.Rule().Percentage,
+		ProductIDs:      c.Eligibility().ProductIDs,
+		Categories:      c.Eligibility().Categories,
+		StartDate:       c.StartDate(),
+		EndDate:         c.EndDate(),
+		MaxRedemptions:  c.MaxRedemptions(),
+		RedemptionCount: c.RedemptionCount(),
+		CreatedAt:       c.CreatedAt(),
+		UpdatedAt:       c.UpdatedAt(),
+	}
+
+	if amountOff := c.Rule().AmountOff; amountOff != nil {
+		dto.AmountOffNumerator = amountOff.Numerator()
+		dto.AmountOffDenominator = amountOff.Denominator()
+	}
+
+	return dto
+}
+
+// FromCouponLines builds the DTOs of priced products.
+func FromCouponLines(lines []services.CouponLine) []CouponLineDTO {
+	dtos := make([]CouponLineDTO, len(lines))
+	for i, line := range lines {
+		dtos[i] = CouponLineDTO{
+			ProductID: line.ProductID,
+			Err:       line.Err,
+		}
+		if line.EffectivePrice != nil {
+			dtos[i].EffectivePriceNum = line.EffectivePrice.Numerator()
+			dtos[i].EffectivePriceDenom = line.EffectivePrice.Denominator()
+			dtos[i].DiscountedPriceNum = line.DiscountedPrice.Numerator()
+			dtos[i].DiscountedPriceDenom = line.DiscountedPrice.Denominator()
+		}
+	}
+	return dtos
+}
@@ -0,0 +1,128 @@
+// Package command_result builds the aggregate state and events returned by commands.
+package command_result
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+)
+
+// Result is the outcome of a command: the product after it ran and the
+// events it emitted. For validate-only runs nothing was committed and the
+// result describes what the command would have done.
+type Result struct {
+	// Product is nil when the command deleted the product.
+	Product *ProductDTO
+	Events  []EventDTO
+}
+
+// EventDTO is a domain event in the form it is written to the outbox.
+type EventDTO struct {
+	EventType   string
+	AggregateID string
+	OccurredAt  time.Time
+	Payload     []byte
+}
+
+// New builds the result of a command from its aggregate. The effective price
+// includes the campaigns running at now.
+func New(ctx context.Context, p *domain.Product, now time.Time, outbox *repo.OutboxRepo, campaigns *repo.CampaignRepo) (*Result, error) {
+	events, err := FromEvents(p.DomainEvents(), outbox)
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := campaigns.ListRunning(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Product: FromProduct(p, now, running...),
+		Events:  events,
+	}, nil
+}
+
+// FromEvents serializes domain events the way the outbox stores them.
+func FromEvents(events []domain.DomainEvent, outbox *repo.OutboxRepo) ([]EventDTO, error) {
+	dtos := make([]EventDTO, 0, len(events))
+	for _, event := range events {
+		payload, err := outbox.SerializeEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, EventDTO{
+			EventType:   event.EventType(),
+			AggregateID: event.AggregateID(),
+			OccurredAt:  event.OccurredAt(),
+			Payload:     payload,
+		})
+	}
+	return dtos, nil
+}
+
+// ProductDTO is the state of a product after a command committed.
+type ProductDTO struct {
+	ID                   string
+	Name                 string
+	Description          string
+	Category             string
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	EffectivePriceNum    int64
+	EffectivePriceDenom  int64
+	DiscountPercent      *int64
+	DiscountStartDate    *time.Time
+	DiscountEndDate      *time.Time
+	Status               string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	ArchivedAt           *time.Time
+	PublishAt            *time.Time
+	UnpublishAt          *time.Time
+	Version              int64
+	ExternalKey          string
+	CategoryID           string
+	Attributes           map[string]string
+}
+
+// FromProduct builds the DTO from the committed aggregate instead of
+// re-reading it, so the result never lags behind the read model.
+// The effective price is evaluated at now with the given campaigns.
+func FromProduct(p *domain.Product, now time.Time, campaigns ...*domain.Campaign) *ProductDTO {
+	effectivePrice := p.EffectivePrice(now, campaigns...)
+
+	dto := &ProductDTO{
+		ID:                   p.ID(),
+		Name:                 p.Name(),
+		Description:          p.Description(),
+		Category:             p.Category(),
+		BasePriceNumerator:   p.BasePrice().Numerator(),
+		BasePriceDenominator: p.BasePrice().Denominator(),
+		EffectivePriceNum:    effectivePrice.Numerator(),
+		EffectivePriceDenom:  effectivePrice.Denominator(),
+		Status:               string(p.Status()),
+		CreatedAt:            p.CreatedAt(),
+		UpdatedAt:            p.UpdatedAt(),
+		ArchivedAt:           p.ArchivedAt(),
+		PublishAt:            p.PublishAt(),
+		UnpublishAt:          p.UnpublishAt(),
+		Version:              p.Version(),
+		ExternalKey:          p.ExternalKey(),
+		CategoryID:           p.CategoryID(),
+		Attributes:           p.Attributes(),
+	}
+
+	if d := p.Discount(); d != nil {
+		percentage := d.Percentage()
+		startDate := d.StartDate()
+		endDate := d.EndDate()
+		dto.DiscountPercent = &percentage
+		dto.DiscountStartDate = &startDate
+		dto.DiscountEndDate = &endDate
+	}
+
+	return dto
+}
@@ -0,0 +1,100 @@
+package create_campaign
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for creating a campaign.
+type Request struct {
+	Name       string
+	Percentage int64
+	StartDate  time.Time
+	EndDate    time.Time
+	ProductIDs []string
+	Categories []string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the create campaign use case.
+type Interactor struct {
+	campaignRepo *repo.CampaignRepo
+	outboxRepo   *repo.OutboxRepo
+	committer    committer.Committer
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new create campaign interactor.
+func NewInteractor(
+	campaignRepo *repo.CampaignRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		campaignRepo: campaignRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute creates a new campaign and returns the result.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CampaignResult, error) {
+	// 1. Create the discount rule value object
+	discount, err := domain.NewDiscount(req.Percentage, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Create new campaign aggregate
+	now := it.clock.Now()
+	campaign, err := domain.NewCampaign(
+		uuid.New().String(),
+		req.Name,
+		discount,
+		domain.CampaignSelection{
+			ProductIDs: req.ProductIDs,
+			Categories: req.Categories,
+		},
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Build commit plan
+	plan := committer.NewPlan()
+
+	// 4. Get insert mutation from repository
+	if mut := it.campaignRepo.InsertMut(campaign); mut != nil {
+		plan.Add(mut)
+	}
+
+	// 5. Add outbox events
+	for _, event := range campaign.DomainEvents() {
+		outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+		if err != nil {
+			return nil, err
+		}
+		plan.Add(outboxMut)
+	}
+
+	// 6. Apply plan atomically, unless only validating
+	if !req.ValidateOnly {
+		if err := it.committer.Apply(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	return command_result.NewCampaign(campaign, now, it.outboxRepo)
+}
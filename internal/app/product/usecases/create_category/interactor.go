@@ -0,0 +1,110 @@
+package create_category
+
+import (
+	"context"
+	"errors"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for creating a category. An empty ParentID
+// creates a root.
+type Request struct {
+	Slug        string
+	DisplayName string
+	ParentID    string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the create category use case.
+type Interactor struct {
+	categoryRepo *repo.CategoryRepo
+	outboxRepo   *repo.OutboxRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new create category interactor.
+func NewInteractor(
+	categoryRepo *repo.CategoryRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		categoryRepo: categoryRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute creates a new category and returns the result. Slugs are unique;
+// the check runs inside the transaction along with the parent's, and the
+// unique index on the slug backs it up against a concurrent create.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CategoryResult, error) {
+	// 1. Create new category aggregate
+	category, err := domain.NewCategory(
+		uuid.New().String(),
+		req.Slug,
+		req.DisplayName,
+		req.ParentID,
+		it.clock.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 2. Check the slug is free and the parent exists
+		if _, err := it.categoryRepo.GetBySlugWithTxn(ctx, txn, category.Slug()); err == nil {
+			return nil, domain.ErrCategorySlugTaken
+		} else if !errors.Is(err, domain.ErrCategoryNotFound) {
+			return nil, err
+		}
+		if category.ParentID() != "" {
+			if _, err := it.categoryRepo.GetByIDWithTxn(ctx, txn, category.ParentID()); err != nil {
+				if errors.Is(err, domain.ErrCategoryNotFound) {
+					return nil, domain.ErrCategoryParentNotFound
+				}
+				return nil, err
+			}
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get insert mutation from repository
+		if mut := it.categoryRepo.InsertMut(category); mut != nil {
+			plan.Add(mut)
+		}
+
+		// 5. Add outbox events
+		for _, event := range category.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically, unless only validating
+		if req.ValidateOnly {
+			return committer.NewPlan(), nil
+		}
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return command_result.NewCategory(category, it.outboxRepo)
+}
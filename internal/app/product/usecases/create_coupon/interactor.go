@@ -0,0 +1,126 @@
+package create_coupon
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for creating a coupon. Exactly one of
+// Percentage and the amount off is set; a zero AmountOffDenominator means
+// there is no amount off.
+type Request struct {
+	Code                 string
+	Percentage           int64
+	AmountOffNumerator   int64
+	AmountOffDenominator int64
+	ProductIDs           []string
+	Categories           []string
+	StartDate            time.Time
+	EndDate              time.Time
+	MaxRedemptions       int64
+
+	ValidateOnly bool
+}
+
+// Interactor handles the create coupon use case.
+type Interactor struct {
+	couponRepo *repo.CouponRepo
+	outboxRepo *repo.OutboxRepo
+	committer  committer.TransactionalCommitter
+	clock      clock.Clock
+}
+
+// NewInteractor creates a new create coupon interactor.
+func NewInteractor(
+	couponRepo *repo.CouponRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		couponRepo: couponRepo,
+		outboxRepo: outboxRepo,
+		committer:  committer,
+		clock:      clock,
+	}
+}
+
+// Execute creates a new coupon and returns the result. Codes are unique; the
+// check runs inside the transaction, and the unique index on the code backs
+// it up against a concurrent create.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CouponResult, error) {
+	// 1. Create the discount rule value object
+	rule := domain.CouponRule{Percentage: req.Percentage}
+	if req.AmountOffDenominator != 0 {
+		amountOff, err := domain.NewMoney(req.AmountOffNumerator, req.AmountOffDenominator)
+		if err != nil {
+			return nil, err
+		}
+		rule.AmountOff = amountOff
+	}
+
+	// 2. Create new coupon aggregate
+	now := it.clock.Now()
+	coupon, err := domain.NewCoupon(
+		uuid.New().String(),
+		req.Code,
+		rule,
+		domain.CouponEligibility{
+			ProductIDs: req.ProductIDs,
+			Categories: req.Categories,
+		},
+		req.StartDate,
+		req.EndDate,
+		req.MaxRedemptions,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		if _, err := it.couponRepo.GetByCodeWithTxn(ctx, txn, coupon.Code()); err == nil {
+			return nil, domain.ErrCouponCodeTaken
+		} else if !errors.Is(err, domain.ErrCouponNotFound) {
+			return nil, err
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get insert mutation from repository
+		if mut := it.couponRepo.InsertMut(coupon); mut != nil {
+			plan.Add(mut)
+		}
+
+		// 5. Add outbox events
+		for _, event := range coupon.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically, unless only validating
+		if req.ValidateOnly {
+			return committer.NewPlan(), nil
+		}
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return command_result.NewCoupon(coupon, nil, it.outboxRepo)
+}
@@ -2,90 +2,149 @@ package create_product
 
 import (
 	"context"
+	"time"
 
+	"cloud.google.com/go/spanner"
 	"github.com/google/uuid"
 
 	"github.com/product-catalog-service/internal/app/product/domain"
 	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
 	"github.com/product-catalog-service/internal/pkg/clock"
 	"github.com/product-catalog-service/internal/pkg/committer"
 )
 
-// Request represents the input for creating a product.
+// Request represents the input for creating a product. With a CategoryID
+// the product is assigned to that category of the tree and carries its
+// display name; Category is then ignored.
 type Request struct {
 	Name                 string
 	Description          string
 	Category             string
+	CategoryID           string
 	BasePriceNumerator   int64
 	BasePriceDenominator int64
+
+	ValidateOnly bool
 }
 
 // Interactor handles the create product use case.
 type Interactor struct {
-	productRepo *repo.ProductRepo
-	outboxRepo  *repo.OutboxRepo
-	committer   committer.Committer
-	clock       clock.Clock
+	productRepo  *repo.ProductRepo
+	outboxRepo   *repo.OutboxRepo
+	campaignRepo *repo.CampaignRepo
+	categoryRepo *repo.CategoryRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
 }
 
 // NewInteractor creates a new create product interactor.
 func NewInteractor(
 	productRepo *repo.ProductRepo,
 	outboxRepo *repo.OutboxRepo,
-	committer committer.Committer,
+	campaignRepo *repo.CampaignRepo,
+	categoryRepo *repo.CategoryRepo,
+	committer committer.TransactionalCommitter,
 	clock clock.Clock,
 ) *Interactor {
 	return &Interactor{
-		productRepo: productRepo,
-		outboxRepo:  outboxRepo,
-		committer:   committer,
-		clock:       clock,
+		productRepo:  productRepo,
+		outboxRepo:   outboxRepo,
+		campaignRepo: campaignRepo,
+		categoryRepo: categoryRepo,
+		committer:    committer,
+		clock:        clock,
 	}
 }
 
-// Execute creates a new product.
-func (it *Interactor) Execute(ctx context.Context, req Request) (string, error) {
+// Execute creates a new product and returns the result. A category given by
+// ID is read in the transaction that inserts the product, so the category
+// cannot be deleted between the check and the insert.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.Result, error) {
 	// 1. Create base price value object
 	basePrice, err := domain.NewMoney(req.BasePriceNumerator, req.BasePriceDenominator)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// 2. Create new product aggregate
-	productID := uuid.New().String()
-	product, err := domain.NewProduct(
-		productID,
-		req.Name,
-		req.Description,
-		req.Category,
-		basePrice,
-		it.clock.Now(),
+	var (
+		product   *domain.Product
+		now       = it.clock.Now()
+		productID = uuid.New().String()
 	)
-	if err != nil {
-		return "", err
+	if req.ValidateOnly {
+		return it.validate(ctx, productID, req, basePrice, now)
 	}
 
-	// 3. Build commit plan
-	plan := committer.NewPlan()
+	err = it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 2. Create new product aggregate
+		var (
+			category *domain.Category
+			err      error
+		)
+		if req.CategoryID != "" {
+			category, err = it.categoryRepo.GetByIDWithTxn(ctx, txn, req.CategoryID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		product, err = newProduct(productID, req, category, basePrice, now)
+		if err != nil {
+			return nil, err
+		}
 
-	// 4. Get insert mutation from repository
-	if mut := it.productRepo.InsertMut(product); mut != nil {
-		plan.Add(mut)
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get insert mutation from repository
+		if mut := it.productRepo.InsertMut(product); mut != nil {
+			plan.Add(mut)
+		}
+
+		// 5. Add outbox events
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 5. Add outbox events
-	for _, event := range product.DomainEvents() {
-		outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+	return command_result.New(ctx, product, now, it.outboxRepo, it.campaignRepo)
+}
+
+// validate builds the product with its category read without a transaction;
+// nothing is written.
+func (it *Interactor) validate(ctx context.Context, productID string, req Request, basePrice *domain.Money, now time.Time) (*command_result.Result, error) {
+	var category *domain.Category
+	if req.CategoryID != "" {
+		var err error
+		category, err = it.categoryRepo.GetByID(ctx, req.CategoryID)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-		plan.Add(outboxMut)
 	}
 
-	// 6. Apply plan atomically
-	if err := it.committer.Apply(ctx, plan); err != nil {
-		return "", err
+	product, err := newProduct(productID, req, category, basePrice, now)
+	if err != nil {
+		return nil, err
 	}
 
-	return product.ID(), nil
+	return command_result.New(ctx, product, now, it.outboxRepo, it.campaignRepo)
+}
+
+// newProduct creates the product in category, or in req.Category when
+// category is nil.
+func newProduct(productID string, req Request, category *domain.Category, basePrice *domain.Money, now time.Time) (*domain.Product, error) {
+	if category != nil {
+		return domain.NewProductInCategory(productID, req.Name, req.Description, category, basePrice, now)
+	}
+	return domain.NewProduct(productID, req.Name, req.Description, req.Category, basePrice, now)
 }
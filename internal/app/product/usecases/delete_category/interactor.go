@@ -0,0 +1,110 @@
+package delete_category
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for deleting a category.
+type Request struct {
+	CategoryID string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the delete category use case.
+type Interactor struct {
+	categoryRepo *repo.CategoryRepo
+	productRepo  *repo.ProductRepo
+	bulkRepo     *repo.BulkOperationRepo
+	outboxRepo   *repo.OutboxRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new delete category interactor.
+func NewInteractor(
+	categoryRepo *repo.CategoryRepo,
+	productRepo *repo.ProductRepo,
+	bulkRepo *repo.BulkOperationRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		categoryRepo: categoryRepo,
+		productRepo:  productRepo,
+		bulkRepo:     bulkRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute deletes a leaf category no product is assigned to, and returns
+// the category as it was. The children, the products and the running
+// operations moving products into the category are checked inside the
+// transaction, so none can appear between the check and the delete.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CategoryResult, error) {
+	var category *domain.Category
+
+	err := it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load existing category aggregate
+		var err error
+		category, err = it.categoryRepo.GetByIDWithTxn(ctx, txn, req.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		hasChildren, err := it.categoryRepo.HasChildrenWithTxn(ctx, txn, category.ID())
+		if err != nil {
+			return nil, err
+		}
+		hasProducts, err := it.productRepo.AnyInCategoryWithTxn(ctx, txn, category.ID())
+		if err != nil {
+			return nil, err
+		}
+		moving, err := it.bulkRepo.MovingCategoriesWithTxn(ctx, txn)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Apply domain logic
+		if err := category.Delete(hasChildren, hasProducts, moving[category.ID()], it.clock.Now()); err != nil {
+			return nil, err
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get delete mutation from repository
+		plan.Add(it.categoryRepo.DeleteMut(category.ID()))
+
+		// 5. Add outbox events
+		for _, event := range category.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically, unless only validating
+		if req.ValidateOnly {
+			return committer.NewPlan(), nil
+		}
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return command_result.NewCategory(category, it.outboxRepo)
+}
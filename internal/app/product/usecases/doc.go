@@ -8,17 +8,43 @@
 //  5. Add outbox events for reliable event publishing
 //  6. Apply the plan atomically
 //
+// Commands return a command_result.Result with the product state and the
+// events they emitted; campaign, coupon and category commands return their
+// own result types. With Request.ValidateOnly set, step 6 is skipped: the
+// result shows what the command would do, and nothing is written. Product
+// commands then read the product without a transaction, so validating takes
+// no locks.
+//
 // Use cases are responsible for:
 //   - Orchestrating domain operations
 //   - Managing transactions via CommitPlan
 //   - Ensuring domain events are persisted in the outbox
 //
 // Available use cases:
-//   - create_product: Create new products in the catalog
-//   - update_product: Update product details (name, description, category)
+//   - create_product: Create new products in the catalog, optionally in a category of the tree
+//   - update_product: Update product details (name, description, category or category assignment)
 //   - activate_product: Transition product to active status
 //   - deactivate_product: Transition product to inactive status
 //   - archive_product: Soft delete a product
+//   - transition_product: Move a product to any status the lifecycle allows
+//   - restore_product: Restore an archived product within the retention period
+//   - purge_product: Permanently delete a product archived past the purge retention
+//   - schedule_product: Set or clear a product's publish and unpublish times
+//   - run_schedule: Fire a product's due publish/unpublish transitions (scheduler worker)
+//   - advance_discount_phase: Announce a product's passed discount start/end (scheduler worker)
 //   - apply_discount: Apply percentage-based discount to a product
 //   - remove_discount: Remove discount from a product
+//   - import_products: Create or update products in bulk from CSV or NDJSON, upserting by external key
+//   - start_bulk_update: Record a bulk command over the products matching a filter set
+//   - run_bulk_update: Apply a bulk command to its next chunk of products (bulk updater worker)
+//   - create_campaign: Create a promotion campaign discounting selected products and categories
+//   - end_campaign: End a campaign before its scheduled end
+//   - create_coupon: Create a coupon code with its discount rule, eligibility, window and redemption limit
+//   - validate_coupon: Price products with a coupon code without redeeming it
+//   - redeem_coupon: Redeem a coupon for an order, counting it against the redemption limit
+//   - create_category: Create a node of the category tree, as a root or under a parent
+//   - update_category: Change a category's slug or move it under another parent
+//   - delete_category: Delete a leaf category no product is assigned to
+//   - rename_category: Change a category's display name and start rewriting its products
+//   - merge_categories: Fold categories into a target and start moving their products to it
 package usecases
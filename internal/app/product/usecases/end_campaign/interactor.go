@@ -0,0 +1,81 @@
+package end_campaign
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for ending a campaign.
+type Request struct {
+	CampaignID string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the end campaign use case.
+type Interactor struct {
+	campaignRepo *repo.CampaignRepo
+	outboxRepo   *repo.OutboxRepo
+	committer    committer.Committer
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new end campaign interactor.
+func NewInteractor(
+	campaignRepo *repo.CampaignRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		campaignRepo: campaignRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute ends a campaign before its scheduled end and returns the result.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CampaignResult, error) {
+	// 1. Load existing campaign aggregate
+	campaign, err := it.campaignRepo.GetByID(ctx, req.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Apply domain logic
+	now := it.clock.Now()
+	if err := campaign.End(now); err != nil {
+		return nil, err
+	}
+
+	// 3. Build commit plan
+	plan := committer.NewPlan()
+
+	// 4. Get update mutation from repository
+	if mut := it.campaignRepo.EndMut(campaign); mut != nil {
+		plan.Add(mut)
+	}
+
+	// 5. Add outbox events
+	for _, event := range campaign.DomainEvents() {
+		outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+		if err != nil {
+			return nil, err
+		}
+		plan.Add(outboxMut)
+	}
+
+	// 6. Apply plan atomically, unless only validating
+	if !req.ValidateOnly {
+		if err := it.committer.Apply(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	return command_result.NewCampaign(campaign, now, it.outboxRepo)
+}
@@ -0,0 +1,227 @@
+package import_products
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+)
+
+// Format is the encoding of an import source.
+type Format string
+
+const (
+	// FormatCSV is comma-separated values with a header row naming the columns.
+	FormatCSV Format = "csv"
+	// FormatNDJSON is one JSON object per line.
+	FormatNDJSON Format = "ndjson"
+)
+
+// Column names shared by both formats. CSV headers and NDJSON object keys
+// use the same names.
+const (
+	ColumnExternalKey = "external_key"
+	ColumnName        = "name"
+	ColumnDescription = "description"
+	ColumnCategory    = "category"
+	ColumnCategoryID  = "category_id"
+	ColumnPrice       = "price"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported import format: expected csv or ndjson")
+	ErrMissingHeader     = errors.New("csv source must start with a header row")
+	ErrMissingColumn     = errors.New("csv header is missing a required column: name, price, and category or category_id")
+	ErrMalformedRow      = errors.New("malformed row")
+	ErrInvalidPrice      = errors.New("invalid price: expected a decimal amount such as 19.99")
+)
+
+// decimalPattern accepts plain decimals only, so "1/3" or "1e3" aren't
+// silently turned into prices.
+var decimalPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// Row is one decoded product row. Line is the 1-based line in the source the
+// row starts on, for reporting.
+type Row struct {
+	Line        int
+	ExternalKey string
+	Name        string
+	Description string
+	Category    string
+	// CategoryID assigns the product to a category of the tree, as in
+	// CreateProduct; Category is then ignored.
+	CategoryID string
+	Price      string
+	// Err is set when the row could not be decoded; the other fields may be empty.
+	Err error
+}
+
+// BasePrice parses the row's price.
+func (r Row) BasePrice() (*domain.Money, error) {
+	price := strings.TrimSpace(r.Price)
+	if !decimalPattern.MatchString(price) {
+		return nil, ErrInvalidPrice
+	}
+
+	amount, ok := new(big.Rat).SetString(price)
+	if !ok || !amount.Num().IsInt64() || !amount.Denom().IsInt64() {
+		return nil, ErrInvalidPrice
+	}
+
+	return domain.NewMoneyFromRat(amount)
+}
+
+// Decoder reads rows from an import source one at a time.
+type Decoder interface {
+	// Next returns the next row, or io.EOF when the source is exhausted.
+	// Rows that fail to decode are returned with Err set; any other error
+	// means the source itself is unreadable.
+	Next() (Row, error)
+}
+
+// NewDecoder returns a decoder for the given format.
+func NewDecoder(r io.Reader, format Format) (Decoder, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVDecoder(r)
+	case FormatNDJSON:
+		return &ndjsonDecoder{scanner: newLineScanner(r)}, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+type csvDecoder struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func newCSVDecoder(r io.Reader) (*csvDecoder, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, ErrMissingHeader
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMissingHeader, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{ColumnName, ColumnPrice} {
+		if _, ok := columns[required]; !ok {
+			return nil, ErrMissingColumn
+		}
+	}
+	_, hasCategory := columns[ColumnCategory]
+	_, hasCategoryID := columns[ColumnCategoryID]
+	if !hasCategory && !hasCategoryID {
+		return nil, ErrMissingColumn
+	}
+
+	return &csvDecoder{reader: reader, columns: columns}, nil
+}
+
+func (d *csvDecoder) Next() (Row, error) {
+	record, err := d.reader.Read()
+	if err == io.EOF {
+		return Row{}, io.EOF
+	}
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		// The reader resynchronises on the next line, so only this row is lost
+		return Row{Line: parseErr.StartLine, Err: fmt.Errorf("%w: %v", ErrMalformedRow, parseErr.Err)}, nil
+	}
+	if err != nil {
+		return Row{}, err
+	}
+
+	line, _ := d.reader.FieldPos(0)
+	return Row{
+		Line:        line,
+		ExternalKey: d.field(record, ColumnExternalKey),
+		Name:        d.field(record, ColumnName),
+		Description: d.field(record, ColumnDescription),
+		Category:    d.field(record, ColumnCategory),
+		CategoryID:  d.field(record, ColumnCategoryID),
+		Price:       d.field(record, ColumnPrice),
+	}, nil
+}
+
+// field returns the named column of record, or "" if the header lacks it
+// or the record is short.
+func (d *csvDecoder) field(record []string, column string) string {
+	i, ok := d.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// ndjsonRow is the JSON shape of a row. Price accepts a string or a number.
+type ndjsonRow struct {
+	ExternalKey string      `json:"external_key"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Category    string      `json:"category"`
+	CategoryID  string      `json:"category_id"`
+	Price       json.Number `json:"price"`
+}
+
+type ndjsonDecoder struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (d *ndjsonDecoder) Next() (Row, error) {
+	for d.scanner.Scan() {
+		d.line++
+		data := bytes.TrimSpace(d.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var raw ndjsonRow
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return Row{Line: d.line, Err: fmt.Errorf("%w: %v", ErrMalformedRow, err)}, nil
+		}
+
+		return Row{
+			Line:        d.line,
+			ExternalKey: strings.TrimSpace(raw.ExternalKey),
+			Name:        strings.TrimSpace(raw.Name),
+			Description: strings.TrimSpace(raw.Description),
+			Category:    strings.TrimSpace(raw.Category),
+			CategoryID:  strings.TrimSpace(raw.CategoryID),
+			Price:       raw.Price.String(),
+		}, nil
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		return Row{}, err
+	}
+	return Row{}, io.EOF
+}
+
+// maxLineLength bounds a single NDJSON line; descriptions can be long.
+const maxLineLength = 1 << 20
+
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+	return scanner
+}
@@ -0,0 +1,407 @@
+package import_products
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// mutationsPerRow is a conservative estimate of the Spanner mutations one
+// row costs: a full products row with its index entries, plus up to two
+// outbox events (an update and a price change) with theirs.
+const mutationsPerRow = 64
+
+// BatchSize is how many rows are committed together. Each batch is one
+// transaction, so a failing batch doesn't undo the ones before it.
+const BatchSize = committer.MaxMutationsPerCommit / mutationsPerRow
+
+// MaxReportedRows caps the rows a report lists, so the reply to a large
+// import stays well under the gRPC message size limit. Past it only failed
+// rows are listed, up to MaxReportedFailures. The counts cover every row.
+const (
+	MaxReportedRows     = 1000
+	MaxReportedFailures = 1000
+)
+
+// Action is what an import did with a row.
+type Action string
+
+const (
+	ActionCreated   Action = "created"
+	ActionUpdated   Action = "updated"
+	ActionUnchanged Action = "unchanged"
+)
+
+// Request represents the input for importing products.
+type Request struct {
+	Source io.Reader
+	Format Format
+
+	ValidateOnly bool
+}
+
+// RowResult is the outcome of one row. Err is set for rejected rows, in
+// which case Action is empty.
+type RowResult struct {
+	Line        int
+	ExternalKey string
+	ProductID   string
+	Action      Action
+	Err         error
+}
+
+// Report is the outcome of an import. Rows lists the results in source
+// order: all of them for a small import, otherwise the first MaxReportedRows
+// and the failures after them, until MaxReportedFailures rows have failed.
+// Truncated is set when a row was left out.
+type Report struct {
+	Rows      []RowResult
+	Created   int
+	Updated   int
+	Unchanged int
+	Failed    int
+	Truncated bool
+}
+
+// Interactor handles the import products use case.
+type Interactor struct {
+	productRepo  *repo.ProductRepo
+	outboxRepo   *repo.OutboxRepo
+	categoryRepo *repo.CategoryRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new import products interactor.
+func NewInteractor(
+	productRepo *repo.ProductRepo,
+	outboxRepo *repo.OutboxRepo,
+	categoryRepo *repo.CategoryRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		productRepo:  productRepo,
+		outboxRepo:   outboxRepo,
+		categoryRepo: categoryRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute imports every row of the source and reports the outcome per row.
+// Rows with an external key update the product already carrying that key,
+// or create it; rows without one always create a product. A rejected row
+// doesn't stop the import. Only an unreadable source or a cancelled context
+// return an error, with the rows committed so far in the report.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Report, error) {
+	decoder, err := NewDecoder(req.Source, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	batch := make([]Row, 0, BatchSize)
+	for {
+		row, err := decoder.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return report, err
+		}
+
+		batch = append(batch, row)
+		if len(batch) == BatchSize {
+			if err := it.importBatch(ctx, batch, req.ValidateOnly, report); err != nil {
+				return report, err
+			}
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := it.importBatch(ctx, batch, req.ValidateOnly, report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// importBatch commits one batch of rows in a single transaction and appends
+// their results to report. If the commit fails, every row that would have
+// been written is reported with the commit error. A batch that is only
+// validated is read without locks and never opens a read-write transaction.
+func (it *Interactor) importBatch(ctx context.Context, rows []Row, validateOnly bool, report *Report) error {
+	var (
+		results []RowResult
+		err     error
+	)
+	if validateOnly {
+		results, err = it.validateBatch(ctx, rows)
+	} else {
+		results, err = it.commitBatch(ctx, rows)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if results == nil {
+			results = make([]RowResult, len(rows))
+			for i, row := range rows {
+				results[i] = RowResult{Line: row.Line, ExternalKey: row.ExternalKey, Err: row.Err}
+			}
+		}
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Action = ""
+				results[i].Err = err
+			}
+		}
+	}
+
+	for _, result := range results {
+		report.add(result)
+	}
+	return nil
+}
+
+// commitBatch applies the batch's rows and commits the products they created
+// or changed in one read-write transaction.
+func (it *Interactor) commitBatch(ctx context.Context, rows []Row) ([]RowResult, error) {
+	var results []RowResult
+	err := it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load the products the batch's external keys already belong to
+		// and the categories its rows are assigned to; the transaction may be
+		// retried, so start from scratch every time
+		byKey := make(map[string]*domain.Product)
+		if keys := externalKeys(rows); len(keys) > 0 {
+			existing, err := it.productRepo.GetByExternalKeysWithTxn(ctx, txn, keys)
+			if err != nil {
+				return nil, err
+			}
+			byKey = existing
+		}
+		categories := make(map[string]*domain.Category)
+		if ids := categoryIDs(rows); len(ids) > 0 {
+			existing, err := it.categoryRepo.GetByIDsWithTxn(ctx, txn, ids)
+			if err != nil {
+				return nil, err
+			}
+			categories = existing
+		}
+
+		var products []*domain.Product
+		results, products = it.applyBatch(rows, byKey, categories)
+		return it.buildPlan(products)
+	})
+	return results, err
+}
+
+// validateBatch applies the batch's rows to products and categories read
+// without locks and discards the outcome.
+func (it *Interactor) validateBatch(ctx context.Context, rows []Row) ([]RowResult, error) {
+	// 1. Load the products the batch's external keys already belong to
+	// and the categories its rows are assigned to
+	byKey := make(map[string]*domain.Product)
+	if keys := externalKeys(rows); len(keys) > 0 {
+		existing, err := it.productRepo.GetByExternalKeys(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		byKey = existing
+	}
+	categories := make(map[string]*domain.Category)
+	if ids := categoryIDs(rows); len(ids) > 0 {
+		existing, err := it.categoryRepo.GetByIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		categories = existing
+	}
+
+	results, _ := it.applyBatch(rows, byKey, categories)
+	return results, nil
+}
+
+// externalKeys returns the external keys of the batch's valid rows.
+func externalKeys(rows []Row) []string {
+	keys := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Err == nil && row.ExternalKey != "" {
+			keys = append(keys, row.ExternalKey)
+		}
+	}
+	return keys
+}
+
+// categoryIDs returns the distinct category IDs of the batch's valid rows.
+func categoryIDs(rows []Row) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, row := range rows {
+		if row.Err == nil && row.CategoryID != "" && !seen[row.CategoryID] {
+			seen[row.CategoryID] = true
+			ids = append(ids, row.CategoryID)
+		}
+	}
+	return ids
+}
+
+// applyBatch runs the domain logic for every row of the batch against the
+// products already carrying its keys and the categories of the tree it
+// names, and returns the results along with each product the batch created
+// or changed.
+func (it *Interactor) applyBatch(rows []Row, byKey map[string]*domain.Product, categories map[string]*domain.Category) ([]RowResult, []*domain.Product) {
+	// 2. Apply domain logic row by row
+	now := it.clock.Now()
+	results := make([]RowResult, len(rows))
+	seen := make(map[*domain.Product]bool)
+	var products []*domain.Product
+	for i, row := range rows {
+		var product *domain.Product
+		results[i], product = it.applyRow(row, byKey, categories, now)
+		if product != nil && !seen[product] {
+			seen[product] = true
+			products = append(products, product)
+		}
+	}
+
+	return results, products
+}
+
+// applyRow creates or updates the product for one row. Products created by
+// earlier rows of the batch are added to byKey, so a key repeated within a
+// batch updates the product its first row created. A row with a category ID
+// is assigned to that category of the tree, which must be in categories.
+func (it *Interactor) applyRow(row Row, byKey map[string]*domain.Product, categories map[string]*domain.Category, now time.Time) (RowResult, *domain.Product) {
+	result := RowResult{Line: row.Line, ExternalKey: row.ExternalKey}
+	if row.Err != nil {
+		result.Err = row.Err
+		return result, nil
+	}
+
+	price, err := row.BasePrice()
+	if err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	var category *domain.Category
+	if row.CategoryID != "" {
+		category = categories[row.CategoryID]
+		if category == nil {
+			result.Err = domain.ErrCategoryNotFound
+			return result, nil
+		}
+	}
+
+	product, ok := byKey[row.ExternalKey]
+	if !ok || row.ExternalKey == "" {
+		id := uuid.New().String()
+		if category != nil {
+			product, err = domain.NewImportedProductInCategory(id, row.ExternalKey, row.Name, row.Description, category, price, now)
+		} else {
+			product, err = domain.NewImportedProduct(id, row.ExternalKey, row.Name, row.Description, row.Category, price, now)
+		}
+		if err != nil {
+			result.Err = err
+			return result, nil
+		}
+		if row.ExternalKey != "" {
+			byKey[row.ExternalKey] = product
+		}
+		result.ProductID = product.ID()
+		result.Action = ActionCreated
+		return result, product
+	}
+
+	// Check the price up front so a rejected row leaves the product untouched
+	result.ProductID = product.ID()
+	if price.IsZero() {
+		result.Err = domain.ErrZeroPrice
+		return result, nil
+	}
+
+	events := len(product.DomainEvents())
+	update := domain.ProductUpdate{
+		Name:             &row.Name,
+		Description:      &row.Description,
+		Category:         &row.Category,
+		AssignedCategory: category,
+	}
+	if category != nil {
+		update.Category = nil
+	}
+	if err := product.Update(update, now); err != nil {
+		result.Err = err
+		return result, nil
+	}
+	if err := product.ChangeBasePrice(price, now); err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	result.Action = ActionUnchanged
+	if len(product.DomainEvents()) > events {
+		result.Action = ActionUpdated
+	}
+	return result, product
+}
+
+// buildPlan collects the mutations and outbox events of the batch's products.
+func (it *Interactor) buildPlan(products []*domain.Product) (*committer.CommitPlan, error) {
+	// 3. Build commit plan
+	plan := committer.NewPlan()
+
+	for _, product := range products {
+		// 4. Get insert or update mutation from repository
+		if mut := it.productRepo.InsertMut(product); mut != nil {
+			plan.Add(mut)
+		}
+		if mut := it.productRepo.UpdateMut(product); mut != nil {
+			plan.Add(mut)
+		}
+
+		// 5. Add outbox events
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+	}
+
+	return plan, nil
+}
+
+func (r *Report) add(result RowResult) {
+	listed := len(r.Rows) < MaxReportedRows
+	switch {
+	case result.Err != nil:
+		r.Failed++
+		listed = listed || r.Failed <= MaxReportedFailures
+	case result.Action == ActionCreated:
+		r.Created++
+	case result.Action == ActionUpdated:
+		r.Updated++
+	default:
+		r.Unchanged++
+	}
+
+	if !listed {
+		r.Truncated = true
+		return
+	}
+	r.Rows = append(r.Rows, result)
+}
@@ -0,0 +1,250 @@
+package merge_categories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// MaxSources is the maximum number of distinct categories merged at once.
+const MaxSources = 100
+
+var (
+	ErrNoSources      = errors.New("at least one source_category_id is required")
+	ErrTooManySources = fmt.Errorf("at most %d source_category_ids may be merged at once", MaxSources)
+)
+
+// Request represents the input for merging categories into a target.
+type Request struct {
+	SourceCategoryIDs []string
+	TargetCategoryID  string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the merge categories use case.
+type Interactor struct {
+	categoryRepo *repo.CategoryRepo
+	bulkRepo     *repo.BulkOperationRepo
+	campaignRepo *repo.CampaignRepo
+	couponRepo   *repo.CouponRepo
+	outboxRepo   *repo.OutboxRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new merge categories interactor.
+func NewInteractor(
+	categoryRepo *repo.CategoryRepo,
+	bulkRepo *repo.BulkOperationRepo,
+	campaignRepo *repo.CampaignRepo,
+	couponRepo *repo.CouponRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		categoryRepo: categoryRepo,
+		bulkRepo:     bulkRepo,
+		campaignRepo: campaignRepo,
+		couponRepo:   couponRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute merges the source categories into the target: their children move
+// under the target, the sources are deleted and a bulk operation moves their
+// products, archived ones included, to the target. The tree changes and the
+// operation commit together, so the bulk updater finishes moving the
+// products even if the server stops right after, and no product can be
+// assigned to a source in the meantime. A source that an earlier rename or
+// merge is still moving products into or out of cannot be merged until that
+// operation completes, and, as for a rename, a source whose name campaigns
+// or coupons select cannot be merged into a differently named target.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CategoryOperationResult, error) {
+	sourceIDs := domain.Dedupe(req.SourceCategoryIDs)
+	if len(sourceIDs) == 0 {
+		return nil, ErrNoSources
+	}
+	if len(sourceIDs) > MaxSources {
+		return nil, ErrTooManySources
+	}
+
+	var (
+		target *domain.Category
+		events []domain.DomainEvent
+		op     *contracts.BulkOperation
+	)
+
+	err := it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load the target, its ancestry, the sources, their children, the
+		// categories earlier operations are still moving products of and
+		// the promotions selecting the sources' products by name
+		var err error
+		target, err = it.categoryRepo.GetByIDWithTxn(ctx, txn, req.TargetCategoryID)
+		if err != nil {
+			return nil, err
+		}
+		targetPath, err := it.categoryRepo.PathWithTxn(ctx, txn, target.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		sources := make([]*domain.Category, 0, len(sourceIDs))
+		isSource := make(map[string]bool, len(sourceIDs))
+		for _, id := range sourceIDs {
+			source, err := it.categoryRepo.GetByIDWithTxn(ctx, txn, id)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, source)
+			isSource[id] = true
+		}
+
+		children, err := it.categoryRepo.ChildrenWithTxn(ctx, txn, sourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		moving, err := it.bulkRepo.MovingCategoriesWithTxn(ctx, txn)
+		if err != nil {
+			return nil, err
+		}
+
+		now := it.clock.Now()
+		names := make([]string, len(sources))
+		for i, source := range sources {
+			names[i] = source.DisplayName()
+		}
+		campaigns, err := it.campaignRepo.SelectingCategoriesWithTxn(ctx, txn, names, now)
+		if err != nil {
+			return nil, err
+		}
+		coupons, err := it.couponRepo.ForCategoriesWithTxn(ctx, txn, names, now)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Apply domain logic
+		events = events[:0]
+		for _, source := range sources {
+			promotions := domain.CategoryPromotions{
+				CampaignIDs: campaigns[source.DisplayName()],
+				CouponCodes: coupons[source.DisplayName()],
+			}
+			if err := source.MergeInto(target, targetPath, moving[source.ID()], promotions, now); err != nil {
+				return nil, err
+			}
+			events = append(events, source.DomainEvents()...)
+		}
+
+		moved := make([]*domain.Category, 0, len(children))
+		targetID := target.ID()
+		for _, child := range children {
+			if isSource[child.ID()] {
+				continue
+			}
+			if err := child.Update(domain.CategoryUpdate{ParentID: &targetID}, targetPath, now); err != nil {
+				return nil, err
+			}
+			moved = append(moved, child)
+			events = append(events, child.DomainEvents()...)
+		}
+
+		op, err = it.newOperation(ctx, sourceIDs, targetID, now)
+		if err != nil {
+			return nil, err
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get mutations from repositories
+		for _, child := range moved {
+			if mut := it.categoryRepo.UpdateMut(child); mut != nil {
+				plan.Add(mut)
+			}
+		}
+		for _, source := range sources {
+			plan.Add(it.categoryRepo.DeleteMut(source.ID()))
+		}
+		plan.Add(it.bulkRepo.InsertMut(op))
+
+		// 5. Add outbox events
+		for _, event := range events {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically, unless only validating
+		if req.ValidateOnly {
+			return committer.NewPlan(), nil
+		}
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventDTOs, err := command_result.FromEvents(events, it.outboxRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &command_result.CategoryOperationResult{
+		Category:  command_result.FromCategory(target),
+		Events:    eventDTOs,
+		Operation: command_result.FromBulkOperation(op),
+	}
+	if req.ValidateOnly {
+		result.Operation.ID = ""
+	}
+
+	return result, nil
+}
+
+// newOperation builds the bulk operation assigning the target to the
+// products in the sources.
+func (it *Interactor) newOperation(ctx context.Context, sourceIDs []string, targetID string, now time.Time) (*contracts.BulkOperation, error) {
+	action, err := domain.NewBulkAction(domain.BulkCommandAssignCategory, domain.BulkActionParams{
+		CategoryID: targetID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filters := contracts.ProductListFilters{
+		CategoryIDs:  sourceIDs,
+		ArchivedMode: contracts.ArchivedModeInclude,
+	}
+
+	matched, err := it.bulkRepo.CountMatching(ctx, filters, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.BulkOperation{
+		ID:           uuid.New().String(),
+		Action:       action,
+		Filters:      filters,
+		Status:       contracts.BulkOperationRunning,
+		MatchedCount: matched,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package purge_product
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// DefaultRetention is how long a product stays archived before it can be purged.
+const DefaultRetention = 90 * 24 * time.Hour
+
+// Request represents the input for purging a product.
+type Request struct {
+	ProductID string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the purge product use case.
+type Interactor struct {
+	productRepo *repo.ProductRepo
+	outboxRepo  *repo.OutboxRepo
+	committer   committer.TransactionalCommitter
+	clock       clock.Clock
+	retention   time.Duration
+}
+
+// NewInteractor creates a new purge product interactor.
+// Only products archived at least retention ago can be purged.
+func NewInteractor(
+	productRepo *repo.ProductRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+	retention time.Duration,
+) *Interactor {
+	return &Interactor{
+		productRepo: productRepo,
+		outboxRepo:  outboxRepo,
+		committer:   committer,
+		clock:       clock,
+		retention:   retention,
+	}
+}
+
+// Retention returns how long a product must be archived before it can be purged.
+func (it *Interactor) Retention() time.Duration {
+	return it.retention
+}
+
+// Execute permanently deletes an archived product and returns the events it
+// emitted. The product is read inside the transaction so a concurrent restore
+// can't be deleted from under it.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.Result, error) {
+	if req.ValidateOnly {
+		return it.validate(ctx, req)
+	}
+
+	var purged *domain.Product
+	err := it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load existing product aggregate
+		product, err := it.productRepo.GetByIDWithTxn(ctx, txn, req.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Apply domain logic
+		if err := product.Purge(it.retention, it.clock.Now()); err != nil {
+			return nil, err
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get delete mutation from repository
+		plan.Add(it.productRepo.DeleteMut(product.ID()))
+
+		// 5. Add outbox events
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		purged = product
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return it.result(purged)
+}
+
+// validate runs the purge checks without a transaction; nothing is deleted.
+func (it *Interactor) validate(ctx context.Context, req Request) (*command_result.Result, error) {
+	product, err := it.productRepo.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := product.Purge(it.retention, it.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	return it.result(product)
+}
+
+// result reports the purge events; the product itself is gone.
+func (it *Interactor) result(product *domain.Product) (*command_result.Result, error) {
+	events, err := command_result.FromEvents(product.DomainEvents(), it.outboxRepo)
+	if err != nil {
+		return nil, err
+	}
+	return &command_result.Result{Events: events}, nil
+}
@@ -0,0 +1,146 @@
+package redeem_coupon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/domain/services"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// MaxProductIDs is the maximum number of distinct product IDs per order.
+const MaxProductIDs = 200
+
+var (
+	ErrNoProductIDs      = errors.New("at least one product_id is required")
+	ErrTooManyProductIDs = fmt.Errorf("at most %d product_ids may be redeemed at once", MaxProductIDs)
+)
+
+// Request represents the input for redeeming a coupon for an order.
+type Request struct {
+	Code       string
+	OrderID    string
+	ProductIDs []string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the redeem coupon use case.
+type Interactor struct {
+	couponRepo   *repo.CouponRepo
+	productRepo  *repo.ProductRepo
+	campaignRepo *repo.CampaignRepo
+	outboxRepo   *repo.OutboxRepo
+	pricing      *services.PricingCalculator
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new redeem coupon interactor.
+func NewInteractor(
+	couponRepo *repo.CouponRepo,
+	productRepo *repo.ProductRepo,
+	campaignRepo *repo.CampaignRepo,
+	outboxRepo *repo.OutboxRepo,
+	pricing *services.PricingCalculator,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		couponRepo:   couponRepo,
+		productRepo:  productRepo,
+		campaignRepo: campaignRepo,
+		outboxRepo:   outboxRepo,
+		pricing:      pricing,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute redeems the coupon for an order and returns the prices the order
+// gets. The coupon, its redemption count and the products are read inside the
+// transaction, so concurrent redemptions can't exceed the limit, and an order
+// can redeem a coupon only once. Products the coupon does not apply to are
+// reported on their line; at least one must be eligible.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CouponResult, error) {
+	ids := domain.Dedupe(req.ProductIDs)
+	if len(ids) == 0 {
+		return nil, ErrNoProductIDs
+	}
+	if len(ids) > MaxProductIDs {
+		return nil, ErrTooManyProductIDs
+	}
+
+	// Campaigns are read outside the transaction; a campaign ending mid-order
+	// is priced as it was when the order started
+	now := it.clock.Now()
+	campaigns, err := it.campaignRepo.ListRunning(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		redeemed *domain.Coupon
+		lines    []services.CouponLine
+	)
+	err = it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load the coupon aggregate and the order's products
+		coupon, err := it.couponRepo.GetByCodeWithTxn(ctx, txn, req.Code)
+		if err != nil {
+			return nil, err
+		}
+		exists, err := it.couponRepo.RedemptionExistsWithTxn(ctx, txn, coupon.ID(), req.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, domain.ErrCouponAlreadyRedeemed
+		}
+		products, err := it.productRepo.GetByIDsWithTxn(ctx, txn, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Apply domain logic
+		priced := it.pricing.PriceWithCoupon(coupon, ids, products, now, campaigns...)
+		redemption, err := coupon.Redeem(req.OrderID, services.EligibleProductIDs(priced), now)
+		if err != nil {
+			return nil, err
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get redemption mutations from repository
+		plan.AddAll(it.couponRepo.RedeemMuts(coupon, redemption)...)
+
+		// 5. Add outbox events
+		for _, event := range coupon.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		redeemed, lines = coupon, priced
+
+		// 6. Apply plan atomically, unless only validating
+		if req.ValidateOnly {
+			return committer.NewPlan(), nil
+		}
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return command_result.NewCoupon(redeemed, lines, it.outboxRepo)
+}
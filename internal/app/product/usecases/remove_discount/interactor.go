@@ -2,8 +2,13 @@ package remove_discount
 
 import (
 	"context"
+	"time"
 
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
 	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
 	"github.com/product-catalog-service/internal/pkg/clock"
 	"github.com/product-catalog-service/internal/pkg/committer"
 )
@@ -11,65 +16,111 @@ import (
 // Request represents the input for removing a discount.
 type Request struct {
 	ProductID string
+
+	// ExpectedVersion, when set, makes the command fail with
+	// domain.ErrVersionMismatch unless the product is at that version.
+	ExpectedVersion int64
+
+	ValidateOnly bool
 }
 
 // Interactor handles the remove discount use case.
 type Interactor struct {
-	productRepo *repo.ProductRepo
-	outboxRepo  *repo.OutboxRepo
-	committer   committer.Committer
-	clock       clock.Clock
+	productRepo  *repo.ProductRepo
+	outboxRepo   *repo.OutboxRepo
+	campaignRepo *repo.CampaignRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
 }
 
 // NewInteractor creates a new remove discount interactor.
 func NewInteractor(
 	productRepo *repo.ProductRepo,
 	outboxRepo *repo.OutboxRepo,
-	committer committer.Committer,
+	campaignRepo *repo.CampaignRepo,
+	committer committer.TransactionalCommitter,
 	clock clock.Clock,
 ) *Interactor {
 	return &Interactor{
-		productRepo: productRepo,
-		outboxRepo:  outboxRepo,
-		committer:   committer,
-		clock:       clock,
+		productRepo:  productRepo,
+		outboxRepo:   outboxRepo,
+		campaignRepo: campaignRepo,
+		committer:    committer,
+		clock:        clock,
 	}
 }
 
-// Execute removes a discount from a product.
-func (it *Interactor) Execute(ctx context.Context, req Request) error {
-	// 1. Load existing product aggregate
-	product, err := it.productRepo.GetByID(ctx, req.ProductID)
-	if err != nil {
-		return err
+// Execute removes a discount from a product and returns the result.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.Result, error) {
+	if req.ValidateOnly {
+		return it.validate(ctx, req)
 	}
 
-	// 2. Apply domain logic
-	if err := product.RemoveDiscount(it.clock.Now()); err != nil {
-		return err
-	}
+	var (
+		product *domain.Product
+		now     time.Time
+	)
 
-	// 3. Build commit plan
-	plan := committer.NewPlan()
+	err := it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load existing product aggregate at the expected version
+		var err error
+		product, err = it.productRepo.GetByIDWithTxn(ctx, txn, req.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if err := product.CheckVersion(req.ExpectedVersion); err != nil {
+			return nil, err
+		}
 
-	// 4. Get update mutation from repository
-	if mut := it.productRepo.UpdateMut(product); mut != nil {
-		plan.Add(mut)
-	}
+		now = it.clock.Now()
 
-	// 5. Add outbox events
-	for _, event := range product.DomainEvents() {
-		outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
-		if err != nil {
-			return err
+		// 2. Apply domain logic
+		if err := product.RemoveDiscount(now); err != nil {
+			return nil, err
 		}
-		plan.Add(outboxMut)
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get update mutation from repository
+		if mut := it.productRepo.UpdateMut(product); mut != nil {
+			plan.Add(mut)
+		}
+
+		// 5. Add outbox events
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return command_result.New(ctx, product, now, it.outboxRepo, it.campaignRepo)
+}
+
+// validate runs the discount removal checks against the product read without a
+// transaction, so nothing is locked or written.
+func (it *Interactor) validate(ctx context.Context, req Request) (*command_result.Result, error) {
+	product, err := it.productRepo.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.CheckVersion(req.ExpectedVersion); err != nil {
+		return nil, err
 	}
 
-	// 6. Apply plan atomically
-	if err := it.committer.Apply(ctx, plan); err != nil {
-		return err
+	now := it.clock.Now()
+	if err := product.RemoveDiscount(now); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return command_result.New(ctx, product, now, it.outboxRepo, it.campaignRepo)
 }
@@ -0,0 +1,184 @@
+package rename_category
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for renaming a category.
+type Request struct {
+	CategoryID  string
+	DisplayName string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the rename category use case.
+type Interactor struct {
+	categoryRepo *repo.CategoryRepo
+	bulkRepo     *repo.BulkOperationRepo
+	campaignRepo *repo.CampaignRepo
+	couponRepo   *repo.CouponRepo
+	outboxRepo   *repo.OutboxRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new rename category interactor.
+func NewInteractor(
+	categoryRepo *repo.CategoryRepo,
+	bulkRepo *repo.BulkOperationRepo,
+	campaignRepo *repo.CampaignRepo,
+	couponRepo *repo.CouponRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		categoryRepo: categoryRepo,
+		bulkRepo:     bulkRepo,
+		campaignRepo: campaignRepo,
+		couponRepo:   couponRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute changes a category's display name and starts a bulk operation
+// giving every product assigned to the category, archived ones included,
+// the new name. The rename and the operation commit together, so the bulk
+// updater finishes the rewrite even if the server stops right after.
+// Campaigns and coupons select products by category name, so the rename is
+// refused while any that are not over select the old one.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CategoryOperationResult, error) {
+	var (
+		category *domain.Category
+		op       *contracts.BulkOperation
+	)
+
+	err := it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load existing category aggregate and the promotions selecting
+		// its products by name
+		var err error
+		category, err = it.categoryRepo.GetByIDWithTxn(ctx, txn, req.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		now := it.clock.Now()
+		name := []string{category.DisplayName()}
+		campaigns, err := it.campaignRepo.SelectingCategoriesWithTxn(ctx, txn, name, now)
+		if err != nil {
+			return nil, err
+		}
+		coupons, err := it.couponRepo.ForCategoriesWithTxn(ctx, txn, name, now)
+		if err != nil {
+			return nil, err
+		}
+		promotions := domain.CategoryPromotions{
+			CampaignIDs: campaigns[category.DisplayName()],
+			CouponCodes: coupons[category.DisplayName()],
+		}
+
+		// 2. Apply domain logic
+		if err := category.Rename(req.DisplayName, promotions, now); err != nil {
+			return nil, err
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+		op = nil
+		if len(category.DomainEvents()) == 0 {
+			return plan, nil
+		}
+
+		op, err = it.newOperation(ctx, category.ID(), now)
+		if err != nil {
+			return nil, err
+		}
+
+		// 4. Get update mutations from repositories
+		if mut := it.categoryRepo.UpdateMut(category); mut != nil {
+			plan.Add(mut)
+		}
+		plan.Add(it.bulkRepo.InsertMut(op))
+
+		// 5. Add outbox events
+		for _, event := range category.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically, unless only validating
+		if req.ValidateOnly {
+			return committer.NewPlan(), nil
+		}
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := command_result.FromEvents(category.DomainEvents(), it.outboxRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &command_result.CategoryOperationResult{
+		Category: command_result.FromCategory(category),
+		Events:   events,
+	}
+	if op != nil {
+		result.Operation = command_result.FromBulkOperation(op)
+		if req.ValidateOnly {
+			result.Operation.ID = ""
+		}
+	}
+
+	return result, nil
+}
+
+// newOperation builds the bulk operation assigning the category to the
+// products already in it, which picks up the new display name.
+func (it *Interactor) newOperation(ctx context.Context, categoryID string, now time.Time) (*contracts.BulkOperation, error) {
+	action, err := domain.NewBulkAction(domain.BulkCommandAssignCategory, domain.BulkActionParams{
+		CategoryID: categoryID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filters := contracts.ProductListFilters{
+		CategoryIDs:  []string{categoryID},
+		ArchivedMode: contracts.ArchivedModeInclude,
+	}
+
+	matched, err := it.bulkRepo.CountMatching(ctx, filters, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.BulkOperation{
+		ID:           uuid.New().String(),
+		Action:       action,
+		Filters:      filters,
+		Status:       contracts.BulkOperationRunning,
+		MatchedCount: matched,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
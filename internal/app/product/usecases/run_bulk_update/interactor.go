@@ -0,0 +1,197 @@
+package run_bulk_update
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// DefaultChunkSize is how many products a chunk visits by default. It keeps
+// each transaction, and the time it holds its locks, short.
+const DefaultChunkSize = 100
+
+// Request represents the input for running a chunk of a bulk operation.
+type Request struct {
+	OperationID string
+}
+
+// Interactor handles the run bulk update use case.
+type Interactor struct {
+	productRepo  *repo.ProductRepo
+	outboxRepo   *repo.OutboxRepo
+	bulkRepo     *repo.BulkOperationRepo
+	categoryRepo *repo.CategoryRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+	lifecycle    *domain.Lifecycle
+	policy       *domain.ActivationPolicy
+	chunkSize    int
+}
+
+// NewInteractor creates a new run bulk update interactor.
+func NewInteractor(
+	productRepo *repo.ProductRepo,
+	outboxRepo *repo.OutboxRepo,
+	bulkRepo *repo.BulkOperationRepo,
+	categoryRepo *repo.CategoryRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+	lifecycle *domain.Lifecycle,
+	policy *domain.ActivationPolicy,
+	chunkSize int,
+) *Interactor {
+	return &Interactor{
+		productRepo:  productRepo,
+		outboxRepo:   outboxRepo,
+		bulkRepo:     bulkRepo,
+		categoryRepo: categoryRepo,
+		committer:    committer,
+		clock:        clock,
+		lifecycle:    lifecycle,
+		policy:       policy,
+		chunkSize:    chunkSize,
+	}
+}
+
+// Execute applies a bulk operation's action to the next chunk of matching
+// products and reports whether the operation is complete. The chunk is
+// selected with a read-only query, so scanning the filters takes no locks.
+// Its products are then reloaded and rechecked against the filters in a
+// read-write transaction that commits them with their outbox events, the
+// failures and the operation's progress, so replicas racing on the same
+// operation never process a product twice. Products the action is rejected
+// for are recorded as failures and left untouched. The category an
+// assign_category action assigns is read in the same transaction.
+func (it *Interactor) Execute(ctx context.Context, req Request) (bool, error) {
+	// 1. Select the next chunk of matching products without locking
+	selected, err := it.bulkRepo.Get(ctx, req.OperationID, 0)
+	if err != nil {
+		return false, err
+	}
+	if selected.Status == contracts.BulkOperationCompleted {
+		return true, nil
+	}
+	candidates, err := it.bulkRepo.MatchingIDs(ctx, selected.Filters, it.clock.Now(), selected.LastProductID, it.chunkSize)
+	if err != nil {
+		return false, err
+	}
+
+	var done bool
+
+	err = it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 2. Reload the operation and the chunk's products that still match
+		op, err := it.bulkRepo.GetWithTxn(ctx, txn, req.OperationID)
+		if err != nil {
+			return nil, err
+		}
+		if op.Status == contracts.BulkOperationCompleted {
+			done = true
+			return committer.NewPlan(), nil
+		}
+		if op.LastProductID != selected.LastProductID {
+			// Another replica ran this chunk; the caller selects the next one
+			done = false
+			return committer.NewPlan(), nil
+		}
+
+		now := it.clock.Now()
+		ids, err := it.bulkRepo.StillMatchingIDsWithTxn(ctx, txn, op.Filters, now, candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		var products map[string]*domain.Product
+		if len(ids) > 0 {
+			products, err = it.productRepo.GetByIDsWithTxn(ctx, txn, ids)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var category *domain.Category
+		if categoryID := op.Action.Params().CategoryID; categoryID != "" && len(ids) > 0 {
+			category, err = it.categoryRepo.GetByIDWithTxn(ctx, txn, categoryID)
+			if err != nil && !errors.Is(err, domain.ErrCategoryNotFound) {
+				return nil, err
+			}
+		}
+
+		// 3. Apply domain logic product by product
+		var (
+			changed  []*domain.Product
+			failures []contracts.BulkOperationFailure
+		)
+		for _, id := range ids {
+			if err := it.apply(op.Action, products[id], category, now); err != nil {
+				failures = append(failures, contracts.BulkOperationFailure{
+					ProductID: id,
+					Message:   err.Error(),
+					FailedAt:  now,
+				})
+				continue
+			}
+			changed = append(changed, products[id])
+		}
+
+		op.ProcessedCount += int64(len(ids))
+		op.SucceededCount += int64(len(changed))
+		op.FailedCount += int64(len(failures))
+		op.UpdatedAt = now
+		if len(candidates) > 0 {
+			op.LastProductID = candidates[len(candidates)-1]
+		}
+		if len(candidates) < it.chunkSize {
+			op.Status = contracts.BulkOperationCompleted
+			op.CompletedAt = &now
+		}
+		done = op.Status == contracts.BulkOperationCompleted
+
+		// 4. Build commit plan
+		plan := committer.NewPlan()
+
+		for _, product := range changed {
+			// 5. Get update mutation from repository
+			if mut := it.productRepo.UpdateMut(product); mut != nil {
+				plan.Add(mut)
+			}
+
+			// 6. Add outbox events
+			for _, event := range product.DomainEvents() {
+				outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+				if err != nil {
+					return nil, err
+				}
+				plan.Add(outboxMut)
+			}
+		}
+
+		for _, failure := range failures {
+			plan.Add(it.bulkRepo.FailureMut(op.ID, failure))
+		}
+		plan.Add(it.bulkRepo.ProgressMut(op))
+
+		return plan, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return done, nil
+}
+
+// apply runs the action on one product. A product that failed to load is
+// reported as not found.
+func (it *Interactor) apply(action *domain.BulkAction, product *domain.Product, category *domain.Category, now time.Time) error {
+	if product == nil {
+		return domain.ErrProductNotFound
+	}
+	return action.Apply(product, it.lifecycle, it.policy, category, now)
+}
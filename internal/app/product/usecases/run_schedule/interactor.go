@@ -0,0 +1,92 @@
+package run_schedule
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for running a product's schedule.
+type Request struct {
+	ProductID string
+}
+
+// Interactor handles the run schedule use case.
+type Interactor struct {
+	productRepo *repo.ProductRepo
+	outboxRepo  *repo.OutboxRepo
+	committer   committer.TransactionalCommitter
+	clock       clock.Clock
+	lifecycle   *domain.Lifecycle
+	policy      *domain.ActivationPolicy
+}
+
+// NewInteractor creates a new run schedule interactor.
+func NewInteractor(
+	productRepo *repo.ProductRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+	lifecycle *domain.Lifecycle,
+	policy *domain.ActivationPolicy,
+) *Interactor {
+	return &Interactor{
+		productRepo: productRepo,
+		outboxRepo:  outboxRepo,
+		committer:   committer,
+		clock:       clock,
+		lifecycle:   lifecycle,
+		policy:      policy,
+	}
+}
+
+// Execute fires the scheduled transitions of a product that are due and
+// reports whether any fired. The product is read and the fired times are
+// cleared in one read-write transaction, so replicas racing on the same
+// product fire each transition once.
+func (it *Interactor) Execute(ctx context.Context, req Request) (bool, error) {
+	var fired bool
+
+	err := it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load existing product aggregate
+		product, err := it.productRepo.GetByIDWithTxn(ctx, txn, req.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Apply domain logic
+		fired, err = product.RunSchedule(it.lifecycle, it.policy, it.clock.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get update mutation from repository
+		if mut := it.productRepo.UpdateMut(product); mut != nil {
+			plan.Add(mut)
+		}
+
+		// 5. Add outbox events
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		return plan, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return fired, nil
+}
@@ -0,0 +1,151 @@
+package start_bulk_update
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for starting a bulk update.
+// The filters have the same meaning as in list_products.
+type Request struct {
+	Category   *string
+	Status     *string
+	ActiveOnly bool
+
+	// ArchivedMode behaves as in list_products.
+	ArchivedMode contracts.ArchivedMode
+
+	MinBasePrice       *big.Rat
+	MaxBasePrice       *big.Rat
+	MinEffectivePrice  *big.Rat
+	MaxEffectivePrice  *big.Rat
+	OnSaleAt           *time.Time
+	MinDiscountPercent *int64
+
+	// Command is run on every matching product, with the arguments in
+	// Params it needs.
+	Command domain.BulkCommand
+	Params  domain.BulkActionParams
+
+	ValidateOnly bool
+}
+
+// Result describes the operation that was started. OperationID is empty
+// when only validating.
+type Result struct {
+	OperationID  string
+	Command      domain.BulkCommand
+	Status       contracts.BulkOperationStatus
+	MatchedCount int64
+	CreatedAt    time.Time
+}
+
+// Interactor handles the start bulk update use case.
+type Interactor struct {
+	bulkRepo  *repo.BulkOperationRepo
+	committer committer.Committer
+	clock     clock.Clock
+}
+
+// NewInteractor creates a new start bulk update interactor.
+func NewInteractor(
+	bulkRepo *repo.BulkOperationRepo,
+	committer committer.Committer,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		bulkRepo:  bulkRepo,
+		committer: committer,
+		clock:     clock,
+	}
+}
+
+// Execute validates the command and filters and records a running bulk
+// operation for the bulk updater to work through. No product is changed
+// here, and MatchedCount is only an estimate of the products the operation
+// will visit.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*Result, error) {
+	archivedMode, err := contracts.ResolveArchivedMode(req.ArchivedMode, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := contracts.ValidatePriceRanges(req.MinBasePrice, req.MaxBasePrice, req.MinEffectivePrice, req.MaxEffectivePrice); err != nil {
+		return nil, err
+	}
+
+	// 1. Create the bulk action, validating the command's arguments
+	action, err := domain.NewBulkAction(req.Command, req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := contracts.ProductListFilters{
+		Category:           req.Category,
+		Status:             req.Status,
+		ActiveOnly:         req.ActiveOnly,
+		ArchivedMode:       archivedMode,
+		MinBasePrice:       req.MinBasePrice,
+		MaxBasePrice:       req.MaxBasePrice,
+		MinEffectivePrice:  req.MinEffectivePrice,
+		MaxEffectivePrice:  req.MaxEffectivePrice,
+		OnSaleAt:           req.OnSaleAt,
+		MinDiscountPercent: req.MinDiscountPercent,
+	}
+
+	now := it.clock.Now()
+
+	// 2. Count the products the operation starts with
+	matched, err := it.bulkRepo.CountMatching(ctx, filters, now)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Command:      action.Command(),
+		Status:       contracts.BulkOperationRunning,
+		MatchedCount: matched,
+		CreatedAt:    now,
+	}
+	if req.ValidateOnly {
+		return result, nil
+	}
+
+	op := &contracts.BulkOperation{
+		ID:           uuid.New().String(),
+		Action:       action,
+		Filters:      filters,
+		Status:       contracts.BulkOperationRunning,
+		MatchedCount: matched,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	// 3. Build commit plan
+	plan := committer.NewPlan()
+
+	// 4. Get insert mutation from repository
+	plan.Add(it.bulkRepo.InsertMut(op))
+
+	// 5. Apply plan
+	if err := it.committer.Apply(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	result.OperationID = op.ID
+	return result, nil
+}
+
+// validRange reports whether an optional min/max pair is not inverted.
+func validRange(min, max *big.Rat) bool {
+	return min == nil || max == nil || min.Cmp(max) <= 0
+}
@@ -0,0 +1,121 @@
+package update_category
+
+import (
+	"context"
+	"errors"
+
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+	"github.com/product-catalog-service/internal/pkg/committer"
+)
+
+// Request represents the input for updating a category. Nil fields are left
+// as they are; an empty ParentID makes the category a root.
+type Request struct {
+	CategoryID string
+	Slug       *string
+	ParentID   *string
+
+	ValidateOnly bool
+}
+
+// Interactor handles the update category use case.
+type Interactor struct {
+	categoryRepo *repo.CategoryRepo
+	outboxRepo   *repo.OutboxRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new update category interactor.
+func NewInteractor(
+	categoryRepo *repo.CategoryRepo,
+	outboxRepo *repo.OutboxRepo,
+	committer committer.TransactionalCommitter,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		categoryRepo: categoryRepo,
+		outboxRepo:   outboxRepo,
+		committer:    committer,
+		clock:        clock,
+	}
+}
+
+// Execute changes a category's slug or moves it under another parent, and
+// returns the result. The new parent's ancestry is read inside the
+// transaction, so a concurrent move cannot close a cycle.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CategoryResult, error) {
+	var category *domain.Category
+
+	err := it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load existing category aggregate
+		var err error
+		category, err = it.categoryRepo.GetByIDWithTxn(ctx, txn, req.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		var parentPath []string
+		if req.ParentID != nil && *req.ParentID != "" && *req.ParentID != category.ParentID() {
+			parentPath, err = it.categoryRepo.PathWithTxn(ctx, txn, *req.ParentID)
+			if err != nil {
+				if errors.Is(err, domain.ErrCategoryNotFound) {
+					return nil, domain.ErrCategoryParentNotFound
+				}
+				return nil, err
+			}
+		}
+
+		// 2. Apply domain logic
+		slug := category.Slug()
+		if err := category.Update(domain.CategoryUpdate{
+			Slug:     req.Slug,
+			ParentID: req.ParentID,
+		}, parentPath, it.clock.Now()); err != nil {
+			return nil, err
+		}
+		if category.Slug() != slug {
+			if _, err := it.categoryRepo.GetBySlugWithTxn(ctx, txn, category.Slug()); err == nil {
+				return nil, domain.ErrCategorySlugTaken
+			} else if !errors.Is(err, domain.ErrCategoryNotFound) {
+				return nil, err
+			}
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+		if len(category.DomainEvents()) == 0 {
+			return plan, nil
+		}
+
+		// 4. Get update mutation from repository
+		if mut := it.categoryRepo.UpdateMut(category); mut != nil {
+			plan.Add(mut)
+		}
+
+		// 5. Add outbox events
+		for _, event := range category.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically, unless only validating
+		if req.ValidateOnly {
+			return committer.NewPlan(), nil
+		}
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return command_result.NewCategory(category, it.outboxRepo)
+}
@@ -2,77 +2,211 @@ package update_product
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"cloud.google.com/go/spanner"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
 	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
 	"github.com/product-catalog-service/internal/pkg/clock"
 	"github.com/product-catalog-service/internal/pkg/committer"
 )
 
+// ErrInvalidUpdateMask is returned when the update mask names an unknown field.
+var ErrInvalidUpdateMask = errors.New("invalid update_mask: expected name, description, category, category_id, attributes or *")
+
 // Request represents the input for updating a product.
 type Request struct {
 	ProductID   string
 	Name        string
 	Description string
 	Category    string
+
+	// CategoryID assigns the product to a category of the tree, taking
+	// precedence over Category. Empty leaves the assignment to Category: a
+	// new free-form category detaches the product from the tree.
+	CategoryID string
+
+	// Attributes replace all of the product's attributes. They are only
+	// changed when the update mask names "attributes" or is "*".
+	Attributes map[string]string
+
+	// UpdateMask lists the fields to change: "name", "description",
+	// "category", "category_id" and "attributes". "*" replaces all of them;
+	// empty replaces all but the attributes.
+	UpdateMask []string
+
+	// ExpectedVersion, when set, makes the command fail with
+	// domain.ErrVersionMismatch unless the product is at that version.
+	ExpectedVersion int64
+
+	ValidateOnly bool
 }
 
 // Interactor handles the update product use case.
 type Interactor struct {
-	productRepo *repo.ProductRepo
-	outboxRepo  *repo.OutboxRepo
-	committer   committer.Committer
-	clock       clock.Clock
+	productRepo  *repo.ProductRepo
+	outboxRepo   *repo.OutboxRepo
+	campaignRepo *repo.CampaignRepo
+	categoryRepo *repo.CategoryRepo
+	committer    committer.TransactionalCommitter
+	clock        clock.Clock
 }
 
 // NewInteractor creates a new update product interactor.
 func NewInteractor(
 	productRepo *repo.ProductRepo,
 	outboxRepo *repo.OutboxRepo,
-	committer committer.Committer,
+	campaignRepo *repo.CampaignRepo,
+	categoryRepo *repo.CategoryRepo,
+	committer committer.TransactionalCommitter,
 	clock clock.Clock,
 ) *Interactor {
 	return &Interactor{
-		productRepo: productRepo,
-		outboxRepo:  outboxRepo,
-		committer:   committer,
-		clock:       clock,
+		productRepo:  productRepo,
+		outboxRepo:   outboxRepo,
+		campaignRepo: campaignRepo,
+		categoryRepo: categoryRepo,
+		committer:    committer,
+		clock:        clock,
 	}
 }
 
-// Execute updates an existing product.
-func (it *Interactor) Execute(ctx context.Context, req Request) error {
-	// 1. Load existing product aggregate
-	product, err := it.productRepo.GetByID(ctx, req.ProductID)
+// Execute updates an existing product and returns the result. A category
+// assigned by ID is read in the transaction that writes the product.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.Result, error) {
+	update, assign, err := maskedUpdate(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	// 2. Apply domain logic
-	if err := product.Update(req.Name, req.Description, req.Category, it.clock.Now()); err != nil {
-		return err
+	if req.ValidateOnly {
+		return it.validate(ctx, req, update, assign)
 	}
 
-	// 3. Build commit plan
-	plan := committer.NewPlan()
+	var (
+		product *domain.Product
+		now     time.Time
+	)
+
+	err = it.committer.ApplyWithTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*committer.CommitPlan, error) {
+		// 1. Load existing product aggregate at the expected version
+		var err error
+		product, err = it.productRepo.GetByIDWithTxn(ctx, txn, req.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if err := product.CheckVersion(req.ExpectedVersion); err != nil {
+			return nil, err
+		}
+		update := update
+		if assign {
+			update.AssignedCategory, err = it.categoryRepo.GetByIDWithTxn(ctx, txn, req.CategoryID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		now = it.clock.Now()
+
+		// 2. Apply domain logic
+		if err := product.Update(update, now); err != nil {
+			return nil, err
+		}
+
+		// 3. Build commit plan
+		plan := committer.NewPlan()
+
+		// 4. Get update mutation from repository
+		if mut := it.productRepo.UpdateMut(product); mut != nil {
+			plan.Add(mut)
+		}
 
-	// 4. Get update mutation from repository
-	if mut := it.productRepo.UpdateMut(product); mut != nil {
-		plan.Add(mut)
+		// 5. Add outbox events
+		for _, event := range product.DomainEvents() {
+			outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+			if err != nil {
+				return nil, err
+			}
+			plan.Add(outboxMut)
+		}
+
+		// 6. Apply plan atomically
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 5. Add outbox events
-	for _, event := range product.DomainEvents() {
-		outboxMut, err := it.outboxRepo.InsertFromDomainEventMut(event)
+	return command_result.New(ctx, product, now, it.outboxRepo, it.campaignRepo)
+}
+
+// validate runs the update checks against the product and category read
+// without a transaction, so nothing is locked or written.
+func (it *Interactor) validate(ctx context.Context, req Request, update domain.ProductUpdate, assign bool) (*command_result.Result, error) {
+	product, err := it.productRepo.GetByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.CheckVersion(req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+	if assign {
+		update.AssignedCategory, err = it.categoryRepo.GetByID(ctx, req.CategoryID)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		plan.Add(outboxMut)
 	}
 
-	// 6. Apply plan atomically
-	if err := it.committer.Apply(ctx, plan); err != nil {
-		return err
+	now := it.clock.Now()
+	if err := product.Update(update, now); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return command_result.New(ctx, product, now, it.outboxRepo, it.campaignRepo)
+}
+
+// maskedUpdate keeps the request fields named by the update mask, and
+// reports whether the product is to be assigned to req.CategoryID.
+func maskedUpdate(req Request) (domain.ProductUpdate, bool, error) {
+	all := domain.ProductUpdate{
+		Name:        &req.Name,
+		Description: &req.Description,
+		Category:    &req.Category,
+	}
+	if req.CategoryID != "" {
+		all.Category = nil
+	}
+	if len(req.UpdateMask) == 0 {
+		return all, req.CategoryID != "", nil
+	}
+
+	attributes := req.Attributes
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	var update domain.ProductUpdate
+	assign := false
+	for _, path := range req.UpdateMask {
+		switch path {
+		case "*":
+			all.Attributes = attributes
+			return all, req.CategoryID != "", nil
+		case domain.FieldName:
+			update.Name = all.Name
+		case domain.FieldDescription:
+			update.Description = all.Description
+		case domain.FieldCategory:
+			update.Category = &req.Category
+		case domain.FieldCategoryID:
+			assign = true
+		case domain.FieldAttributes:
+			update.Attributes = attributes
+		default:
+			return domain.ProductUpdate{}, false, ErrInvalidUpdateMask
+		}
+	}
+	return update, assign, nil
 }
@@ -0,0 +1,96 @@
+package validate_coupon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/domain/services"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/pkg/clock"
+)
+
+// MaxProductIDs is the maximum number of distinct product IDs per request.
+const MaxProductIDs = 200
+
+var (
+	ErrNoProductIDs      = errors.New("at least one product_id is required")
+	ErrTooManyProductIDs = fmt.Errorf("at most %d product_ids may be priced at once", MaxProductIDs)
+)
+
+// Request represents the input for validating a coupon.
+type Request struct {
+	Code       string
+	ProductIDs []string
+}
+
+// Interactor handles the validate coupon use case. It writes nothing: it is
+// a use case rather than a query because pricing needs the domain aggregates.
+type Interactor struct {
+	couponRepo   *repo.CouponRepo
+	productRepo  *repo.ProductRepo
+	campaignRepo *repo.CampaignRepo
+	pricing      *services.PricingCalculator
+	clock        clock.Clock
+}
+
+// NewInteractor creates a new validate coupon interactor.
+func NewInteractor(
+	couponRepo *repo.CouponRepo,
+	productRepo *repo.ProductRepo,
+	campaignRepo *repo.CampaignRepo,
+	pricing *services.PricingCalculator,
+	clock clock.Clock,
+) *Interactor {
+	return &Interactor{
+		couponRepo:   couponRepo,
+		productRepo:  productRepo,
+		campaignRepo: campaignRepo,
+		pricing:      pricing,
+		clock:        clock,
+	}
+}
+
+// Execute prices the products with the coupon, in request order, without
+// redeeming it. A coupon that can't be used at all fails the request;
+// products it does not apply to are reported on their line.
+func (it *Interactor) Execute(ctx context.Context, req Request) (*command_result.CouponResult, error) {
+	ids := domain.Dedupe(req.ProductIDs)
+	if len(ids) == 0 {
+		return nil, ErrNoProductIDs
+	}
+	if len(ids) > MaxProductIDs {
+		return nil, ErrTooManyProductIDs
+	}
+
+	// 1. Load the coupon and check it can be used now
+	now := it.clock.Now()
+	coupon, err := it.couponRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if err := coupon.CheckRedeemable(now); err != nil {
+		return nil, err
+	}
+
+	// 2. Load the products and the campaigns pricing them
+	products, err := it.productRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	campaigns, err := it.campaignRepo.ListRunning(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Price each product with the coupon
+	lines := it.pricing.PriceWithCoupon(coupon, ids, products, now, campaigns...)
+
+	return &command_result.CouponResult{
+		Coupon: command_result.FromCoupon(coupon),
+		Lines:  command_result.FromCouponLines(lines),
+		Events: []command_result.EventDTO{},
+	}, nil
+}
@@ -0,0 +1,77 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/run_bulk_update"
+)
+
+// BulkUpdater works through running bulk operations a chunk at a time.
+type BulkUpdater struct {
+	bulkRepo *repo.BulkOperationRepo
+	runBulk  *run_bulk_update.Interactor
+}
+
+// NewBulkUpdater creates a new BulkUpdater.
+func NewBulkUpdater(
+	bulkRepo *repo.BulkOperationRepo,
+	runBulk *run_bulk_update.Interactor,
+) *BulkUpdater {
+	return &BulkUpdater{
+		bulkRepo: bulkRepo,
+		runBulk:  runBulk,
+	}
+}
+
+// ProcessRunning runs every running bulk operation, oldest first, until it
+// completes. It returns the number of operations it completed.
+func (b *BulkUpdater) ProcessRunning(ctx context.Context) (int, error) {
+	completed := 0
+
+	err := b.bulkRepo.ForEachRunning(ctx, func(id string) error {
+		for {
+			done, err := b.runBulk.Execute(ctx, run_bulk_update.Request{OperationID: id})
+			switch {
+			case err == nil:
+				if !done {
+					continue
+				}
+				completed++
+			case errors.Is(err, domain.ErrBulkOperationNotFound):
+				// Deleted since it was listed
+			default:
+				return err
+			}
+			return nil
+		}
+	})
+
+	return completed, err
+}
+
+// Run processes running bulk operations every interval until ctx is cancelled.
+func (b *BulkUpdater) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		completed, err := b.ProcessRunning(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("workers: failed to run bulk operations: %v", err)
+		}
+		if completed > 0 {
+			log.Printf("workers: completed %d bulk operations", completed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,14 @@
+// Package workers contains background jobs that drive the product aggregate
+// from the write side, as opposed to projections that follow the outbox.
+//
+// Workers select candidates with a plain read and then run the regular use
+// case for each one. The use case re-checks the domain rules inside its own
+// transaction, so a candidate that changed in the meantime, or that another
+// replica already handled, is skipped rather than processed twice.
+//
+// Available workers:
+//   - Purger: Permanently deletes products archived longer than the retention period
+//   - Scheduler: Activates and deactivates products at their publish/unpublish times
+//     and announces discount start and expiry
+//   - BulkUpdater: Applies running bulk operations to their matching products, a chunk per transaction
+package workers
@@ -0,0 +1,118 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/purge_product"
+	"github.com/product-catalog-service/internal/pkg/clock"
+)
+
+// PurgeCandidate describes an archived product that is due for purging.
+type PurgeCandidate struct {
+	ProductID  string
+	Name       string
+	Category   string
+	ArchivedAt time.Time
+	// Skipped is set when the product was restored, or purged by another
+	// replica, after it was listed, so this run did not purge it.
+	Skipped bool
+}
+
+// PurgeReport summarizes a purge run. Candidates lists every product found
+// due; Purged and Skipped count how many of them this run purged and skipped.
+// In a dry run nothing is purged and Candidates lists what would have been.
+type PurgeReport struct {
+	DryRun     bool
+	Candidates []PurgeCandidate
+	Purged     int
+	Skipped    int
+}
+
+// Purger deletes products that have been archived longer than the purge
+// retention period.
+type Purger struct {
+	productRepo *repo.ProductRepo
+	purge       *purge_product.Interactor
+	clock       clock.Clock
+}
+
+// NewPurger creates a new Purger.
+func NewPurger(
+	productRepo *repo.ProductRepo,
+	purge *purge_product.Interactor,
+	clock clock.Clock,
+) *Purger {
+	return &Purger{
+		productRepo: productRepo,
+		purge:       purge,
+		clock:       clock,
+	}
+}
+
+// PurgeDue purges every product archived at least the retention period ago,
+// each in its own transaction. With dryRun it only reports the candidates.
+func (p *Purger) PurgeDue(ctx context.Context, dryRun bool) (*PurgeReport, error) {
+	report := &PurgeReport{DryRun: dryRun}
+	cutoff := p.clock.Now().Add(-p.purge.Retention())
+
+	err := p.productRepo.ForEachArchivedBefore(ctx, cutoff, func(product *domain.Product) error {
+		candidate := PurgeCandidate{
+			ProductID:  product.ID(),
+			Name:       product.Name(),
+			Category:   product.Category(),
+			ArchivedAt: *product.ArchivedAt(),
+		}
+		if dryRun {
+			report.Candidates = append(report.Candidates, candidate)
+			return nil
+		}
+
+		_, err := p.purge.Execute(ctx, purge_product.Request{ProductID: product.ID()})
+		switch {
+		case err == nil:
+			report.Purged++
+		case errors.Is(err, domain.ErrProductNotFound),
+			errors.Is(err, domain.ErrProductNotArchived),
+			errors.Is(err, domain.ErrPurgeRetentionNotElapsed):
+			// Purged by another replica or restored since it was listed
+			candidate.Skipped = true
+			report.Skipped++
+		default:
+			return err
+		}
+		report.Candidates = append(report.Candidates, candidate)
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// Run purges due products every interval until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := p.PurgeDue(ctx, false)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("workers: failed to purge archived products: %v", err)
+		}
+		if report != nil && report.Purged > 0 {
+			log.Printf("workers: purged %d archived products", report.Purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
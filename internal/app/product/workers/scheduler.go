@@ -0,0 +1,122 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/advance_discount_phase"
+	"github.com/product-catalog-service/internal/app/product/usecases/run_schedule"
+	"github.com/product-catalog-service/internal/pkg/clock"
+)
+
+// ErrSweepIncomplete is returned by FireDue when some products could not be
+// updated; the others were.
+var ErrSweepIncomplete = errors.New("scheduler sweep incomplete")
+
+// failedProducts counts the products a sweep failed to update.
+// It is published on /debug/vars when the metrics endpoint is enabled.
+var failedProducts = expvar.NewInt("scheduler_failed_products")
+
+// Scheduler fires the publish and unpublish times set on products and
+// announces the start and end of their discounts.
+type Scheduler struct {
+	productRepo     *repo.ProductRepo
+	runSchedule     *run_schedule.Interactor
+	advanceDiscount *advance_discount_phase.Interactor
+	clock           clock.Clock
+}
+
+// NewScheduler creates a new Scheduler.
+func NewScheduler(
+	productRepo *repo.ProductRepo,
+	runSchedule *run_schedule.Interactor,
+	advanceDiscount *advance_discount_phase.Interactor,
+	clock clock.Clock,
+) *Scheduler {
+	return &Scheduler{
+		productRepo:     productRepo,
+		runSchedule:     runSchedule,
+		advanceDiscount: advanceDiscount,
+		clock:           clock,
+	}
+}
+
+// FireDue runs the schedule of every product with a due publish or unpublish
+// time, then advances the discount phase of every product with a passed
+// discount boundary. A product that fails is logged and left for the next
+// sweep without stopping this one. It returns the number of products that
+// changed, and an error wrapping ErrSweepIncomplete if any product failed.
+func (s *Scheduler) FireDue(ctx context.Context) (int, error) {
+	fired, failedSchedules, scheduleErr := s.fireEach(ctx, "schedule", s.productRepo.ForEachScheduleDue, func(id string) (bool, error) {
+		return s.runSchedule.Execute(ctx, run_schedule.Request{ProductID: id})
+	})
+
+	advanced, failedBoundaries, boundaryErr := s.fireEach(ctx, "discount boundary", s.productRepo.ForEachDiscountBoundaryDue, func(id string) (bool, error) {
+		return s.advanceDiscount.Execute(ctx, advance_discount_phase.Request{ProductID: id})
+	})
+
+	err := errors.Join(scheduleErr, boundaryErr)
+	if failed := failedSchedules + failedBoundaries; failed > 0 {
+		err = errors.Join(err, fmt.Errorf("%w: %d products failed", ErrSweepIncomplete, failed))
+	}
+	return fired + advanced, err
+}
+
+// fireEach calls fire for every product listed by forEach. A product whose
+// fire fails is logged and counted, and the sweep moves on. Only a failure to
+// list the products, or ctx ending, stops it.
+func (s *Scheduler) fireEach(
+	ctx context.Context,
+	what string,
+	forEach func(ctx context.Context, now time.Time, fn func(id string) error) error,
+	fire func(id string) (bool, error),
+) (changed, failed int, err error) {
+	err = forEach(ctx, s.clock.Now(), func(id string) error {
+		ok, err := fire(id)
+		switch {
+		case err == nil:
+			if ok {
+				changed++
+			}
+		case errors.Is(err, domain.ErrProductNotFound):
+			// Purged since it was listed
+		case ctx.Err() != nil:
+			return ctx.Err()
+		default:
+			failed++
+			failedProducts.Add(1)
+			log.Printf("workers: failed to fire %s of product %s: %v", what, id, err)
+		}
+		return nil
+	})
+
+	return changed, failed, err
+}
+
+// Run fires due schedules every interval until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fired, err := s.FireDue(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("workers: failed to fire product schedules: %v", err)
+		}
+		if fired > 0 {
+			log.Printf("workers: fired schedules or discount boundaries of %d products", fired)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
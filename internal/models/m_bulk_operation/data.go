@@ -0,0 +1,65 @@
+package m_bulk_operation
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// BulkOperation represents the database model for a bulk operation.
+type BulkOperation struct {
+	OperationID    string
+	Command        string
+	Params         spanner.NullJSON
+	Filters        spanner.NullJSON
+	Status         string
+	LastProductID  spanner.NullString
+	MatchedCount   int64
+	ProcessedCount int64
+	SucceededCount int64
+	FailedCount    int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CompletedAt    spanner.NullTime
+}
+
+// Model provides methods for creating Spanner mutations.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates an insert mutation for a bulk operation.
+func (m *Model) InsertMut(o *BulkOperation) *spanner.Mutation {
+	return spanner.InsertMap(TableName, map[string]interface{}{
+		OperationID:    o.OperationID,
+		Command:        o.Command,
+		Params:         o.Params,
+		Filters:        o.Filters,
+		Status:         o.Status,
+		LastProductID:  o.LastProductID,
+		MatchedCount:   o.MatchedCount,
+		ProcessedCount: o.ProcessedCount,
+		SucceededCount: o.SucceededCount,
+		FailedCount:    o.FailedCount,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+		CompletedAt:    o.CompletedAt,
+	})
+}
+
+// UpdateProgressMut creates an update mutation for the progress columns.
+func (m *Model) UpdateProgressMut(o *BulkOperation) *spanner.Mutation {
+	return spanner.UpdateMap(TableName, map[string]interface{}{
+		OperationID:    o.OperationID,
+		Status:         o.Status,
+		LastProductID:  o.LastProductID,
+		ProcessedCount: o.ProcessedCount,
+		SucceededCount: o.SucceededCount,
+		FailedCount:    o.FailedCount,
+		UpdatedAt:      o.UpdatedAt,
+		CompletedAt:    o.CompletedAt,
+	})
+}
@@ -0,0 +1,51 @@
+package m_bulk_operation
+
+// Table name
+const TableName = "bulk_operations"
+
+// Column names for the bulk_operations table.
+const (
+	OperationID    = "operation_id"
+	Command        = "command"
+	Params         = "params"
+	Filters        = "filters"
+	Status         = "status"
+	LastProductID  = "last_product_id"
+	MatchedCount   = "matched_count"
+	ProcessedCount = "processed_count"
+	SucceededCount = "succeeded_count"
+	FailedCount    = "failed_count"
+	CreatedAt      = "created_at"
+	UpdatedAt      = "updated_at"
+	CompletedAt    = "completed_at"
+)
+
+// Index names for the bulk_operations table.
+const (
+	IndexStatus = "idx_bulk_operations_status"
+)
+
+// Operation status constants.
+const (
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+)
+
+// AllColumns returns all column names.
+func AllColumns() []string {
+	return []string{
+		OperationID,
+		Command,
+		Params,
+		Filters,
+		Status,
+		LastProductID,
+		MatchedCount,
+		ProcessedCount,
+		SucceededCount,
+		FailedCount,
+		CreatedAt,
+		UpdatedAt,
+		CompletedAt,
+	}
+}
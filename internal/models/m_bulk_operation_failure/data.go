@@ -0,0 +1,36 @@
+package m_bulk_operation_failure
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// BulkOperationFailure represents the database model for a product a bulk
+// operation could not be applied to.
+type BulkOperationFailure struct {
+	OperationID string
+	ProductID   string
+	Message     string
+	FailedAt    time.Time
+}
+
+// Model provides methods for creating Spanner mutations.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertOrUpdateMut creates an insert or update mutation for a failure. A
+// product is processed once per operation, but a replayed chunk may record
+// it again.
+func (m *Model) InsertOrUpdateMut(f *BulkOperationFailure) *spanner.Mutation {
+	return spanner.InsertOrUpdateMap(TableName, map[string]interface{}{
+		OperationID: f.OperationID,
+		ProductID:   f.ProductID,
+		Message:     f.Message,
+		FailedAt:    f.FailedAt,
+	})
+}
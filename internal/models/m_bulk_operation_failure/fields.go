@@ -0,0 +1,22 @@
+package m_bulk_operation_failure
+
+// Table name
+const TableName = "bulk_operation_failures"
+
+// Column names for the bulk_operation_failures table.
+const (
+	OperationID = "operation_id"
+	ProductID   = "product_id"
+	Message     = "message"
+	FailedAt    = "failed_at"
+)
+
+// AllColumns returns all column names.
+func AllColumns() []string {
+	return []string{
+		OperationID,
+		ProductID,
+		Message,
+		FailedAt,
+	}
+}
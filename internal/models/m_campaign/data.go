@@ -0,0 +1,51 @@
+package m_campaign
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Campaign represents the database model for a promotion campaign.
+type Campaign struct {
+	CampaignID      string
+	Name            string
+	DiscountPercent int64
+	StartDate       time.Time
+	EndDate         time.Time
+	EndedAt         spanner.NullTime
+	ProductIDs      []string
+	Categories      []string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Model provides methods for creating Spanner mutations.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates an insert mutation for a campaign.
+func (m *Model) InsertMut(c *Campaign) *spanner.Mutation {
+	return spanner.InsertMap(TableName, map[string]interface{}{
+		CampaignID:      c.CampaignID,
+		Name:            c.Name,
+		DiscountPercent: c.DiscountPercent,
+		StartDate:       c.StartDate,
+		EndDate:         c.EndDate,
+		EndedAt:         c.EndedAt,
+		ProductIDs:      c.ProductIDs,
+		Categories:      c.Categories,
+		CreatedAt:       c.CreatedAt,
+		UpdatedAt:       c.UpdatedAt,
+	})
+}
+
+// UpdateMut creates an update mutation for specific columns.
+func (m *Model) UpdateMut(campaignID string, updates map[string]interface{}) *spanner.Mutation {
+	updates[CampaignID] = campaignID
+	return spanner.UpdateMap(TableName, updates)
+}
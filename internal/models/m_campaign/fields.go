@@ -0,0 +1,40 @@
+package m_campaign
+
+// Table name
+const TableName = "campaigns"
+
+// Column names for the campaigns table.
+const (
+	CampaignID      = "campaign_id"
+	Name            = "name"
+	DiscountPercent = "discount_percent"
+	StartDate       = "start_date"
+	EndDate         = "end_date"
+	EndedAt         = "ended_at"
+	ProductIDs      = "product_ids"
+	Categories      = "categories"
+	CreatedAt       = "created_at"
+	UpdatedAt       = "updated_at"
+)
+
+// Index names for the campaigns table.
+const (
+	IndexEndDate   = "idx_campaigns_end_date"
+	IndexCreatedAt = "idx_campaigns_created_at"
+)
+
+// AllColumns returns all column names.
+func AllColumns() []string {
+	return []string{
+		CampaignID,
+		Name,
+		DiscountPercent,
+		StartDate,
+		EndDate,
+		EndedAt,
+		ProductIDs,
+		Categories,
+		CreatedAt,
+		UpdatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package m_category
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Category represents the database model for a node of the category tree.
+type Category struct {
+	CategoryID  string
+	Slug        string
+	ParentID    spanner.NullString
+	DisplayName string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Model provides methods for creating Spanner mutations.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates an insert mutation for a category.
+func (m *Model) InsertMut(c *Category) *spanner.Mutation {
+	return spanner.InsertMap(TableName, map[string]interface{}{
+		CategoryID:  c.CategoryID,
+		Slug:        c.Slug,
+		ParentID:    c.ParentID,
+		DisplayName: c.DisplayName,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	})
+}
+
+// UpdateMut creates an update mutation for specific columns.
+func (m *Model) UpdateMut(categoryID string, updates map[string]interface{}) *spanner.Mutation {
+	updates[CategoryID] = categoryID
+	return spanner.UpdateMap(TableName, updates)
+}
+
+// DeleteMut creates a delete mutation for a single category.
+func (m *Model) DeleteMut(categoryID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{categoryID})
+}
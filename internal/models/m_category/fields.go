@@ -0,0 +1,32 @@
+package m_category
+
+// Table name
+const TableName = "categories"
+
+// Column names for the categories table.
+const (
+	CategoryID  = "category_id"
+	Slug        = "slug"
+	ParentID    = "parent_id"
+	DisplayName = "display_name"
+	CreatedAt   = "created_at"
+	UpdatedAt   = "updated_at"
+)
+
+// Index names for the categories table.
+const (
+	IndexSlug   = "idx_categories_slug"
+	IndexParent = "idx_categories_parent"
+)
+
+// AllColumns returns all column names.
+func AllColumns() []string {
+	return []string{
+		CategoryID,
+		Slug,
+		ParentID,
+		DisplayName,
+		CreatedAt,
+		UpdatedAt,
+	}
+}
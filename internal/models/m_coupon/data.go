@@ -0,0 +1,57 @@
+package m_coupon
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// Coupon represents the database model for a coupon code.
+type Coupon struct {
+	CouponID             string
+	Code                 string
+	Percentage           spanner.NullInt64
+	AmountOffNumerator   spanner.NullInt64
+	AmountOffDenominator spanner.NullInt64
+	ProductIDs           []string
+	Categories           []string
+	StartDate            time.Time
+	EndDate              time.Time
+	MaxRedemptions       int64
+	RedemptionCount      int64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// Model provides methods for creating Spanner mutations.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates an insert mutation for a coupon.
+func (m *Model) InsertMut(c *Coupon) *spanner.Mutation {
+	return spanner.InsertMap(TableName, map[string]interface{}{
+		CouponID:             c.CouponID,
+		Code:                 c.Code,
+		Percentage:           c.Percentage,
+		AmountOffNumerator:   c.AmountOffNumerator,
+		AmountOffDenominator: c.AmountOffDenominator,
+		ProductIDs:           c.ProductIDs,
+		Categories:           c.Categories,
+		StartDate:            c.StartDate,
+		EndDate:              c.EndDate,
+		MaxRedemptions:       c.MaxRedemptions,
+		RedemptionCount:      c.RedemptionCount,
+		CreatedAt:            c.CreatedAt,
+		UpdatedAt:            c.UpdatedAt,
+	})
+}
+
+// UpdateMut creates an update mutation for specific columns.
+func (m *Model) UpdateMut(couponID string, updates map[string]interface{}) *spanner.Mutation {
+	updates[CouponID] = couponID
+	return spanner.UpdateMap(TableName, updates)
+}
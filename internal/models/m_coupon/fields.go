@@ -0,0 +1,45 @@
+package m_coupon
+
+// Table name
+const TableName = "coupons"
+
+// Column names for the coupons table.
+const (
+	CouponID             = "coupon_id"
+	Code                 = "code"
+	Percentage           = "percentage"
+	AmountOffNumerator   = "amount_off_numerator"
+	AmountOffDenominator = "amount_off_denominator"
+	ProductIDs           = "product_ids"
+	Categories           = "categories"
+	StartDate            = "start_date"
+	EndDate              = "end_date"
+	MaxRedemptions       = "max_redemptions"
+	RedemptionCount      = "redemption_count"
+	CreatedAt            = "created_at"
+	UpdatedAt            = "updated_at"
+)
+
+// Index names for the coupons table.
+const (
+	IndexCode = "idx_coupons_code"
+)
+
+// AllColumns returns all column names.
+func AllColumns() []string {
+	return []string{
+		CouponID,
+		Code,
+		Percentage,
+		AmountOffNumerator,
+		AmountOffDenominator,
+		ProductIDs,
+		Categories,
+		StartDate,
+		EndDate,
+		MaxRedemptions,
+		RedemptionCount,
+		CreatedAt,
+		UpdatedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package m_coupon_redemption
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// CouponRedemption represents the database model for a coupon redeemed for
+// an order.
+type CouponRedemption struct {
+	CouponID   string
+	OrderID    string
+	ProductIDs []string
+	RedeemedAt time.Time
+}
+
+// Model provides methods for creating Spanner mutations.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertMut creates an insert mutation for a redemption. Inserting the same
+// order twice fails the commit.
+func (m *Model) InsertMut(r *CouponRedemption) *spanner.Mutation {
+	return spanner.InsertMap(TableName, map[string]interface{}{
+		CouponID:   r.CouponID,
+		OrderID:    r.OrderID,
+		ProductIDs: r.ProductIDs,
+		RedeemedAt: r.RedeemedAt,
+	})
+}
@@ -0,0 +1,22 @@
+package m_coupon_redemption
+
+// Table name
+const TableName = "coupon_redemptions"
+
+// Column names for the coupon_redemptions table.
+const (
+	CouponID   = "coupon_id"
+	OrderID    = "order_id"
+	ProductIDs = "product_ids"
+	RedeemedAt = "redeemed_at"
+)
+
+// AllColumns returns all column names.
+func AllColumns() []string {
+	return []string{
+		CouponID,
+		OrderID,
+		ProductIDs,
+		RedeemedAt,
+	}
+}
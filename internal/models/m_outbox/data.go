@@ -15,6 +15,7 @@ type OutboxEvent struct {
 	Status      string
 	CreatedAt   time.Time
 	ProcessedAt spanner.NullTime
+	ProjectedAt spanner.NullTime
 }
 
 // Model provides methods for creating Spanner mutations.
@@ -58,3 +59,12 @@ func (m *Model) MarkProcessedMut(eventID string, processedAt time.Time) *spanner
 func (m *Model) MarkFailedMut(eventID string) *spanner.Mutation {
 	return m.UpdateStatusMut(eventID, StatusFailed, nil)
 }
+
+// MarkProjectedMut creates a mutation recording that the read model
+// projections have handled an event. The delivery status is left untouched.
+func (m *Model) MarkProjectedMut(eventID string, projectedAt time.Time) *spanner.Mutation {
+	return spanner.UpdateMap(TableName, map[string]interface{}{
+		EventID:     eventID,
+		ProjectedAt: projectedAt,
+	})
+}
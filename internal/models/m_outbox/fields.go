@@ -12,6 +12,7 @@ const (
 	Status      = "status"
 	CreatedAt   = "created_at"
 	ProcessedAt = "processed_at"
+	ProjectedAt = "projected_at"
 )
 
 // Event status constants.
@@ -31,6 +32,7 @@ func AllColumns() []string {
 		Status,
 		CreatedAt,
 		ProcessedAt,
+		ProjectedAt,
 	}
 }
 
@@ -17,10 +17,17 @@ type Product struct {
 	DiscountPercent      spanner.NullNumeric
 	DiscountStartDate    spanner.NullTime
 	DiscountEndDate      spanner.NullTime
+	DiscountPhase        string
 	Status               string
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	ArchivedAt           spanner.NullTime
+	PublishAt            spanner.NullTime
+	UnpublishAt          spanner.NullTime
+	Version              int64
+	ExternalKey          spanner.NullString
+	CategoryID           spanner.NullString
+	Attributes           spanner.NullJSON
 }
 
 // Model provides methods for creating Spanner mutations.
@@ -43,10 +50,17 @@ func (m *Model) InsertMut(p *Product) *spanner.Mutation {
 		DiscountPercent:      p.DiscountPercent,
 		DiscountStartDate:    p.DiscountStartDate,
 		DiscountEndDate:      p.DiscountEndDate,
+		DiscountPhase:        p.DiscountPhase,
 		Status:               p.Status,
 		CreatedAt:            p.CreatedAt,
 		UpdatedAt:            p.UpdatedAt,
 		ArchivedAt:           p.ArchivedAt,
+		PublishAt:            p.PublishAt,
+		UnpublishAt:          p.UnpublishAt,
+		Version:              p.Version,
+		ExternalKey:          p.ExternalKey,
+		CategoryID:           p.CategoryID,
+		Attributes:           p.Attributes,
 	})
 }
 
@@ -68,9 +82,21 @@ func (m *Model) InsertOrUpdateMut(p *Product) *spanner.Mutation {
 		DiscountPercent:      p.DiscountPercent,
 		DiscountStartDate:    p.DiscountStartDate,
 		DiscountEndDate:      p.DiscountEndDate,
+		DiscountPhase:        p.DiscountPhase,
 		Status:               p.Status,
 		CreatedAt:            p.CreatedAt,
 		UpdatedAt:            p.UpdatedAt,
 		ArchivedAt:           p.ArchivedAt,
+		PublishAt:            p.PublishAt,
+		UnpublishAt:          p.UnpublishAt,
+		Version:              p.Version,
+		ExternalKey:          p.ExternalKey,
+		CategoryID:           p.CategoryID,
+		Attributes:           p.Attributes,
 	})
 }
+
+// DeleteMut creates a delete mutation for a single product.
+func (m *Model) DeleteMut(productID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID})
+}
@@ -14,10 +14,27 @@ const (
 	DiscountPercent      = "discount_percent"
 	DiscountStartDate    = "discount_start_date"
 	DiscountEndDate      = "discount_end_date"
+	DiscountPhase        = "discount_phase"
 	Status               = "status"
 	CreatedAt            = "created_at"
 	UpdatedAt            = "updated_at"
 	ArchivedAt           = "archived_at"
+	PublishAt            = "publish_at"
+	UnpublishAt          = "unpublish_at"
+	Version              = "version"
+	ExternalKey          = "external_key"
+	CategoryID           = "category_id"
+	Attributes           = "attributes"
+)
+
+// Index names for the products table.
+const (
+	IndexPublishAt     = "idx_products_publish_at"
+	IndexUnpublishAt   = "idx_products_unpublish_at"
+	IndexDiscountStart = "idx_products_discount_start"
+	IndexDiscountEnd   = "idx_products_discount_end"
+	IndexExternalKey   = "idx_products_external_key"
+	IndexCategoryID    = "idx_products_category_id"
 )
 
 // AllColumns returns all column names.
@@ -32,10 +49,17 @@ func AllColumns() []string {
 		DiscountPercent,
 		DiscountStartDate,
 		DiscountEndDate,
+		DiscountPhase,
 		Status,
 		CreatedAt,
 		UpdatedAt,
 		ArchivedAt,
+		PublishAt,
+		UnpublishAt,
+		Version,
+		ExternalKey,
+		CategoryID,
+		Attributes,
 	}
 }
 
@@ -51,9 +75,16 @@ func InsertColumns() []string {
 		DiscountPercent,
 		DiscountStartDate,
 		DiscountEndDate,
+		DiscountPhase,
 		Status,
 		CreatedAt,
 		UpdatedAt,
 		ArchivedAt,
+		PublishAt,
+		UnpublishAt,
+		Version,
+		ExternalKey,
+		CategoryID,
+		Attributes,
 	}
 }
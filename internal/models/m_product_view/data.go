@@ -0,0 +1,76 @@
+package m_product_view
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// ProductView represents the database model for a denormalized product view.
+type ProductView struct {
+	ProductID            string
+	Name                 string
+	Description          string
+	Category             string
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	DiscountPercent      spanner.NullNumeric
+	DiscountStartDate    spanner.NullTime
+	DiscountEndDate      spanner.NullTime
+	DiscountPhase        string
+	Status               string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	ArchivedAt           spanner.NullTime
+	PublishAt            spanner.NullTime
+	UnpublishAt          spanner.NullTime
+	Version              int64
+	ExternalKey          spanner.NullString
+	CategoryID           spanner.NullString
+	Attributes           spanner.NullJSON
+	BasePrice            spanner.NullNumeric
+	DiscountedPrice      spanner.NullNumeric
+	ProjectedAt          time.Time
+}
+
+// Model provides methods for creating Spanner mutations.
+type Model struct{}
+
+// NewModel creates a new Model instance.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// InsertOrUpdateMut creates an insert or update mutation that replaces the whole view row.
+func (m *Model) InsertOrUpdateMut(v *ProductView) *spanner.Mutation {
+	return spanner.InsertOrUpdateMap(TableName, map[string]interface{}{
+		ProductID:            v.ProductID,
+		Name:                 v.Name,
+		Description:          v.Description,
+		Category:             v.Category,
+		BasePriceNumerator:   v.BasePriceNumerator,
+		BasePriceDenominator: v.BasePriceDenominator,
+		DiscountPercent:      v.DiscountPercent,
+		DiscountStartDate:    v.DiscountStartDate,
+		DiscountEndDate:      v.DiscountEndDate,
+		DiscountPhase:        v.DiscountPhase,
+		Status:               v.Status,
+		CreatedAt:            v.CreatedAt,
+		UpdatedAt:            v.UpdatedAt,
+		ArchivedAt:           v.ArchivedAt,
+		PublishAt:            v.PublishAt,
+		UnpublishAt:          v.UnpublishAt,
+		Version:              v.Version,
+		ExternalKey:          v.ExternalKey,
+		CategoryID:           v.CategoryID,
+		Attributes:           v.Attributes,
+		BasePrice:            v.BasePrice,
+		DiscountedPrice:      v.DiscountedPrice,
+		ProjectedAt:          v.ProjectedAt,
+	})
+}
+
+// DeleteMut creates a delete mutation for a single view row.
+func (m *Model) DeleteMut(productID string) *spanner.Mutation {
+	return spanner.Delete(TableName, spanner.Key{productID})
+}
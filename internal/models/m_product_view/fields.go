@@ -0,0 +1,75 @@
+package m_product_view
+
+// Table name
+const TableName = "product_views"
+
+// Column names for the product_views table.
+const (
+	ProductID            = "product_id"
+	Name                 = "name"
+	Description          = "description"
+	Category             = "category"
+	BasePriceNumerator   = "base_price_numerator"
+	BasePriceDenominator = "base_price_denominator"
+	DiscountPercent      = "discount_percent"
+	DiscountStartDate    = "discount_start_date"
+	DiscountEndDate      = "discount_end_date"
+	DiscountPhase        = "discount_phase"
+	Status               = "status"
+	CreatedAt            = "created_at"
+	UpdatedAt            = "updated_at"
+	ArchivedAt           = "archived_at"
+	PublishAt            = "publish_at"
+	UnpublishAt          = "unpublish_at"
+	Version              = "version"
+	ExternalKey          = "external_key"
+	CategoryID           = "category_id"
+	Attributes           = "attributes"
+	BasePrice            = "base_price"
+	DiscountedPrice      = "discounted_price"
+	ProjectedAt          = "projected_at"
+)
+
+// Hidden search columns generated by Spanner. They are never written and are
+// not part of AllColumns.
+const (
+	NameTokens        = "name_tokens"
+	DescriptionTokens = "description_tokens"
+	CategoryTokens    = "category_tokens"
+	TextNgramTokens   = "text_ngram_tokens"
+)
+
+// Index names for the product_views table.
+const (
+	IndexProjectedAt = "idx_product_views_projected_at"
+	IndexSearch      = "idx_product_views_search"
+)
+
+// AllColumns returns all column names.
+func AllColumns() []string {
+	return []string{
+		ProductID,
+		Name,
+		Description,
+		Category,
+		BasePriceNumerator,
+		BasePriceDenominator,
+		DiscountPercent,
+		DiscountStartDate,
+		DiscountEndDate,
+		DiscountPhase,
+		Status,
+		CreatedAt,
+		UpdatedAt,
+		ArchivedAt,
+		PublishAt,
+		UnpublishAt,
+		Version,
+		ExternalKey,
+		CategoryID,
+		Attributes,
+		BasePrice,
+		DiscountedPrice,
+		ProjectedAt,
+	}
+}
@@ -6,6 +6,11 @@ import (
 	"cloud.google.com/go/spanner"
 )
 
+// MaxMutationsPerCommit is Spanner's limit on mutations in a single commit.
+// Spanner counts every column written, plus every secondary index entry
+// touched, as a mutation, so a plan's Count understates its cost.
+const MaxMutationsPerCommit = 80000
+
 // CommitPlan represents a collection of mutations to be applied atomically.
 type CommitPlan struct {
 	mutations []*spanner.Mutation
@@ -53,6 +58,14 @@ type Committer interface {
 	Apply(ctx context.Context, plan *CommitPlan) error
 }
 
+// TransactionalCommitter applies commit plans built inside a read-write transaction.
+type TransactionalCommitter interface {
+	ApplyWithTransaction(
+		ctx context.Context,
+		fn func(ctx context.Context, txn *spanner.ReadWriteTransaction) (*CommitPlan, error),
+	) error
+}
+
 // SpannerCommitter implements Committer using Spanner client.
 type SpannerCommitter struct {
 	client *spanner.Client
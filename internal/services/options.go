@@ -1,34 +1,107 @@
 package services
 
 import (
+	"time"
+
 	"cloud.google.com/go/spanner"
 
+	"github.com/product-catalog-service/internal/app/product/domain"
+	domainServices "github.com/product-catalog-service/internal/app/product/domain/services"
+	"github.com/product-catalog-service/internal/app/product/projections"
+	"github.com/product-catalog-service/internal/app/product/projections/product_view"
+	"github.com/product-catalog-service/internal/app/product/queries/batch_get_products"
+	"github.com/product-catalog-service/internal/app/product/queries/export_products"
+	"github.com/product-catalog-service/internal/app/product/queries/get_bulk_operation"
+	"github.com/product-catalog-service/internal/app/product/queries/get_campaign"
+	"github.com/product-catalog-service/internal/app/product/queries/get_category"
 	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product_facets"
+	"github.com/product-catalog-service/internal/app/product/queries/list_campaigns"
+	"github.com/product-catalog-service/internal/app/product/queries/list_categories"
 	"github.com/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/product-catalog-service/internal/app/product/queries/search_products"
 	"github.com/product-catalog-service/internal/app/product/repo"
 	"github.com/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/advance_discount_phase"
 	"github.com/product-catalog-service/internal/app/product/usecases/apply_discount"
 	"github.com/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_campaign"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_category"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_coupon"
 	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
 	"github.com/product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/delete_category"
+	"github.com/product-catalog-service/internal/app/product/usecases/end_campaign"
+	"github.com/product-catalog-service/internal/app/product/usecases/import_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/merge_categories"
+	"github.com/product-catalog-service/internal/app/product/usecases/purge_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/redeem_coupon"
 	"github.com/product-catalog-service/internal/app/product/usecases/remove_discount"
+	"github.com/product-catalog-service/internal/app/product/usecases/rename_category"
+	"github.com/product-catalog-service/internal/app/product/usecases/restore_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/run_bulk_update"
+	"github.com/product-catalog-service/internal/app/product/usecases/run_schedule"
+	"github.com/product-catalog-service/internal/app/product/usecases/schedule_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/start_bulk_update"
+	"github.com/product-catalog-service/internal/app/product/usecases/transition_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/update_category"
 	"github.com/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/validate_coupon"
+	"github.com/product-catalog-service/internal/app/product/workers"
 	"github.com/product-catalog-service/internal/pkg/clock"
 	"github.com/product-catalog-service/internal/pkg/committer"
 	grpcHandler "github.com/product-catalog-service/internal/transport/grpc/product"
 )
 
+// Options configures the container. The zero value gives production defaults.
+type Options struct {
+	// Clock overrides the real clock (for testing).
+	Clock clock.Clock
+
+	// ReadModelSource selects the table backing the query side.
+	// Defaults to the products table.
+	ReadModelSource repo.ReadModelSource
+
+	// RestoreRetention is how long archived products stay restorable.
+	// Defaults to restore_product.DefaultRetention.
+	RestoreRetention time.Duration
+
+	// PurgeRetention is how long archived products are kept before they can
+	// be purged. Defaults to purge_product.DefaultRetention.
+	PurgeRetention time.Duration
+
+	// Lifecycle is the product state-transition table.
+	// Defaults to domain.DefaultLifecycle.
+	Lifecycle *domain.Lifecycle
+
+	// ActivationPolicy lists the rules a product must meet before it is
+	// activated. Defaults to no rules.
+	ActivationPolicy *domain.ActivationPolicy
+
+	// BulkChunkSize is how many products a bulk operation changes per
+	// transaction. Defaults to run_bulk_update.DefaultChunkSize.
+	BulkChunkSize int
+}
+
 // Container holds all service dependencies.
 type Container struct {
 	// Infrastructure
-	SpannerClient *spanner.Client
-	Clock         clock.Clock
-	Committer     committer.Committer
+	SpannerClient    *spanner.Client
+	Clock            clock.Clock
+	Committer        committer.Committer
+	SpannerCommitter *committer.SpannerCommitter
 
 	// Repositories
-	ProductRepo   *repo.ProductRepo
-	OutboxRepo    *repo.OutboxRepo
-	ReadModelRepo *repo.ReadModelRepo
+	ProductRepo       *repo.ProductRepo
+	OutboxRepo        *repo.OutboxRepo
+	OutboxReadRepo    *repo.OutboxReadRepo
+	ReadModelRepo     *repo.ReadModelRepo
+	ProductViewRepo   *repo.ProductViewRepo
+	ProductSearchRepo *repo.ProductSearchRepo
+	BulkOperationRepo *repo.BulkOperationRepo
+	CampaignRepo      *repo.CampaignRepo
+	CouponRepo        *repo.CouponRepo
+	CategoryRepo      *repo.CategoryRepo
 
 	// Commands
 	CreateProductUsecase     *create_product.Interactor
@@ -36,12 +109,49 @@ type Container struct {
 	ActivateProductUsecase   *activate_product.Interactor
 	DeactivateProductUsecase *deactivate_product.Interactor
 	ArchiveProductUsecase    *archive_product.Interactor
+	TransitionProductUsecase *transition_product.Interactor
+	RestoreProductUsecase    *restore_product.Interactor
+	PurgeProductUsecase      *purge_product.Interactor
+	ScheduleProductUsecase   *schedule_product.Interactor
+	RunScheduleUsecase       *run_schedule.Interactor
+	AdvanceDiscountUsecase   *advance_discount_phase.Interactor
 	ApplyDiscountUsecase     *apply_discount.Interactor
 	RemoveDiscountUsecase    *remove_discount.Interactor
+	ImportProductsUsecase    *import_products.Interactor
+	StartBulkUpdateUsecase   *start_bulk_update.Interactor
+	RunBulkUpdateUsecase     *run_bulk_update.Interactor
+	CreateCampaignUsecase    *create_campaign.Interactor
+	EndCampaignUsecase       *end_campaign.Interactor
+	CreateCouponUsecase      *create_coupon.Interactor
+	ValidateCouponUsecase    *validate_coupon.Interactor
+	RedeemCouponUsecase      *redeem_coupon.Interactor
+	CreateCategoryUsecase    *create_category.Interactor
+	UpdateCategoryUsecase    *update_category.Interactor
+	DeleteCategoryUsecase    *delete_category.Interactor
+	RenameCategoryUsecase    *rename_category.Interactor
+	MergeCategoriesUsecase   *merge_categories.Interactor
 
 	// Queries
-	GetProductQuery   *get_product.Query
-	ListProductsQuery *list_products.Query
+	GetProductQuery       *get_product.Query
+	BatchGetProductsQuery *batch_get_products.Query
+	ListProductsQuery     *list_products.Query
+	SearchProductsQuery   *search_products.Query
+	GetProductFacetsQuery *get_product_facets.Query
+	ExportProductsQuery   *export_products.Query
+	GetBulkOperationQuery *get_bulk_operation.Query
+	GetCampaignQuery      *get_campaign.Query
+	ListCampaignsQuery    *list_campaigns.Query
+	GetCategoryQuery      *get_category.Query
+	ListCategoriesQuery   *list_categories.Query
+
+	// Projections
+	ProductViewProjection *product_view.Projection
+	ProjectionDispatcher  *projections.Dispatcher
+
+	// Workers
+	Purger      *workers.Purger
+	Scheduler   *workers.Scheduler
+	BulkUpdater *workers.BulkUpdater
 
 	// gRPC Handler
 	ProductHandler *grpcHandler.Handler
@@ -49,164 +159,329 @@ type Container struct {
 
 // NewContainer creates a new dependency injection container.
 func NewContainer(spannerClient *spanner.Client) *Container {
+	return NewContainerWithOptions(spannerClient, Options{})
+}
+
+// NewContainerWithClock creates a container with a custom clock (for testing).
+func NewContainerWithClock(spannerClient *spanner.Client, clk clock.Clock) *Container {
+	return NewContainerWithOptions(spannerClient, Options{Clock: clk})
+}
+
+// NewContainerWithOptions creates a container configured by opts.
+func NewContainerWithOptions(spannerClient *spanner.Client, opts Options) *Container {
 	c := &Container{
 		SpannerClient: spannerClient,
+		Clock:         opts.Clock,
 	}
 
 	// Initialize clock
-	c.Clock = clock.NewRealClock()
+	if c.Clock == nil {
+		c.Clock = clock.NewRealClock()
+	}
 
 	// Initialize committer
-	c.Committer = committer.NewSpannerCommitter(spannerClient)
+	c.SpannerCommitter = committer.NewSpannerCommitter(spannerClient)
+	c.Committer = c.SpannerCommitter
 
 	// Initialize repositories
 	c.ProductRepo = repo.NewProductRepo(spannerClient)
 	c.OutboxRepo = repo.NewOutboxRepo(c.Clock)
-	c.ReadModelRepo = repo.NewReadModelRepo(spannerClient, c.Clock)
+	c.OutboxReadRepo = repo.NewOutboxReadRepo(spannerClient)
+	c.ReadModelRepo = repo.NewReadModelRepo(spannerClient, c.Clock, opts.ReadModelSource)
+	c.ProductViewRepo = repo.NewProductViewRepo(spannerClient)
+	c.ProductSearchRepo = repo.NewProductSearchRepo(spannerClient, c.Clock)
+	c.BulkOperationRepo = repo.NewBulkOperationRepo(spannerClient, c.Clock)
+	c.CampaignRepo = repo.NewCampaignRepo(spannerClient, c.Clock)
+	c.CouponRepo = repo.NewCouponRepo(spannerClient)
+	c.CategoryRepo = repo.NewCategoryRepo(spannerClient)
 
 	// Initialize usecases
 	c.CreateProductUsecase = create_product.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.CategoryRepo,
+		c.SpannerCommitter,
 		c.Clock,
 	)
 
 	c.UpdateProductUsecase = update_product.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.CategoryRepo,
+		c.SpannerCommitter,
 		c.Clock,
 	)
 
+	lifecycle := opts.Lifecycle
+	if lifecycle == nil {
+		lifecycle = domain.DefaultLifecycle()
+	}
 	c.ActivateProductUsecase = activate_product.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.SpannerCommitter,
 		c.Clock,
+		lifecycle,
+		opts.ActivationPolicy,
 	)
 
 	c.DeactivateProductUsecase = deactivate_product.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.SpannerCommitter,
 		c.Clock,
+		lifecycle,
 	)
 
 	c.ArchiveProductUsecase = archive_product.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.SpannerCommitter,
 		c.Clock,
+		lifecycle,
 	)
 
-	c.ApplyDiscountUsecase = apply_discount.NewInteractor(
+	c.TransitionProductUsecase = transition_product.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.SpannerCommitter,
 		c.Clock,
+		lifecycle,
+		opts.ActivationPolicy,
 	)
 
-	c.RemoveDiscountUsecase = remove_discount.NewInteractor(
+	restoreRetention := opts.RestoreRetention
+	if restoreRetention == 0 {
+		restoreRetention = restore_product.DefaultRetention
+	}
+	c.RestoreProductUsecase = restore_product.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.SpannerCommitter,
 		c.Clock,
+		restoreRetention,
 	)
 
-	// Initialize queries
-	c.GetProductQuery = get_product.NewQuery(c.ReadModelRepo)
-	c.ListProductsQuery = list_products.NewQuery(c.ReadModelRepo)
-
-	// Initialize gRPC handler
-	commands := grpcHandler.Commands{
-		CreateProduct:     c.CreateProductUsecase,
-		UpdateProduct:     c.UpdateProductUsecase,
-		ActivateProduct:   c.ActivateProductUsecase,
-		DeactivateProduct: c.DeactivateProductUsecase,
-		ArchiveProduct:    c.ArchiveProductUsecase,
-		ApplyDiscount:     c.ApplyDiscountUsecase,
-		RemoveDiscount:    c.RemoveDiscountUsecase,
-	}
-
-	queries := grpcHandler.Queries{
-		GetProduct:   c.GetProductQuery,
-		ListProducts: c.ListProductsQuery,
+	purgeRetention := opts.PurgeRetention
+	if purgeRetention == 0 {
+		purgeRetention = purge_product.DefaultRetention
 	}
+	c.PurgeProductUsecase = purge_product.NewInteractor(
+		c.ProductRepo,
+		c.OutboxRepo,
+		c.SpannerCommitter,
+		c.Clock,
+		purgeRetention,
+	)
 
-	c.ProductHandler = grpcHandler.NewHandler(commands, queries)
-
-	return c
-}
-
-// NewContainerWithClock creates a container with a custom clock (for testing).
-func NewContainerWithClock(spannerClient *spanner.Client, clk clock.Clock) *Container {
-	c := &Container{
-		SpannerClient: spannerClient,
-		Clock:         clk,
-	}
+	c.ScheduleProductUsecase = schedule_product.NewInteractor(
+		c.ProductRepo,
+		c.OutboxRepo,
+		c.CampaignRepo,
+		c.SpannerCommitter,
+		c.Clock,
+	)
 
-	// Initialize committer
-	c.Committer = committer.NewSpannerCommitter(spannerClient)
+	c.RunScheduleUsecase = run_schedule.NewInteractor(
+		c.ProductRepo,
+		c.OutboxRepo,
+		c.SpannerCommitter,
+		c.Clock,
+		lifecycle,
+		opts.ActivationPolicy,
+	)
 
-	// Initialize repositories
-	c.ProductRepo = repo.NewProductRepo(spannerClient)
-	c.OutboxRepo = repo.NewOutboxRepo(c.Clock)
-	c.ReadModelRepo = repo.NewReadModelRepo(spannerClient, c.Clock)
+	c.AdvanceDiscountUsecase = advance_discount_phase.NewInteractor(
+		c.ProductRepo,
+		c.OutboxRepo,
+		c.CampaignRepo,
+		c.SpannerCommitter,
+		c.Clock,
+	)
 
-	// Initialize usecases
-	c.CreateProductUsecase = create_product.NewInteractor(
+	c.ApplyDiscountUsecase = apply_discount.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.SpannerCommitter,
 		c.Clock,
 	)
 
-	c.UpdateProductUsecase = update_product.NewInteractor(
+	c.RemoveDiscountUsecase = remove_discount.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.CampaignRepo,
+		c.SpannerCommitter,
 		c.Clock,
 	)
 
-	c.ActivateProductUsecase = activate_product.NewInteractor(
+	c.ImportProductsUsecase = import_products.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
+		c.CategoryRepo,
+		c.SpannerCommitter,
+		c.Clock,
+	)
+
+	c.StartBulkUpdateUsecase = start_bulk_update.NewInteractor(
+		c.BulkOperationRepo,
 		c.Committer,
 		c.Clock,
 	)
 
-	c.DeactivateProductUsecase = deactivate_product.NewInteractor(
+	bulkChunkSize := opts.BulkChunkSize
+	if bulkChunkSize == 0 {
+		bulkChunkSize = run_bulk_update.DefaultChunkSize
+	}
+	c.RunBulkUpdateUsecase = run_bulk_update.NewInteractor(
 		c.ProductRepo,
 		c.OutboxRepo,
+		c.BulkOperationRepo,
+		c.CategoryRepo,
+		c.SpannerCommitter,
+		c.Clock,
+		lifecycle,
+		opts.ActivationPolicy,
+		bulkChunkSize,
+	)
+
+	c.CreateCampaignUsecase = create_campaign.NewInteractor(
+		c.CampaignRepo,
+		c.OutboxRepo,
 		c.Committer,
 		c.Clock,
 	)
 
-	c.ArchiveProductUsecase = archive_product.NewInteractor(
-		c.ProductRepo,
+	c.EndCampaignUsecase = end_campaign.NewInteractor(
+		c.CampaignRepo,
 		c.OutboxRepo,
 		c.Committer,
 		c.Clock,
 	)
 
-	c.ApplyDiscountUsecase = apply_discount.NewInteractor(
+	pricing := domainServices.NewPricingCalculator()
+
+	c.CreateCouponUsecase = create_coupon.NewInteractor(
+		c.CouponRepo,
+		c.OutboxRepo,
+		c.SpannerCommitter,
+		c.Clock,
+	)
+
+	c.ValidateCouponUsecase = validate_coupon.NewInteractor(
+		c.CouponRepo,
+		c.ProductRepo,
+		c.CampaignRepo,
+		pricing,
+		c.Clock,
+	)
+
+	c.RedeemCouponUsecase = redeem_coupon.NewInteractor(
+		c.CouponRepo,
 		c.ProductRepo,
+		c.CampaignRepo,
 		c.OutboxRepo,
-		c.Committer,
+		pricing,
+		c.SpannerCommitter,
 		c.Clock,
 	)
 
-	c.RemoveDiscountUsecase = remove_discount.NewInteractor(
+	c.CreateCategoryUsecase = create_category.NewInteractor(
+		c.CategoryRepo,
+		c.OutboxRepo,
+		c.SpannerCommitter,
+		c.Clock,
+	)
+
+	c.UpdateCategoryUsecase = update_category.NewInteractor(
+		c.CategoryRepo,
+		c.OutboxRepo,
+		c.SpannerCommitter,
+		c.Clock,
+	)
+
+	c.DeleteCategoryUsecase = delete_category.NewInteractor(
+		c.CategoryRepo,
 		c.ProductRepo,
+		c.BulkOperationRepo,
 		c.OutboxRepo,
-		c.Committer,
+		c.SpannerCommitter,
+		c.Clock,
+	)
+
+	c.RenameCategoryUsecase = rename_category.NewInteractor(
+		c.CategoryRepo,
+		c.BulkOperationRepo,
+		c.CampaignRepo,
+		c.CouponRepo,
+		c.OutboxRepo,
+		c.SpannerCommitter,
+		c.Clock,
+	)
+
+	c.MergeCategoriesUsecase = merge_categories.NewInteractor(
+		c.CategoryRepo,
+		c.BulkOperationRepo,
+		c.CampaignRepo,
+		c.CouponRepo,
+		c.OutboxRepo,
+		c.SpannerCommitter,
 		c.Clock,
 	)
 
 	// Initialize queries
 	c.GetProductQuery = get_product.NewQuery(c.ReadModelRepo)
-	c.ListProductsQuery = list_products.NewQuery(c.ReadModelRepo)
+	c.BatchGetProductsQuery = batch_get_products.NewQuery(c.ReadModelRepo)
+	c.ListProductsQuery = list_products.NewQuery(c.ReadModelRepo, c.CategoryRepo)
+	c.SearchProductsQuery = search_products.NewQuery(c.ProductSearchRepo)
+	c.GetProductFacetsQuery = get_product_facets.NewQuery(c.ReadModelRepo, c.CategoryRepo)
+	c.ExportProductsQuery = export_products.NewQuery(c.ReadModelRepo, c.CategoryRepo)
+	c.GetBulkOperationQuery = get_bulk_operation.NewQuery(c.BulkOperationRepo)
+	c.GetCampaignQuery = get_campaign.NewQuery(c.CampaignRepo)
+	c.ListCampaignsQuery = list_campaigns.NewQuery(c.CampaignRepo)
+	c.GetCategoryQuery = get_category.NewQuery(c.CategoryRepo)
+	c.ListCategoriesQuery = list_categories.NewQuery(c.CategoryRepo)
+
+	// Initialize projections
+	c.ProductViewProjection = product_view.NewProjection(
+		c.ProductRepo,
+		c.ProductViewRepo,
+		c.SpannerCommitter,
+		c.Clock,
+	)
+
+	c.ProjectionDispatcher = projections.NewDispatcher(
+		c.OutboxReadRepo,
+		c.SpannerCommitter,
+		c.Clock,
+		c.ProductViewProjection,
+	)
+
+	// Initialize workers
+	c.Purger = workers.NewPurger(
+		c.ProductRepo,
+		c.PurgeProductUsecase,
+		c.Clock,
+	)
+
+	c.Scheduler = workers.NewScheduler(
+		c.ProductRepo,
+		c.RunScheduleUsecase,
+		c.AdvanceDiscountUsecase,
+		c.Clock,
+	)
+
+	c.BulkUpdater = workers.NewBulkUpdater(
+		c.BulkOperationRepo,
+		c.RunBulkUpdateUsecase,
+	)
 
 	// Initialize gRPC handler
 	commands := grpcHandler.Commands{
@@ -215,13 +490,38 @@ func NewContainerWithClock(spannerClient *spanner.Client, clk clock.Clock) *Cont
 		ActivateProduct:   c.ActivateProductUsecase,
 		DeactivateProduct: c.DeactivateProductUsecase,
 		ArchiveProduct:    c.ArchiveProductUsecase,
+		TransitionProduct: c.TransitionProductUsecase,
+		RestoreProduct:    c.RestoreProductUsecase,
+		PurgeProduct:      c.PurgeProductUsecase,
+		ScheduleProduct:   c.ScheduleProductUsecase,
 		ApplyDiscount:     c.ApplyDiscountUsecase,
 		RemoveDiscount:    c.RemoveDiscountUsecase,
+		ImportProducts:    c.ImportProductsUsecase,
+		StartBulkUpdate:   c.StartBulkUpdateUsecase,
+		CreateCampaign:    c.CreateCampaignUsecase,
+		EndCampaign:       c.EndCampaignUsecase,
+		CreateCoupon:      c.CreateCouponUsecase,
+		ValidateCoupon:    c.ValidateCouponUsecase,
+		RedeemCoupon:      c.RedeemCouponUsecase,
+		CreateCategory:    c.CreateCategoryUsecase,
+		UpdateCategory:    c.UpdateCategoryUsecase,
+		DeleteCategory:    c.DeleteCategoryUsecase,
+		RenameCategory:    c.RenameCategoryUsecase,
+		MergeCategories:   c.MergeCategoriesUsecase,
 	}
 
 	queries := grpcHandler.Queries{
-		GetProduct:   c.GetProductQuery,
-		ListProducts: c.ListProductsQuery,
+		GetProduct:       c.GetProductQuery,
+		BatchGetProducts: c.BatchGetProductsQuery,
+		ListProducts:     c.ListProductsQuery,
+		SearchProducts:   c.SearchProductsQuery,
+		GetProductFacets: c.GetProductFacetsQuery,
+		ExportProducts:   c.ExportProductsQuery,
+		GetBulkOperation: c.GetBulkOperationQuery,
+		GetCampaign:      c.GetCampaignQuery,
+		ListCampaigns:    c.ListCampaignsQuery,
+		GetCategory:      c.GetCategoryQuery,
+		ListCategories:   c.ListCategoriesQuery,
 	}
 
 	c.ProductHandler = grpcHandler.NewHandler(commands, queries)
@@ -1,68 +1,273 @@
 package product
 
 import (
+	"context"
 	"errors"
+	"log"
+	"strings"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/product-catalog-service/internal/app/product/contracts"
 	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/queries/batch_get_products"
+	"github.com/product-catalog-service/internal/app/product/queries/export_products"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product_facets"
+	"github.com/product-catalog-service/internal/app/product/queries/list_campaigns"
+	"github.com/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/product-catalog-service/internal/app/product/queries/search_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/import_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/merge_categories"
+	"github.com/product-catalog-service/internal/app/product/usecases/redeem_coupon"
+	"github.com/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/validate_coupon"
 )
 
-// mapDomainErrorToGRPC converts domain errors to gRPC status errors.
-func mapDomainErrorToGRPC(err error) error {
+// ErrorDomain is the google.rpc.ErrorInfo domain of every error this service returns.
+const ErrorDomain = "product-catalog-service"
+
+// errorReason describes how a known error is reported to clients. Reasons
+// are part of the API: clients branch on them, so they must never change.
+type errorReason struct {
+	err    error
+	code   codes.Code
+	reason string
+	// field is the request field at fault, reported as a BadRequest field
+	// violation. Empty when the error is not about a single field.
+	field string
+}
+
+// domainErrors lists the application errors with a stable reason.
+var domainErrors = []errorReason{
+	// Not found errors
+	{domain.ErrProductNotFound, codes.NotFound, "PRODUCT_NOT_FOUND", ""},
+	{domain.ErrBulkOperationNotFound, codes.NotFound, "BULK_OPERATION_NOT_FOUND", ""},
+	{domain.ErrCampaignNotFound, codes.NotFound, "CAMPAIGN_NOT_FOUND", ""},
+	{domain.ErrCouponNotFound, codes.NotFound, "COUPON_NOT_FOUND", "code"},
+	{domain.ErrCategoryNotFound, codes.NotFound, "CATEGORY_NOT_FOUND", "category_id"},
+	{domain.ErrCategoryParentNotFound, codes.NotFound, "CATEGORY_PARENT_NOT_FOUND", "parent_id"},
+
+	// Conflicts (already exists)
+	{domain.ErrCouponCodeTaken, codes.AlreadyExists, "COUPON_CODE_TAKEN", "code"},
+	{domain.ErrCouponAlreadyRedeemed, codes.AlreadyExists, "COUPON_ALREADY_REDEEMED", "order_id"},
+	{domain.ErrCategorySlugTaken, codes.AlreadyExists, "CATEGORY_SLUG_TAKEN", "slug"},
+
+	// Validation errors (invalid argument)
+	{domain.ErrEmptyProductName, codes.InvalidArgument, "EMPTY_PRODUCT_NAME", "name"},
+	{domain.ErrEmptyCategory, codes.InvalidArgument, "EMPTY_CATEGORY", "category"},
+	{domain.ErrInvalidProductStatus, codes.InvalidArgument, "INVALID_PRODUCT_STATUS", "target_status"},
+	{domain.ErrProductNameTooLong, codes.InvalidArgument, "PRODUCT_NAME_TOO_LONG", "name"},
+	{domain.ErrCategoryTooLong, codes.InvalidArgument, "CATEGORY_TOO_LONG", "category"},
+	{domain.ErrExternalKeyTooLong, codes.InvalidArgument, "EXTERNAL_KEY_TOO_LONG", "external_key"},
+	{domain.ErrTooManyAttributes, codes.InvalidArgument, "TOO_MANY_ATTRIBUTES", "attributes"},
+	{domain.ErrInvalidAttributeName, codes.InvalidArgument, "INVALID_ATTRIBUTE_NAME", "attributes"},
+	{domain.ErrAttributeTooLong, codes.InvalidArgument, "ATTRIBUTE_TOO_LONG", "attributes"},
+	{domain.ErrInvalidMoney, codes.InvalidArgument, "INVALID_MONEY", "base_price"},
+	{domain.ErrNegativeMoney, codes.InvalidArgument, "NEGATIVE_MONEY", "base_price"},
+	{domain.ErrZeroPrice, codes.InvalidArgument, "ZERO_PRICE", "base_price"},
+	{domain.ErrInvalidDiscountPercentage, codes.InvalidArgument, "INVALID_DISCOUNT_PERCENTAGE", "percentage"},
+	{domain.ErrInvalidDiscountPeriod, codes.InvalidArgument, "INVALID_DISCOUNT_PERIOD", "end_date"},
+	{domain.ErrInvalidSchedule, codes.InvalidArgument, "INVALID_SCHEDULE", "unpublish_at"},
+	{list_products.ErrInvalidPageToken, codes.InvalidArgument, "INVALID_PAGE_TOKEN", "page_token"},
+	{list_products.ErrInvalidOrderBy, codes.InvalidArgument, "INVALID_ORDER_BY", "order_by"},
+	{contracts.ErrInvalidPriceRange, codes.InvalidArgument, "INVALID_PRICE_RANGE", ""},
+	{contracts.ErrInvalidArchivedMode, codes.InvalidArgument, "INVALID_ARCHIVED_MODE", "archived_mode"},
+	{search_products.ErrEmptySearchQuery, codes.InvalidArgument, "EMPTY_SEARCH_QUERY", "query"},
+	{search_products.ErrSearchQueryTooLong, codes.InvalidArgument, "SEARCH_QUERY_TOO_LONG", "query"},
+	{get_product_facets.ErrInvalidPriceBands, codes.InvalidArgument, "INVALID_PRICE_BANDS", "price_band_boundaries"},
+	{export_products.ErrInvalidParallelism, codes.InvalidArgument, "INVALID_PARALLELISM", "parallelism"},
+	{export_products.ErrUnsupportedFormat, codes.InvalidArgument, "UNSUPPORTED_EXPORT_FORMAT", "format"},
+	{batch_get_products.ErrNoProductIDs, codes.InvalidArgument, "MISSING_PRODUCT_IDS", "product_ids"},
+	{batch_get_products.ErrTooManyProductIDs, codes.InvalidArgument, "TOO_MANY_PRODUCT_IDS", "product_ids"},
+	{update_product.ErrInvalidUpdateMask, codes.InvalidArgument, "INVALID_UPDATE_MASK", "update_mask"},
+	{import_products.ErrUnsupportedFormat, codes.InvalidArgument, "UNSUPPORTED_IMPORT_FORMAT", "format"},
+	{import_products.ErrMissingHeader, codes.InvalidArgument, "MISSING_CSV_HEADER", "chunk"},
+	{import_products.ErrMissingColumn, codes.InvalidArgument, "MISSING_CSV_COLUMN", "chunk"},
+	{import_products.ErrMalformedRow, codes.InvalidArgument, "MALFORMED_ROW", ""},
+	{import_products.ErrInvalidPrice, codes.InvalidArgument, "INVALID_PRICE", "price"},
+	{domain.ErrInvalidBulkCommand, codes.InvalidArgument, "INVALID_BULK_COMMAND", "command"},
+	{domain.ErrEmptyCampaignName, codes.InvalidArgument, "EMPTY_CAMPAIGN_NAME", "name"},
+	{domain.ErrCampaignNameTooLong, codes.InvalidArgument, "CAMPAIGN_NAME_TOO_LONG", "name"},
+	{domain.ErrEmptyCampaignSelection, codes.InvalidArgument, "EMPTY_CAMPAIGN_SELECTION", "product_ids"},
+	{domain.ErrCampaignSelectionTooLarge, codes.InvalidArgument, "CAMPAIGN_SELECTION_TOO_LARGE", ""},
+	{list_campaigns.ErrInvalidCampaignStatus, codes.InvalidArgument, "INVALID_CAMPAIGN_STATUS", "status"},
+	{domain.ErrEmptyCouponCode, codes.InvalidArgument, "EMPTY_COUPON_CODE", "code"},
+	{domain.ErrCouponCodeTooLong, codes.InvalidArgument, "COUPON_CODE_TOO_LONG", "code"},
+	{domain.ErrInvalidCouponCode, codes.InvalidArgument, "INVALID_COUPON_CODE", "code"},
+	{domain.ErrInvalidCouponRule, codes.InvalidArgument, "INVALID_COUPON_RULE", ""},
+	{domain.ErrInvalidCouponPeriod, codes.InvalidArgument, "INVALID_COUPON_PERIOD", "end_date"},
+	{domain.ErrInvalidMaxRedemptions, codes.InvalidArgument, "INVALID_MAX_REDEMPTIONS", "max_redemptions"},
+	{domain.ErrCouponEligibilityTooLarge, codes.InvalidArgument, "COUPON_ELIGIBILITY_TOO_LARGE", ""},
+	{domain.ErrEmptyOrderID, codes.InvalidArgument, "EMPTY_ORDER_ID", "order_id"},
+	{domain.ErrOrderIDTooLong, codes.InvalidArgument, "ORDER_ID_TOO_LONG", "order_id"},
+	{validate_coupon.ErrNoProductIDs, codes.InvalidArgument, "MISSING_PRICED_PRODUCT_IDS", "product_ids"},
+	{validate_coupon.ErrTooManyProductIDs, codes.InvalidArgument, "TOO_MANY_PRICED_PRODUCT_IDS", "product_ids"},
+	{redeem_coupon.ErrNoProductIDs, codes.InvalidArgument, "MISSING_REDEEMED_PRODUCT_IDS", "product_ids"},
+	{redeem_coupon.ErrTooManyProductIDs, codes.InvalidArgument, "TOO_MANY_REDEEMED_PRODUCT_IDS", "product_ids"},
+	{domain.ErrEmptyCategorySlug, codes.InvalidArgument, "EMPTY_CATEGORY_SLUG", "slug"},
+	{domain.ErrCategorySlugTooLong, codes.InvalidArgument, "CATEGORY_SLUG_TOO_LONG", "slug"},
+	{domain.ErrInvalidCategorySlug, codes.InvalidArgument, "INVALID_CATEGORY_SLUG", "slug"},
+	{domain.ErrEmptyCategoryDisplayName, codes.InvalidArgument, "EMPTY_CATEGORY_DISPLAY_NAME", "display_name"},
+	{domain.ErrCategoryDisplayNameTooLong, codes.InvalidArgument, "CATEGORY_DISPLAY_NAME_TOO_LONG", "display_name"},
+	{domain.ErrCategoryMergeIntoSelf, codes.InvalidArgument, "CATEGORY_MERGE_INTO_SELF", "target_category_id"},
+	{merge_categories.ErrNoSources, codes.InvalidArgument, "MISSING_SOURCE_CATEGORY_IDS", "source_category_ids"},
+	{merge_categories.ErrTooManySources, codes.InvalidArgument, "TOO_MANY_SOURCE_CATEGORY_IDS", "source_category_ids"},
+
+	// Business rule violations (failed precondition)
+	{domain.ErrProductNotActive, codes.FailedPrecondition, "PRODUCT_NOT_ACTIVE", ""},
+	{domain.ErrProductAlreadyActive, codes.FailedPrecondition, "PRODUCT_ALREADY_ACTIVE", ""},
+	{domain.ErrProductArchived, codes.FailedPrecondition, "PRODUCT_ARCHIVED", ""},
+	{domain.ErrProductInactive, codes.FailedPrecondition, "PRODUCT_INACTIVE", ""},
+	{domain.ErrDiscountNotActive, codes.FailedPrecondition, "DISCOUNT_NOT_ACTIVE", ""},
+	{domain.ErrDiscountAlreadyExists, codes.FailedPrecondition, "DISCOUNT_ALREADY_EXISTS", ""},
+	{domain.ErrNoDiscountToRemove, codes.FailedPrecondition, "NO_DISCOUNT_TO_REMOVE", ""},
+	{domain.ErrDiscountExpired, codes.FailedPrecondition, "DISCOUNT_EXPIRED", ""},
+	{domain.ErrDiscountNotStarted, codes.FailedPrecondition, "DISCOUNT_NOT_STARTED", ""},
+	{domain.ErrCannotActivateArchived, codes.FailedPrecondition, "PRODUCT_ARCHIVED", ""},
+	{domain.ErrCannotDeactivateArchived, codes.FailedPrecondition, "PRODUCT_ARCHIVED", ""},
+	{domain.ErrCannotArchiveActive, codes.FailedPrecondition, "PRODUCT_ACTIVE", ""},
+	{domain.ErrCannotUpdateArchived, codes.FailedPrecondition, "PRODUCT_ARCHIVED", ""},
+	{domain.ErrProductNotArchived, codes.FailedPrecondition, "PRODUCT_NOT_ARCHIVED", ""},
+	{domain.ErrRestoreWindowExpired, codes.FailedPrecondition, "RESTORE_WINDOW_EXPIRED", ""},
+	{domain.ErrPurgeRetentionNotElapsed, codes.FailedPrecondition, "PURGE_RETENTION_NOT_ELAPSED", ""},
+	{domain.ErrCannotScheduleArchived, codes.FailedPrecondition, "PRODUCT_ARCHIVED", ""},
+	{domain.ErrInvalidStatusTransition, codes.FailedPrecondition, "STATUS_TRANSITION_NOT_ALLOWED", ""},
+	{domain.ErrProductAlreadyInStatus, codes.FailedPrecondition, "PRODUCT_ALREADY_IN_STATUS", ""},
+	{domain.ErrCampaignAlreadyEnded, codes.FailedPrecondition, "CAMPAIGN_ALREADY_ENDED", ""},
+	{domain.ErrCouponNotStarted, codes.FailedPrecondition, "COUPON_NOT_STARTED", ""},
+	{domain.ErrCouponExpired, codes.FailedPrecondition, "COUPON_EXPIRED", ""},
+	{domain.ErrCouponExhausted, codes.FailedPrecondition, "COUPON_EXHAUSTED", ""},
+	{domain.ErrCouponNotApplicable, codes.FailedPrecondition, "COUPON_NOT_APPLICABLE", "product_ids"},
+	{domain.ErrCategoryCycle, codes.FailedPrecondition, "CATEGORY_CYCLE", "parent_id"},
+	{domain.ErrCategoryHasChildren, codes.FailedPrecondition, "CATEGORY_HAS_CHILDREN", ""},
+	{domain.ErrCategoryInUse, codes.FailedPrecondition, "CATEGORY_IN_USE", ""},
+	{domain.ErrCategoryMergeIntoChild, codes.FailedPrecondition, "CATEGORY_MERGE_INTO_DESCENDANT", "target_category_id"},
+	{domain.ErrCategoryMoveInProgress, codes.FailedPrecondition, "CATEGORY_MOVE_IN_PROGRESS", ""},
+	{domain.ErrCategoryInPromotion, codes.FailedPrecondition, "CATEGORY_IN_PROMOTION", ""},
+	// Live policy failures are reported with their violations by
+	// activationPolicyStatus; this entry names the ones reported later,
+	// such as a product a bulk operation could not activate.
+	{domain.ErrActivationPolicyViolated, codes.FailedPrecondition, "ACTIVATION_POLICY_VIOLATED", ""},
+
+	// Concurrency conflicts (aborted)
+	{domain.ErrVersionMismatch, codes.Aborted, "VERSION_MISMATCH", "expected_version"},
+}
+
+// mapDomainErrorToGRPC converts domain errors to gRPC status errors with
+// ErrorInfo, BadRequest (for field errors) and RequestInfo details. Unknown
+// errors are logged with the request ID and reported as INTERNAL.
+func mapDomainErrorToGRPC(ctx context.Context, err error) error {
 	if err == nil {
 		return nil
 	}
 
-	// Not found errors
-	if errors.Is(err, domain.ErrProductNotFound) {
-		return status.Error(codes.NotFound, err.Error())
+	requestID := requestIDFromContext(ctx)
+
+	// Activation readiness failures carry one detail entry per violation
+	var policyErr *domain.ActivationPolicyError
+	if errors.As(err, &policyErr) {
+		return activationPolicyStatus(requestID, policyErr)
 	}
 
-	// Validation errors (invalid argument)
-	validationErrors := []error{
-		domain.ErrEmptyProductName,
-		domain.ErrEmptyCategory,
-		domain.ErrInvalidProductStatus,
-		domain.ErrProductNameTooLong,
-		domain.ErrCategoryTooLong,
-		domain.ErrInvalidMoney,
-		domain.ErrNegativeMoney,
-		domain.ErrZeroPrice,
-		domain.ErrInvalidDiscountPercentage,
-		domain.ErrInvalidDiscountPeriod,
+	if known, ok := lookupDomainError(err); ok {
+		return newStatusError(requestID, known.code, err.Error(), known.reason, known.field)
+	}
+
+	// Default to internal error
+	log.Printf("grpc: internal error [request_id=%s]: %v", requestID, err)
+	return newStatusError(requestID, codes.Internal, "internal server error", "INTERNAL", "")
+}
+
+// lookupDomainError finds the entry of domainErrors matching err.
+func lookupDomainError(err error) (errorReason, bool) {
+	for _, known := range domainErrors {
+		if errors.Is(err, known.err) {
+			return known, true
+		}
 	}
+	return errorReason{}, false
+}
 
-	for _, validationErr := range validationErrors {
-		if errors.Is(err, validationErr) {
-			return status.Error(codes.InvalidArgument, err.Error())
+// lookupDomainErrorMessage finds the entry of domainErrors whose error has
+// the given message, for errors that were stored as text. Messages that
+// extend a known error, as ActivationPolicyError does, match it too.
+func lookupDomainErrorMessage(message string) (errorReason, bool) {
+	for _, known := range domainErrors {
+		text := known.err.Error()
+		if message == text || strings.HasPrefix(message, text+": ") {
+			return known, true
 		}
 	}
+	return errorReason{}, false
+}
 
-	// Business rule violations (failed precondition)
-	businessErrors := []error{
-		domain.ErrProductNotActive,
-		domain.ErrProductAlreadyActive,
-		domain.ErrProductArchived,
-		domain.ErrProductInactive,
-		domain.ErrDiscountNotActive,
-		domain.ErrDiscountAlreadyExists,
-		domain.ErrNoDiscountToRemove,
-		domain.ErrDiscountExpired,
-		domain.ErrDiscountNotStarted,
-		domain.ErrCannotActivateArchived,
-		domain.ErrCannotDeactivateArchived,
-		domain.ErrCannotArchiveActive,
-		domain.ErrCannotUpdateArchived,
+// invalidRequestError reports a failed request validation as INVALID_ARGUMENT.
+func invalidRequestError(ctx context.Context, err error) error {
+	reason, field := requestErrorReason(err)
+	return newStatusError(requestIDFromContext(ctx), codes.InvalidArgument, err.Error(), reason, field)
+}
+
+// newStatusError builds a status error with the standard details.
+func newStatusError(requestID string, code codes.Code, message, reason, field string) error {
+	info := &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: ErrorDomain,
+	}
+	if field != "" {
+		info.Metadata = map[string]string{"field": field}
 	}
 
-	for _, businessErr := range businessErrors {
-		if errors.Is(err, businessErr) {
-			return status.Error(codes.FailedPrecondition, err.Error())
+	st := status.New(code, message)
+	if detailed, err := st.WithDetails(info); err == nil {
+		st = detailed
+	}
+	if field != "" {
+		violation := &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       field,
+				Description: message,
+			}},
+		}
+		if detailed, err := st.WithDetails(violation); err == nil {
+			st = detailed
 		}
 	}
+	if detailed, err := st.WithDetails(&errdetails.RequestInfo{RequestId: requestID}); err == nil {
+		st = detailed
+	}
 
-	// Default to internal error
-	return status.Error(codes.Internal, "internal server error")
+	return st.Err()
+}
+
+// activationPolicyStatus reports activation policy violations as
+// FAILED_PRECONDITION with PreconditionFailure and BadRequest details, so
+// clients can both explain the failure and highlight the offending fields.
+func activationPolicyStatus(requestID string, policyErr *domain.ActivationPolicyError) error {
+	preconditions := &errdetails.PreconditionFailure{}
+	fields := &errdetails.BadRequest{}
+	for _, v := range policyErr.Violations {
+		preconditions.Violations = append(preconditions.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        v.Rule,
+			Subject:     v.Field,
+			Description: v.Description,
+		})
+		fields.FieldViolations = append(fields.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason: "ACTIVATION_POLICY_VIOLATED",
+		Domain: ErrorDomain,
+	}
+
+	st, err := status.New(codes.FailedPrecondition, policyErr.Error()).
+		WithDetails(info, preconditions, fields, &errdetails.RequestInfo{RequestId: requestID})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, policyErr.Error())
+	}
+	return st.Err()
 }
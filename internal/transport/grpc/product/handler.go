@@ -1,20 +1,47 @@
 package product
 
 import (
+	"bytes"
 	"context"
+	"io"
 
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/product-catalog-service/internal/app/product/queries/batch_get_products"
+	"github.com/product-catalog-service/internal/app/product/queries/export_products"
+	"github.com/product-catalog-service/internal/app/product/queries/get_bulk_operation"
+	"github.com/product-catalog-service/internal/app/product/queries/get_campaign"
+	"github.com/product-catalog-service/internal/app/product/queries/get_category"
 	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product_facets"
+	"github.com/product-catalog-service/internal/app/product/queries/list_campaigns"
+	"github.com/product-catalog-service/internal/app/product/queries/list_categories"
 	"github.com/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/product-catalog-service/internal/app/product/queries/search_products"
 	"github.com/product-catalog-service/internal/app/product/usecases/activate_product"
 	"github.com/product-catalog-service/internal/app/product/usecases/apply_discount"
 	"github.com/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_campaign"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_category"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_coupon"
 	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
 	"github.com/product-catalog-service/internal/app/product/usecases/deactivate_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/delete_category"
+	"github.com/product-catalog-service/internal/app/product/usecases/end_campaign"
+	"github.com/product-catalog-service/internal/app/product/usecases/import_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/merge_categories"
+	"github.com/product-catalog-service/internal/app/product/usecases/purge_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/redeem_coupon"
 	"github.com/product-catalog-service/internal/app/product/usecases/remove_discount"
+	"github.com/product-catalog-service/internal/app/product/usecases/rename_category"
+	"github.com/product-catalog-service/internal/app/product/usecases/restore_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/schedule_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/start_bulk_update"
+	"github.com/product-catalog-service/internal/app/product/usecases/transition_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/update_category"
 	"github.com/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/validate_coupon"
 	pb "github.com/product-catalog-service/proto/product/v1"
 )
 
@@ -25,14 +52,39 @@ type Commands struct {
 	ActivateProduct   *activate_product.Interactor
 	DeactivateProduct *deactivate_product.Interactor
 	ArchiveProduct    *archive_product.Interactor
+	TransitionProduct *transition_product.Interactor
+	RestoreProduct    *restore_product.Interactor
+	PurgeProduct      *purge_product.Interactor
+	ScheduleProduct   *schedule_product.Interactor
 	ApplyDiscount     *apply_discount.Interactor
 	RemoveDiscount    *remove_discount.Interactor
+	ImportProducts    *import_products.Interactor
+	StartBulkUpdate   *start_bulk_update.Interactor
+	CreateCampaign    *create_campaign.Interactor
+	EndCampaign       *end_campaign.Interactor
+	CreateCoupon      *create_coupon.Interactor
+	ValidateCoupon    *validate_coupon.Interactor
+	RedeemCoupon      *redeem_coupon.Interactor
+	CreateCategory    *create_category.Interactor
+	UpdateCategory    *update_category.Interactor
+	DeleteCategory    *delete_category.Interactor
+	RenameCategory    *rename_category.Interactor
+	MergeCategories   *merge_categories.Interactor
 }
 
 // Queries holds all query handlers.
 type Queries struct {
-	GetProduct   *get_product.Query
-	ListProducts *list_products.Query
+	GetProduct       *get_product.Query
+	BatchGetProducts *batch_get_products.Query
+	ListProducts     *list_products.Query
+	SearchProducts   *search_products.Query
+	GetProductFacets *get_product_facets.Query
+	ExportProducts   *export_products.Query
+	GetBulkOperation *get_bulk_operation.Query
+	GetCampaign      *get_campaign.Query
+	ListCampaigns    *list_campaigns.Query
+	GetCategory      *get_category.Query
+	ListCategories   *list_categories.Query
 }
 
 // Handler implements the ProductServiceServer interface.
@@ -54,133 +106,513 @@ func NewHandler(commands Commands, queries Queries) *Handler {
 func (h *Handler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductReply, error) {
 	// 1. Validate proto request
 	if err := validateCreateRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	// 2. Map proto to application request
 	appReq := mapToCreateProductRequest(req)
 
 	// 3. Call usecase
-	productID, err := h.commands.CreateProduct.Execute(ctx, appReq)
+	result, err := h.commands.CreateProduct.Execute(ctx, appReq)
 	if err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+		return nil, mapDomainErrorToGRPC(ctx, err)
 	}
 
 	// 4. Return response
 	return &pb.CreateProductReply{
-		ProductId: productID,
+		ProductId: result.Product.ID,
+		Product:   mapCommandResultToProto(result.Product),
+		Events:    mapEventsToProto(result.Events),
 	}, nil
 }
 
 // UpdateProduct updates an existing product.
 func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductReply, error) {
 	if err := validateUpdateRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	appReq := mapToUpdateProductRequest(req)
 
-	if err := h.commands.UpdateProduct.Execute(ctx, appReq); err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+	result, err := h.commands.UpdateProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
 	}
 
-	return &pb.UpdateProductReply{}, nil
+	return &pb.UpdateProductReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
 }
 
 // ActivateProduct activates a product.
 func (h *Handler) ActivateProduct(ctx context.Context, req *pb.ActivateProductRequest) (*pb.ActivateProductReply, error) {
 	if err := validateActivateRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	appReq := activate_product.Request{
-		ProductID: req.GetProductId(),
+		ProductID:       req.GetProductId(),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
 	}
 
-	if err := h.commands.ActivateProduct.Execute(ctx, appReq); err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+	result, err := h.commands.ActivateProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
 	}
 
-	return &pb.ActivateProductReply{}, nil
+	return &pb.ActivateProductReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
 }
 
 // DeactivateProduct deactivates a product.
 func (h *Handler) DeactivateProduct(ctx context.Context, req *pb.DeactivateProductRequest) (*pb.DeactivateProductReply, error) {
 	if err := validateDeactivateRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	appReq := deactivate_product.Request{
-		ProductID: req.GetProductId(),
+		ProductID:       req.GetProductId(),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
 	}
 
-	if err := h.commands.DeactivateProduct.Execute(ctx, appReq); err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+	result, err := h.commands.DeactivateProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
 	}
 
-	return &pb.DeactivateProductReply{}, nil
+	return &pb.DeactivateProductReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
 }
 
 // ArchiveProduct archives (soft deletes) a product.
 func (h *Handler) ArchiveProduct(ctx context.Context, req *pb.ArchiveProductRequest) (*pb.ArchiveProductReply, error) {
 	if err := validateArchiveRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	appReq := archive_product.Request{
-		ProductID: req.GetProductId(),
+		ProductID:       req.GetProductId(),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+
+	result, err := h.commands.ArchiveProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.ArchiveProductReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
+}
+
+// TransitionProduct moves a product to any status the lifecycle allows.
+// ActivateProduct, DeactivateProduct and ArchiveProduct are shortcuts for it.
+func (h *Handler) TransitionProduct(ctx context.Context, req *pb.TransitionProductRequest) (*pb.TransitionProductReply, error) {
+	if err := validateTransitionRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := transition_product.Request{
+		ProductID:       req.GetProductId(),
+		TargetStatus:    req.GetTargetStatus(),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
 	}
 
-	if err := h.commands.ArchiveProduct.Execute(ctx, appReq); err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+	result, err := h.commands.TransitionProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.TransitionProductReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
+}
+
+// RestoreProduct moves an archived product back to inactive.
+func (h *Handler) RestoreProduct(ctx context.Context, req *pb.RestoreProductRequest) (*pb.RestoreProductReply, error) {
+	if err := validateRestoreRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := restore_product.Request{
+		ProductID:       req.GetProductId(),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
 	}
 
-	return &pb.ArchiveProductReply{}, nil
+	result, err := h.commands.RestoreProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.RestoreProductReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
+}
+
+// PurgeProduct permanently deletes an archived product once its purge
+// retention period has elapsed.
+func (h *Handler) PurgeProduct(ctx context.Context, req *pb.PurgeProductRequest) (*pb.PurgeProductReply, error) {
+	if err := validatePurgeRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := purge_product.Request{
+		ProductID:    req.GetProductId(),
+		ValidateOnly: req.GetValidateOnly(),
+	}
+
+	result, err := h.commands.PurgeProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.PurgeProductReply{Events: mapEventsToProto(result.Events)}, nil
+}
+
+// ScheduleProduct sets when a product is activated and deactivated automatically.
+func (h *Handler) ScheduleProduct(ctx context.Context, req *pb.ScheduleProductRequest) (*pb.ScheduleProductReply, error) {
+	if err := validateScheduleRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := mapToScheduleProductRequest(req)
+
+	result, err := h.commands.ScheduleProduct.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.ScheduleProductReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
 }
 
 // ApplyDiscount applies a discount to a product.
 func (h *Handler) ApplyDiscount(ctx context.Context, req *pb.ApplyDiscountRequest) (*pb.ApplyDiscountReply, error) {
 	if err := validateApplyDiscountRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	appReq := mapToApplyDiscountRequest(req)
 
-	if err := h.commands.ApplyDiscount.Execute(ctx, appReq); err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+	result, err := h.commands.ApplyDiscount.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
 	}
 
-	return &pb.ApplyDiscountReply{}, nil
+	return &pb.ApplyDiscountReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
 }
 
 // RemoveDiscount removes a discount from a product.
 func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountReply, error) {
 	if err := validateRemoveDiscountRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	appReq := remove_discount.Request{
-		ProductID: req.GetProductId(),
+		ProductID:       req.GetProductId(),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+
+	result, err := h.commands.RemoveDiscount.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.RemoveDiscountReply{
+		Product: mapCommandResultToProto(result.Product),
+		Events:  mapEventsToProto(result.Events),
+	}, nil
+}
+
+// ImportProducts creates or updates products from a streamed CSV or NDJSON
+// source and reports the counts and the outcome of its rows.
+func (h *Handler) ImportProducts(stream pb.ProductService_ImportProductsServer) error {
+	ctx := stream.Context()
+
+	// 1. The first message carries the import options
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return invalidRequestError(ctx, ErrMissingImportFormat)
+	}
+	if err != nil {
+		return err
+	}
+	if err := validateImportRequest(first); err != nil {
+		return invalidRequestError(ctx, err)
+	}
+
+	// 2. Map proto to application request, reading the rest of the stream as the source
+	appReq := mapToImportProductsRequest(first, newImportStreamReader(stream, first.GetChunk()))
+
+	// 3. Call usecase
+	report, err := h.commands.ImportProducts.Execute(ctx, appReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return mapDomainErrorToGRPC(ctx, err)
+	}
+
+	// 4. Return response
+	return stream.SendAndClose(mapImportReportToProto(ctx, report))
+}
+
+// BulkUpdate starts a bulk operation running a command on every product
+// matching the filters. It returns as soon as the operation is recorded;
+// the products are changed in the background.
+func (h *Handler) BulkUpdate(ctx context.Context, req *pb.BulkUpdateRequest) (*pb.BulkUpdateReply, error) {
+	if err := validateBulkUpdateRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := mapToStartBulkUpdateRequest(req)
+
+	result, err := h.commands.StartBulkUpdate.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.BulkUpdateReply{
+		Operation: mapBulkUpdateResultToProto(result),
+	}, nil
+}
+
+// CreateCampaign creates a promotion campaign over a selection of products.
+func (h *Handler) CreateCampaign(ctx context.Context, req *pb.CreateCampaignRequest) (*pb.CreateCampaignReply, error) {
+	if err := validateCreateCampaignRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
 	}
 
-	if err := h.commands.RemoveDiscount.Execute(ctx, appReq); err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+	appReq := mapToCreateCampaignRequest(req)
+
+	result, err := h.commands.CreateCampaign.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.CreateCampaignReply{
+		Campaign: mapCampaignResultToProto(result.Campaign),
+		Events:   mapEventsToProto(result.Events),
+	}, nil
+}
+
+// EndCampaign ends a campaign before its scheduled end.
+func (h *Handler) EndCampaign(ctx context.Context, req *pb.EndCampaignRequest) (*pb.EndCampaignReply, error) {
+	if err := validateEndCampaignRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := mapToEndCampaignRequest(req)
+
+	result, err := h.commands.EndCampaign.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
 	}
 
-	return &pb.RemoveDiscountReply{}, nil
+	return &pb.EndCampaignReply{
+		Campaign: mapCampaignResultToProto(result.Campaign),
+		Events:   mapEventsToProto(result.Events),
+	}, nil
+}
+
+// CreateCoupon creates a coupon code.
+func (h *Handler) CreateCoupon(ctx context.Context, req *pb.CreateCouponRequest) (*pb.CreateCouponReply, error) {
+	if err := validateCreateCouponRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := mapToCreateCouponRequest(req)
+
+	result, err := h.commands.CreateCoupon.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.CreateCouponReply{
+		Coupon: mapCouponResultToProto(result.Coupon),
+		Events: mapEventsToProto(result.Events),
+	}, nil
+}
+
+// ValidateCoupon prices products with a coupon without redeeming it.
+func (h *Handler) ValidateCoupon(ctx context.Context, req *pb.ValidateCouponRequest) (*pb.ValidateCouponReply, error) {
+	if err := validateValidateCouponRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := mapToValidateCouponRequest(req)
+
+	result, err := h.commands.ValidateCoupon.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.ValidateCouponReply{
+		Coupon: mapCouponResultToProto(result.Coupon),
+		Lines:  mapCouponLinesToProto(result.Lines),
+	}, nil
+}
+
+// RedeemCoupon redeems a coupon for an order.
+func (h *Handler) RedeemCoupon(ctx context.Context, req *pb.RedeemCouponRequest) (*pb.RedeemCouponReply, error) {
+	if err := validateRedeemCouponRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := mapToRedeemCouponRequest(req)
+
+	result, err := h.commands.RedeemCoupon.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.RedeemCouponReply{
+		Coupon: mapCouponResultToProto(result.Coupon),
+		Lines:  mapCouponLinesToProto(result.Lines),
+		Events: mapEventsToProto(result.Events),
+	}, nil
+}
+
+// CreateCategory creates a node of the category tree.
+func (h *Handler) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryReply, error) {
+	if err := validateCreateCategoryRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := mapToCreateCategoryRequest(req)
+
+	result, err := h.commands.CreateCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.CreateCategoryReply{
+		Category: mapCategoryResultToProto(result.Category),
+		Events:   mapEventsToProto(result.Events),
+	}, nil
+}
+
+// UpdateCategory changes a category's slug or moves it under another parent.
+func (h *Handler) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryReply, error) {
+	if err := validateUpdateCategoryRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := mapToUpdateCategoryRequest(req)
+
+	result, err := h.commands.UpdateCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.UpdateCategoryReply{
+		Category: mapCategoryResultToProto(result.Category),
+		Events:   mapEventsToProto(result.Events),
+	}, nil
+}
+
+// DeleteCategory deletes a leaf category no product is assigned to.
+func (h *Handler) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryReply, error) {
+	if err := validateDeleteCategoryRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := delete_category.Request{
+		CategoryID:   req.GetCategoryId(),
+		ValidateOnly: req.GetValidateOnly(),
+	}
+
+	result, err := h.commands.DeleteCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.DeleteCategoryReply{
+		Category: mapCategoryResultToProto(result.Category),
+		Events:   mapEventsToProto(result.Events),
+	}, nil
+}
+
+// RenameCategory changes a category's display name and starts rewriting the
+// products assigned to it.
+func (h *Handler) RenameCategory(ctx context.Context, req *pb.RenameCategoryRequest) (*pb.RenameCategoryReply, error) {
+	if err := validateRenameCategoryRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := rename_category.Request{
+		CategoryID:   req.GetCategoryId(),
+		DisplayName:  req.GetDisplayName(),
+		ValidateOnly: req.GetValidateOnly(),
+	}
+
+	result, err := h.commands.RenameCategory.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.RenameCategoryReply{
+		Category:  mapCategoryResultToProto(result.Category),
+		Operation: mapCategoryOperationToProto(result.Operation),
+		Events:    mapEventsToProto(result.Events),
+	}, nil
+}
+
+// MergeCategories merges categories into a target and starts moving their
+// products to it.
+func (h *Handler) MergeCategories(ctx context.Context, req *pb.MergeCategoriesRequest) (*pb.MergeCategoriesReply, error) {
+	if err := validateMergeCategoriesRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	appReq := merge_categories.Request{
+		SourceCategoryIDs: req.GetSourceCategoryIds(),
+		TargetCategoryID:  req.GetTargetCategoryId(),
+		ValidateOnly:      req.GetValidateOnly(),
+	}
+
+	result, err := h.commands.MergeCategories.Execute(ctx, appReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.MergeCategoriesReply{
+		Category:  mapCategoryResultToProto(result.Category),
+		Operation: mapCategoryOperationToProto(result.Operation),
+		Events:    mapEventsToProto(result.Events),
+	}, nil
 }
 
 // GetProduct retrieves a product by ID.
 func (h *Handler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductReply, error) {
 	if err := validateGetProductRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	queryReq := mapToGetProductRequest(req)
 
 	product, err := h.queries.GetProduct.Execute(ctx, queryReq)
 	if err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+		return nil, mapDomainErrorToGRPC(ctx, err)
 	}
 
 	return &pb.GetProductReply{
@@ -188,18 +620,237 @@ func (h *Handler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*p
 	}, nil
 }
 
+// BatchGetProducts retrieves several products by ID in one read.
+func (h *Handler) BatchGetProducts(ctx context.Context, req *pb.BatchGetProductsRequest) (*pb.BatchGetProductsReply, error) {
+	if err := validateBatchGetProductsRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	queryReq := batch_get_products.Request{
+		ProductIDs: req.GetProductIds(),
+	}
+
+	result, err := h.queries.BatchGetProducts.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	products := make([]*pb.Product, len(result.Products))
+	for i, p := range result.Products {
+		products[i] = mapProductDTOToProto(p)
+	}
+
+	return &pb.BatchGetProductsReply{
+		Products:   products,
+		MissingIds: result.MissingIDs,
+	}, nil
+}
+
 // ListProducts retrieves a paginated list of products.
 func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsReply, error) {
 	if err := validateListProductsRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidRequestError(ctx, err)
 	}
 
 	queryReq := mapToListProductsRequest(req)
 
 	result, err := h.queries.ListProducts.Execute(ctx, queryReq)
 	if err != nil {
-		return nil, mapDomainErrorToGRPC(err)
+		return nil, mapDomainErrorToGRPC(ctx, err)
 	}
 
 	return mapListResultToProto(result), nil
 }
+
+// SearchProducts finds products by keyword, most relevant first.
+func (h *Handler) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsReply, error) {
+	if err := validateSearchProductsRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	queryReq := mapToSearchProductsRequest(req)
+
+	result, err := h.queries.SearchProducts.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return mapSearchResultToProto(result), nil
+}
+
+// GetProductFacets returns facet counts for the products matching the filters.
+func (h *Handler) GetProductFacets(ctx context.Context, req *pb.GetProductFacetsRequest) (*pb.GetProductFacetsReply, error) {
+	if err := validateGetProductFacetsRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	queryReq := mapToGetProductFacetsRequest(req)
+
+	result, err := h.queries.GetProductFacets.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return mapFacetsToProto(result), nil
+}
+
+// ExportProducts streams every product matching the filters, read at a single
+// timestamp, as Product messages or as CSV or NDJSON chunks. At least one
+// message is sent, so the read timestamp is known even for an empty export.
+func (h *Handler) ExportProducts(req *pb.ExportProductsRequest, stream pb.ProductService_ExportProductsServer) error {
+	ctx := stream.Context()
+
+	if err := validateExportProductsRequest(req); err != nil {
+		return invalidRequestError(ctx, err)
+	}
+
+	queryReq := mapToExportProductsRequest(req)
+
+	// Encoded formats reuse one buffer; each batch becomes one chunk
+	var (
+		buf     bytes.Buffer
+		encoder export_products.Encoder
+	)
+	if format, ok := exportFormats[req.GetFormat()]; ok {
+		var err error
+		if encoder, err = export_products.NewEncoder(&buf, format); err != nil {
+			return mapDomainErrorToGRPC(ctx, err)
+		}
+	}
+
+	var sent bool
+	var sendErr error
+	result, err := h.queries.ExportProducts.Execute(ctx, queryReq, func(batch *export_products.BatchDTO) error {
+		reply := &pb.ExportProductsReply{ReadTimestamp: timestamppb.New(batch.ReadTimestamp)}
+		if encoder == nil {
+			reply.Products = make([]*pb.Product, len(batch.Products))
+			for i, product := range batch.Products {
+				reply.Products[i] = mapExportProductDTOToProto(product)
+			}
+		} else {
+			buf.Reset()
+			if err := encoder.Encode(batch.Products); err != nil {
+				return err
+			}
+			reply.Chunk = bytes.Clone(buf.Bytes())
+		}
+
+		sent = true
+		sendErr = stream.Send(reply)
+		return sendErr
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if sendErr != nil {
+			return sendErr
+		}
+		return mapDomainErrorToGRPC(ctx, err)
+	}
+
+	// Whatever the encoder still holds, such as the header of an empty CSV export
+	buf.Reset()
+	if encoder != nil {
+		if err := encoder.Flush(); err != nil {
+			return mapDomainErrorToGRPC(ctx, err)
+		}
+	}
+	if sent && buf.Len() == 0 {
+		return nil
+	}
+	return stream.Send(&pb.ExportProductsReply{
+		Chunk:         buf.Bytes(),
+		ReadTimestamp: timestamppb.New(result.ReadTimestamp),
+	})
+}
+
+// GetBulkOperation retrieves the progress and failures of a bulk operation.
+func (h *Handler) GetBulkOperation(ctx context.Context, req *pb.GetBulkOperationRequest) (*pb.GetBulkOperationReply, error) {
+	if err := validateGetBulkOperationRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	queryReq := mapToGetBulkOperationRequest(req)
+
+	result, err := h.queries.GetBulkOperation.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.GetBulkOperationReply{
+		Operation: mapBulkOperationToProto(ctx, result),
+	}, nil
+}
+
+// GetCampaign retrieves a campaign by ID.
+func (h *Handler) GetCampaign(ctx context.Context, req *pb.GetCampaignRequest) (*pb.GetCampaignReply, error) {
+	if err := validateGetCampaignRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	queryReq := get_campaign.Request{
+		CampaignID: req.GetCampaignId(),
+	}
+
+	result, err := h.queries.GetCampaign.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.GetCampaignReply{
+		Campaign: mapCampaignDTOToProto(result),
+	}, nil
+}
+
+// ListCampaigns retrieves a page of campaigns, newest first.
+func (h *Handler) ListCampaigns(ctx context.Context, req *pb.ListCampaignsRequest) (*pb.ListCampaignsReply, error) {
+	if err := validateListCampaignsRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	queryReq := mapToListCampaignsRequest(req)
+
+	result, err := h.queries.ListCampaigns.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return mapCampaignListToProto(result), nil
+}
+
+// GetCategory retrieves a category by ID.
+func (h *Handler) GetCategory(ctx context.Context, req *pb.GetCategoryRequest) (*pb.GetCategoryReply, error) {
+	if err := validateGetCategoryRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	queryReq := get_category.Request{
+		CategoryID: req.GetCategoryId(),
+	}
+
+	result, err := h.queries.GetCategory.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return &pb.GetCategoryReply{
+		Category: mapCategoryDTOToProto(result),
+	}, nil
+}
+
+// ListCategories retrieves a page of categories, ordered by slug.
+func (h *Handler) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesReply, error) {
+	if err := validateListCategoriesRequest(req); err != nil {
+		return nil, invalidRequestError(ctx, err)
+	}
+
+	queryReq := mapToListCategoriesRequest(req)
+
+	result, err := h.queries.ListCategories.Execute(ctx, queryReq)
+	if err != nil {
+		return nil, mapDomainErrorToGRPC(ctx, err)
+	}
+
+	return mapCategoryListToProto(result), nil
+}
@@ -0,0 +1,32 @@
+package product
+
+import (
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// importStreamReader presents the chunks of an ImportProducts stream as one
+// continuous source, receiving the next message only when the current chunk
+// is used up.
+type importStreamReader struct {
+	stream pb.ProductService_ImportProductsServer
+	chunk  []byte
+}
+
+func newImportStreamReader(stream pb.ProductService_ImportProductsServer, first []byte) *importStreamReader {
+	return &importStreamReader{stream: stream, chunk: first}
+}
+
+// Read implements io.Reader. The end of the client stream is io.EOF.
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
@@ -1,13 +1,40 @@
 package product
 
 import (
+	"context"
+	"io"
+	"log"
+	"math/big"
+
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/queries/export_products"
+	"github.com/product-catalog-service/internal/app/product/queries/get_bulk_operation"
+	"github.com/product-catalog-service/internal/app/product/queries/get_campaign"
+	"github.com/product-catalog-service/internal/app/product/queries/get_category"
 	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product_facets"
+	"github.com/product-catalog-service/internal/app/product/queries/list_campaigns"
+	"github.com/product-catalog-service/internal/app/product/queries/list_categories"
 	"github.com/product-catalog-service/internal/app/product/queries/list_products"
+	"github.com/product-catalog-service/internal/app/product/queries/search_products"
 	"github.com/product-catalog-service/internal/app/product/usecases/apply_discount"
+	"github.com/product-catalog-service/internal/app/product/usecases/command_result"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_campaign"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_category"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_coupon"
 	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/end_campaign"
+	"github.com/product-catalog-service/internal/app/product/usecases/import_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/redeem_coupon"
+	"github.com/product-catalog-service/internal/app/product/usecases/schedule_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/start_bulk_update"
+	"github.com/product-catalog-service/internal/app/product/usecases/update_category"
 	"github.com/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/validate_coupon"
 	pb "github.com/product-catalog-service/proto/product/v1"
 )
 
@@ -26,28 +53,53 @@ func mapToCreateProductRequest(req *pb.CreateProductRequest) create_product.Requ
 		Name:                 req.GetName(),
 		Description:          req.GetDescription(),
 		Category:             req.GetCategory(),
+		CategoryID:           req.GetCategoryId(),
 		BasePriceNumerator:   num,
 		BasePriceDenominator: denom,
+		ValidateOnly:         req.GetValidateOnly(),
 	}
 }
 
 // mapToUpdateProductRequest converts proto request to application request.
 func mapToUpdateProductRequest(req *pb.UpdateProductRequest) update_product.Request {
 	return update_product.Request{
-		ProductID:   req.GetProductId(),
-		Name:        req.GetName(),
-		Description: req.GetDescription(),
-		Category:    req.GetCategory(),
+		ProductID:       req.GetProductId(),
+		Name:            req.GetName(),
+		Description:     req.GetDescription(),
+		Category:        req.GetCategory(),
+		CategoryID:      req.GetCategoryId(),
+		UpdateMask:      req.GetUpdateMask().GetPaths(),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
+		Attributes:      req.GetAttributes(),
+	}
+}
+
+// importFormats maps proto import formats to application formats.
+var importFormats = map[pb.ImportFormat]import_products.Format{
+	pb.ImportFormat_IMPORT_FORMAT_CSV:    import_products.FormatCSV,
+	pb.ImportFormat_IMPORT_FORMAT_NDJSON: import_products.FormatNDJSON,
+}
+
+// mapToImportProductsRequest converts the first message of an import stream
+// to application request, reading the rows from source.
+func mapToImportProductsRequest(first *pb.ImportProductsRequest, source io.Reader) import_products.Request {
+	return import_products.Request{
+		Source:       source,
+		Format:       importFormats[first.GetFormat()],
+		ValidateOnly: first.GetValidateOnly(),
 	}
 }
 
 // mapToApplyDiscountRequest converts proto request to application request.
 func mapToApplyDiscountRequest(req *pb.ApplyDiscountRequest) apply_discount.Request {
 	return apply_discount.Request{
-		ProductID:  req.GetProductId(),
-		Percentage: req.GetPercentage(),
-		StartDate:  pb.TimestampToTime(req.GetStartDate()),
-		EndDate:    pb.TimestampToTime(req.GetEndDate()),
+		ProductID:       req.GetProductId(),
+		Percentage:      req.GetPercentage(),
+		StartDate:       pb.TimestampToTime(req.GetStartDate()),
+		EndDate:         pb.TimestampToTime(req.GetEndDate()),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
 	}
 }
 
@@ -58,6 +110,13 @@ func mapToGetProductRequest(req *pb.GetProductRequest) get_product.Request {
 	}
 }
 
+// mapToGetBulkOperationRequest converts proto request to query request.
+func mapToGetBulkOperationRequest(req *pb.GetBulkOperationRequest) get_bulk_operation.Request {
+	return get_bulk_operation.Request{
+		OperationID: req.GetOperationId(),
+	}
+}
+
 // mapToListProductsRequest converts proto request to query request.
 func mapToListProductsRequest(req *pb.ListProductsRequest) list_products.Request {
 	var category, status *string
@@ -72,7 +131,254 @@ func mapToListProductsRequest(req *pb.ListProductsRequest) list_products.Request
 		status = &st
 	}
 
-	return list_products.Request{
+	// Counting stays on unless the client explicitly opts out
+	skipTotalCount := req.IncludeTotalCount != nil && !req.GetIncludeTotalCount()
+
+	queryReq := list_products.Request{
+		Category:          category,
+		Status:            status,
+		ActiveOnly:        req.GetActiveOnly(),
+		Limit:             int(req.GetLimit()),
+		Offset:            int(req.GetOffset()),
+		PageToken:         req.GetPageToken(),
+		OrderBy:           req.GetOrderBy(),
+		SkipTotalCount:    skipTotalCount,
+		ArchivedMode:      mapArchivedMode(req.GetArchivedMode()),
+		MinBasePrice:      moneyToRat(req.GetMinBasePrice()),
+		MaxBasePrice:      moneyToRat(req.GetMaxBasePrice()),
+		MinEffectivePrice: moneyToRat(req.GetMinEffectivePrice()),
+		MaxEffectivePrice: moneyToRat(req.GetMaxEffectivePrice()),
+	}
+
+	if req.GetOnSaleAt() != nil {
+		onSaleAt := pb.TimestampToTime(req.GetOnSaleAt())
+		queryReq.OnSaleAt = &onSaleAt
+	}
+
+	if req.MinDiscountPercent != nil {
+		minDiscount := req.GetMinDiscountPercent()
+		queryReq.MinDiscountPercent = &minDiscount
+	}
+
+	if req.CampaignId != nil {
+		campaignID := req.GetCampaignId()
+		queryReq.CampaignID = &campaignID
+	}
+
+	if req.CategoryId != nil {
+		categoryID := req.GetCategoryId()
+		queryReq.CategoryID = &categoryID
+		queryReq.IncludeDescendants = req.GetIncludeDescendants()
+	}
+
+	return queryReq
+}
+
+// exportFormats maps proto export formats sent as chunks to application
+// formats. Formats missing here are sent as Product messages.
+var exportFormats = map[pb.ExportFormat]export_products.Format{
+	pb.ExportFormat_EXPORT_FORMAT_CSV:    export_products.FormatCSV,
+	pb.ExportFormat_EXPORT_FORMAT_NDJSON: export_products.FormatNDJSON,
+}
+
+// mapToExportProductsRequest converts proto request to query request.
+func mapToExportProductsRequest(req *pb.ExportProductsRequest) export_products.Request {
+	queryReq := export_products.Request{
+		ActiveOnly:        req.GetActiveOnly(),
+		ArchivedMode:      mapArchivedMode(req.GetArchivedMode()),
+		MinBasePrice:      moneyToRat(req.GetMinBasePrice()),
+		MaxBasePrice:      moneyToRat(req.GetMaxBasePrice()),
+		MinEffectivePrice: moneyToRat(req.GetMinEffectivePrice()),
+		MaxEffectivePrice: moneyToRat(req.GetMaxEffectivePrice()),
+		Parallelism:       int(req.GetParallelism()),
+	}
+
+	if req.Category != nil {
+		cat := req.GetCategory()
+		queryReq.Category = &cat
+	}
+
+	if req.Status != nil {
+		st := req.GetStatus()
+		queryReq.Status = &st
+	}
+
+	if req.GetOnSaleAt() != nil {
+		onSaleAt := pb.TimestampToTime(req.GetOnSaleAt())
+		queryReq.OnSaleAt = &onSaleAt
+	}
+
+	if req.MinDiscountPercent != nil {
+		minDiscount := req.GetMinDiscountPercent()
+		queryReq.MinDiscountPercent = &minDiscount
+	}
+
+	if req.CategoryId != nil {
+		categoryID := req.GetCategoryId()
+		queryReq.CategoryID = &categoryID
+		queryReq.IncludeDescendants = req.GetIncludeDescendants()
+	}
+
+	return queryReq
+}
+
+// bulkCommands maps proto bulk commands to domain bulk commands.
+var bulkCommands = map[pb.BulkCommand]domain.BulkCommand{
+	pb.BulkCommand_BULK_COMMAND_ACTIVATE:        domain.BulkCommandActivate,
+	pb.BulkCommand_BULK_COMMAND_DEACTIVATE:      domain.BulkCommandDeactivate,
+	pb.BulkCommand_BULK_COMMAND_ARCHIVE:         domain.BulkCommandArchive,
+	pb.BulkCommand_BULK_COMMAND_APPLY_DISCOUNT:  domain.BulkCommandApplyDiscount,
+	pb.BulkCommand_BULK_COMMAND_REMOVE_DISCOUNT: domain.BulkCommandRemoveDiscount,
+	pb.BulkCommand_BULK_COMMAND_CHANGE_CATEGORY: domain.BulkCommandChangeCategory,
+	pb.BulkCommand_BULK_COMMAND_ASSIGN_CATEGORY: domain.BulkCommandAssignCategory,
+}
+
+// mapBulkCommandToProto converts a domain bulk command name to its proto enum.
+func mapBulkCommandToProto(command string) pb.BulkCommand {
+	for pbCommand, domainCommand := range bulkCommands {
+		if string(domainCommand) == command {
+			return pbCommand
+		}
+	}
+	return pb.BulkCommand_BULK_COMMAND_UNSPECIFIED
+}
+
+// mapToStartBulkUpdateRequest converts proto request to application request.
+func mapToStartBulkUpdateRequest(req *pb.BulkUpdateRequest) start_bulk_update.Request {
+	appReq := start_bulk_update.Request{
+		ActiveOnly:        req.GetActiveOnly(),
+		ArchivedMode:      mapArchivedMode(req.GetArchivedMode()),
+		MinBasePrice:      moneyToRat(req.GetMinBasePrice()),
+		MaxBasePrice:      moneyToRat(req.GetMaxBasePrice()),
+		MinEffectivePrice: moneyToRat(req.GetMinEffectivePrice()),
+		MaxEffectivePrice: moneyToRat(req.GetMaxEffectivePrice()),
+		Command:           bulkCommands[req.GetCommand()],
+		Params: domain.BulkActionParams{
+			Percentage: req.GetPercentage(),
+			StartDate:  pb.TimestampToTime(req.GetStartDate()),
+			EndDate:    pb.TimestampToTime(req.GetEndDate()),
+			Category:   req.GetNewCategory(),
+		},
+		ValidateOnly: req.GetValidateOnly(),
+	}
+
+	if req.Category != nil {
+		cat := req.GetCategory()
+		appReq.Category = &cat
+	}
+
+	if req.Status != nil {
+		st := req.GetStatus()
+		appReq.Status = &st
+	}
+
+	if req.GetOnSaleAt() != nil {
+		onSaleAt := pb.TimestampToTime(req.GetOnSaleAt())
+		appReq.OnSaleAt = &onSaleAt
+	}
+
+	if req.MinDiscountPercent != nil {
+		minDiscount := req.GetMinDiscountPercent()
+		appReq.MinDiscountPercent = &minDiscount
+	}
+
+	return appReq
+}
+
+// mapToGetProductFacetsRequest converts proto request to query request.
+func mapToGetProductFacetsRequest(req *pb.GetProductFacetsRequest) get_product_facets.Request {
+	queryReq := get_product_facets.Request{
+		ActiveOnly:        req.GetActiveOnly(),
+		ArchivedMode:      mapArchivedMode(req.GetArchivedMode()),
+		MinBasePrice:      moneyToRat(req.GetMinBasePrice()),
+		MaxBasePrice:      moneyToRat(req.GetMaxBasePrice()),
+		MinEffectivePrice: moneyToRat(req.GetMinEffectivePrice()),
+		MaxEffectivePrice: moneyToRat(req.GetMaxEffectivePrice()),
+	}
+
+	if req.Category != nil {
+		cat := req.GetCategory()
+		queryReq.Category = &cat
+	}
+
+	if req.Status != nil {
+		st := req.GetStatus()
+		queryReq.Status = &st
+	}
+
+	if req.GetOnSaleAt() != nil {
+		onSaleAt := pb.TimestampToTime(req.GetOnSaleAt())
+		queryReq.OnSaleAt = &onSaleAt
+	}
+
+	if req.MinDiscountPercent != nil {
+		minDiscount := req.GetMinDiscountPercent()
+		queryReq.MinDiscountPercent = &minDiscount
+	}
+
+	for _, boundary := range req.GetPriceBandBoundaries() {
+		queryReq.PriceBandBoundaries = append(queryReq.PriceBandBoundaries, moneyToRat(boundary))
+	}
+
+	if req.CategoryId != nil {
+		categoryID := req.GetCategoryId()
+		queryReq.CategoryID = &categoryID
+		queryReq.IncludeDescendants = req.GetIncludeDescendants()
+	}
+
+	return queryReq
+}
+
+// mapArchivedMode converts the proto archived mode to the read model mode.
+func mapArchivedMode(mode pb.ArchivedMode) contracts.ArchivedMode {
+	switch mode {
+	case pb.ArchivedMode_ARCHIVED_MODE_EXCLUDE:
+		return contracts.ArchivedModeExclude
+	case pb.ArchivedMode_ARCHIVED_MODE_INCLUDE:
+		return contracts.ArchivedModeInclude
+	case pb.ArchivedMode_ARCHIVED_MODE_ONLY:
+		return contracts.ArchivedModeOnly
+	default:
+		return contracts.ArchivedModeUnspecified
+	}
+}
+
+// moneyToRat converts an optional proto Money to a rational amount.
+func moneyToRat(m *pb.Money) *big.Rat {
+	if m == nil {
+		return nil
+	}
+	return big.NewRat(m.GetNumerator(), m.GetDenominator())
+}
+
+// ratToMoney converts an optional rational amount to proto Money.
+// Amounts are built from int64 prices, so the reduced fraction fits.
+func ratToMoney(r *big.Rat) *pb.Money {
+	if r == nil {
+		return nil
+	}
+	return &pb.Money{
+		Numerator:   r.Num().Int64(),
+		Denominator: r.Denom().Int64(),
+	}
+}
+
+// mapToSearchProductsRequest converts proto request to query request.
+func mapToSearchProductsRequest(req *pb.SearchProductsRequest) search_products.Request {
+	var category, status *string
+
+	if req.Category != nil {
+		cat := req.GetCategory()
+		category = &cat
+	}
+
+	if req.Status != nil {
+		st := req.GetStatus()
+		status = &st
+	}
+
+	return search_products.Request{
+		Query:      req.GetQuery(),
 		Category:   category,
 		Status:     status,
 		ActiveOnly: req.GetActiveOnly(),
@@ -81,6 +387,27 @@ func mapToListProductsRequest(req *pb.ListProductsRequest) list_products.Request
 	}
 }
 
+// mapToScheduleProductRequest converts proto request to application request.
+func mapToScheduleProductRequest(req *pb.ScheduleProductRequest) schedule_product.Request {
+	appReq := schedule_product.Request{
+		ProductID:       req.GetProductId(),
+		ValidateOnly:    req.GetValidateOnly(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+
+	if req.GetPublishAt() != nil {
+		publishAt := pb.TimestampToTime(req.GetPublishAt())
+		appReq.PublishAt = &publishAt
+	}
+
+	if req.GetUnpublishAt() != nil {
+		unpublishAt := pb.TimestampToTime(req.GetUnpublishAt())
+		appReq.UnpublishAt = &unpublishAt
+	}
+
+	return appReq
+}
+
 // mapProductDTOToProto converts a product DTO to proto message.
 func mapProductDTOToProto(dto *get_product.ProductDTO) *pb.Product {
 	product := &pb.Product{
@@ -96,9 +423,121 @@ func mapProductDTOToProto(dto *get_product.ProductDTO) *pb.Product {
 			Numerator:   dto.EffectivePriceNum,
 			Denominator: dto.EffectivePriceDenom,
 		},
-		Status:    dto.Status,
-		CreatedAt: timestamppb.New(dto.CreatedAt),
-		UpdatedAt: timestamppb.New(dto.UpdatedAt),
+		Status:      dto.Status,
+		CreatedAt:   timestamppb.New(dto.CreatedAt),
+		UpdatedAt:   timestamppb.New(dto.UpdatedAt),
+		Version:     dto.Version,
+		ExternalKey: dto.ExternalKey,
+		CategoryId:  dto.CategoryID,
+		Attributes:  dto.Attributes,
+	}
+
+	if dto.ArchivedAt != nil {
+		product.ArchivedAt = timestamppb.New(*dto.ArchivedAt)
+	}
+	if dto.PublishAt != nil {
+		product.PublishAt = timestamppb.New(*dto.PublishAt)
+	}
+	if dto.UnpublishAt != nil {
+		product.UnpublishAt = timestamppb.New(*dto.UnpublishAt)
+	}
+
+	if dto.DiscountPercent != nil {
+		product.Discount = &pb.Discount{
+			Percentage: *dto.DiscountPercent,
+		}
+		if dto.DiscountStartDate != nil {
+			product.Discount.StartDate = timestamppb.New(*dto.DiscountStartDate)
+		}
+		if dto.DiscountEndDate != nil {
+			product.Discount.EndDate = timestamppb.New(*dto.DiscountEndDate)
+		}
+	}
+
+	return product
+}
+
+// mapExportProductDTOToProto converts an exported product DTO to proto message.
+func mapExportProductDTOToProto(dto *export_products.ProductDTO) *pb.Product {
+	product := &pb.Product{
+		Id:          dto.ID,
+		Name:        dto.Name,
+		Description: dto.Description,
+		Category:    dto.Category,
+		BasePrice: &pb.Money{
+			Numerator:   dto.BasePriceNumerator,
+			Denominator: dto.BasePriceDenominator,
+		},
+		EffectivePrice: &pb.Money{
+			Numerator:   dto.EffectivePriceNum,
+			Denominator: dto.EffectivePriceDenom,
+		},
+		Status:      dto.Status,
+		CreatedAt:   timestamppb.New(dto.CreatedAt),
+		UpdatedAt:   timestamppb.New(dto.UpdatedAt),
+		Version:     dto.Version,
+		ExternalKey: dto.ExternalKey,
+		CategoryId:  dto.CategoryID,
+		Attributes:  dto.Attributes,
+	}
+
+	if dto.ArchivedAt != nil {
+		product.ArchivedAt = timestamppb.New(*dto.ArchivedAt)
+	}
+	if dto.PublishAt != nil {
+		product.PublishAt = timestamppb.New(*dto.PublishAt)
+	}
+	if dto.UnpublishAt != nil {
+		product.UnpublishAt = timestamppb.New(*dto.UnpublishAt)
+	}
+
+	if dto.DiscountPercent != nil {
+		product.Discount = &pb.Discount{
+			Percentage: *dto.DiscountPercent,
+		}
+		if dto.DiscountStartDate != nil {
+			product.Discount.StartDate = timestamppb.New(*dto.DiscountStartDate)
+		}
+		if dto.DiscountEndDate != nil {
+			product.Discount.EndDate = timestamppb.New(*dto.DiscountEndDate)
+		}
+	}
+
+	return product
+}
+
+// mapCommandResultToProto converts the product state returned by a command to proto message.
+func mapCommandResultToProto(dto *command_result.ProductDTO) *pb.Product {
+	product := &pb.Product{
+		Id:          dto.ID,
+		Name:        dto.Name,
+		Description: dto.Description,
+		Category:    dto.Category,
+		BasePrice: &pb.Money{
+			Numerator:   dto.BasePriceNumerator,
+			Denominator: dto.BasePriceDenominator,
+		},
+		EffectivePrice: &pb.Money{
+			Numerator:   dto.EffectivePriceNum,
+			Denominator: dto.EffectivePriceDenom,
+		},
+		Status:      dto.Status,
+		CreatedAt:   timestamppb.New(dto.CreatedAt),
+		UpdatedAt:   timestamppb.New(dto.UpdatedAt),
+		Version:     dto.Version,
+		ExternalKey: dto.ExternalKey,
+		CategoryId:  dto.CategoryID,
+		Attributes:  dto.Attributes,
+	}
+
+	if dto.ArchivedAt != nil {
+		product.ArchivedAt = timestamppb.New(*dto.ArchivedAt)
+	}
+	if dto.PublishAt != nil {
+		product.PublishAt = timestamppb.New(*dto.PublishAt)
+	}
+	if dto.UnpublishAt != nil {
+		product.UnpublishAt = timestamppb.New(*dto.UnpublishAt)
 	}
 
 	if dto.DiscountPercent != nil {
@@ -116,6 +555,25 @@ func mapProductDTOToProto(dto *get_product.ProductDTO) *pb.Product {
 	return product
 }
 
+// mapEventsToProto converts the events emitted by a command to proto messages.
+func mapEventsToProto(events []command_result.EventDTO) []*pb.DomainEvent {
+	result := make([]*pb.DomainEvent, 0, len(events))
+	for _, e := range events {
+		event := &pb.DomainEvent{
+			EventType:   e.EventType,
+			AggregateId: e.AggregateID,
+			OccurredAt:  timestamppb.New(e.OccurredAt),
+		}
+		// Payloads are the outbox JSON objects, so they always decode
+		payload := &structpb.Struct{}
+		if err := payload.UnmarshalJSON(e.Payload); err == nil {
+			event.Payload = payload
+		}
+		result = append(result, event)
+	}
+	return result
+}
+
 // mapProductListItemDTOToProto converts a product list item DTO to proto message.
 func mapProductListItemDTOToProto(dto *list_products.ProductListItemDTO) *pb.ProductListItem {
 	item := &pb.ProductListItem{
@@ -131,14 +589,19 @@ func mapProductListItemDTOToProto(dto *list_products.ProductListItemDTO) *pb.Pro
 			Numerator:   dto.EffectivePriceNum,
 			Denominator: dto.EffectivePriceDenom,
 		},
-		Status:    dto.Status,
-		CreatedAt: timestamppb.New(dto.CreatedAt),
+		Status:     dto.Status,
+		CreatedAt:  timestamppb.New(dto.CreatedAt),
+		CategoryId: dto.CategoryID,
 	}
 
 	if dto.DiscountPercent != nil {
 		item.DiscountPercent = dto.DiscountPercent
 	}
 
+	if dto.ArchivedAt != nil {
+		item.ArchivedAt = timestamppb.New(*dto.ArchivedAt)
+	}
+
 	return item
 }
 
@@ -150,8 +613,492 @@ func mapListResultToProto(result *list_products.ListResultDTO) *pb.ListProductsR
 	}
 
 	return &pb.ListProductsReply{
-		Products:   products,
+		Products:      products,
+		TotalCount:    result.TotalCount,
+		HasMore:       result.HasMore,
+		NextPageToken: result.NextPageToken,
+	}
+}
+
+// mapSearchResultToProto converts a search result DTO to proto response.
+func mapSearchResultToProto(result *search_products.SearchResultDTO) *pb.SearchProductsReply {
+	results := make([]*pb.ProductSearchResult, len(result.Results))
+	for i, hit := range result.Results {
+		item := &pb.ProductListItem{
+			Id:          hit.ID,
+			Name:        hit.Name,
+			Description: hit.Description,
+			Category:    hit.Category,
+			BasePrice: &pb.Money{
+				Numerator:   hit.BasePriceNumerator,
+				Denominator: hit.BasePriceDenominator,
+			},
+			EffectivePrice: &pb.Money{
+				Numerator:   hit.EffectivePriceNum,
+				Denominator: hit.EffectivePriceDenom,
+			},
+			DiscountPercent: hit.DiscountPercent,
+			Status:          hit.Status,
+			CreatedAt:       timestamppb.New(hit.CreatedAt),
+		}
+
+		if hit.ArchivedAt != nil {
+			item.ArchivedAt = timestamppb.New(*hit.ArchivedAt)
+		}
+
+		results[i] = &pb.ProductSearchResult{
+			Product: item,
+			Score:   hit.Score,
+		}
+	}
+
+	return &pb.SearchProductsReply{
+		Results:    results,
 		TotalCount: result.TotalCount,
 		HasMore:    result.HasMore,
 	}
 }
+
+// mapFacetsToProto converts a facets DTO to proto response.
+func mapFacetsToProto(result *get_product_facets.FacetsDTO) *pb.GetProductFacetsReply {
+	reply := &pb.GetProductFacetsReply{
+		TotalCount:      result.TotalCount,
+		Categories:      mapFacetValuesToProto(result.Categories),
+		Statuses:        mapFacetValuesToProto(result.Statuses),
+		DiscountBuckets: mapFacetValuesToProto(result.DiscountBuckets),
+		PriceBands:      make([]*pb.PriceBandFacet, len(result.PriceBands)),
+	}
+
+	for i, band := range result.PriceBands {
+		reply.PriceBands[i] = &pb.PriceBandFacet{
+			Min:   ratToMoney(band.Min),
+			Max:   ratToMoney(band.Max),
+			Count: band.Count,
+		}
+	}
+
+	return reply
+}
+
+func mapFacetValuesToProto(values []get_product_facets.FacetValueDTO) []*pb.FacetValue {
+	out := make([]*pb.FacetValue, len(values))
+	for i, v := range values {
+		out[i] = &pb.FacetValue{
+			Value: v.Value,
+			Count: v.Count,
+		}
+	}
+	return out
+}
+
+// mapImportReportToProto converts an import report to proto message. Row
+// errors get the reason and field a single-product command would report;
+// unexpected ones, such as a failed batch commit, are logged once and
+// reported as INTERNAL.
+func mapImportReportToProto(ctx context.Context, report *import_products.Report) *pb.ImportProductsReply {
+	reply := &pb.ImportProductsReply{
+		Rows:      make([]*pb.ImportRowResult, 0, len(report.Rows)),
+		Created:   int32(report.Created),
+		Updated:   int32(report.Updated),
+		Unchanged: int32(report.Unchanged),
+		Failed:    int32(report.Failed),
+		Truncated: report.Truncated,
+	}
+
+	logged := make(map[string]bool)
+	for _, row := range report.Rows {
+		result := &pb.ImportRowResult{
+			Line:        int32(row.Line),
+			ExternalKey: row.ExternalKey,
+			ProductId:   row.ProductID,
+			Action:      string(row.Action),
+		}
+
+		if row.Err != nil {
+			if known, ok := lookupDomainError(row.Err); ok {
+				result.ErrorReason = known.reason
+				result.ErrorMessage = row.Err.Error()
+				result.ErrorField = known.field
+			} else {
+				if !logged[row.Err.Error()] {
+					logged[row.Err.Error()] = true
+					log.Printf("grpc: import row failed [request_id=%s]: %v", requestIDFromContext(ctx), row.Err)
+				}
+				result.ErrorReason = "INTERNAL"
+				result.ErrorMessage = "internal server error"
+			}
+		}
+
+		reply.Rows = append(reply.Rows, result)
+	}
+
+	return reply
+}
+
+// mapBulkUpdateResultToProto converts a started bulk update to proto message.
+func mapBulkUpdateResultToProto(result *start_bulk_update.Result) *pb.BulkOperation {
+	return &pb.BulkOperation{
+		OperationId:  result.OperationID,
+		Command:      mapBulkCommandToProto(string(result.Command)),
+		Status:       string(result.Status),
+		MatchedCount: result.MatchedCount,
+		CreatedAt:    timestamppb.New(result.CreatedAt),
+		UpdatedAt:    timestamppb.New(result.CreatedAt),
+	}
+}
+
+// mapBulkOperationToProto converts a bulk operation DTO to proto message.
+// Failures get the reason a single-product command would report; messages
+// of unexpected errors are logged once and reported as INTERNAL.
+func mapBulkOperationToProto(ctx context.Context, dto *get_bulk_operation.OperationDTO) *pb.BulkOperation {
+	op := &pb.BulkOperation{
+		OperationId:    dto.ID,
+		Command:        mapBulkCommandToProto(dto.Command),
+		Status:         dto.Status,
+		MatchedCount:   dto.MatchedCount,
+		ProcessedCount: dto.ProcessedCount,
+		SucceededCount: dto.SucceededCount,
+		FailedCount:    dto.FailedCount,
+		Failures:       make([]*pb.BulkOperationFailure, 0, len(dto.Failures)),
+		CreatedAt:      timestamppb.New(dto.CreatedAt),
+		UpdatedAt:      timestamppb.New(dto.UpdatedAt),
+		Done:           dto.Done(),
+	}
+
+	if dto.CompletedAt != nil {
+		op.CompletedAt = timestamppb.New(*dto.CompletedAt)
+	}
+
+	logged := make(map[string]bool)
+	for _, f := range dto.Failures {
+		failure := &pb.BulkOperationFailure{ProductId: f.ProductID}
+		if known, ok := lookupDomainErrorMessage(f.Message); ok {
+			failure.ErrorReason = known.reason
+			failure.ErrorMessage = f.Message
+		} else {
+			if !logged[f.Message] {
+				logged[f.Message] = true
+				log.Printf("grpc: bulk operation %s failed on a product [request_id=%s]: %s", dto.ID, requestIDFromContext(ctx), f.Message)
+			}
+			failure.ErrorReason = "INTERNAL"
+			failure.ErrorMessage = "internal server error"
+		}
+		op.Failures = append(op.Failures, failure)
+	}
+
+	return op
+}
+
+// mapToCreateCampaignRequest converts proto request to application request.
+func mapToCreateCampaignRequest(req *pb.CreateCampaignRequest) create_campaign.Request {
+	return create_campaign.Request{
+		Name:         req.GetName(),
+		Percentage:   req.GetPercentage(),
+		StartDate:    pb.TimestampToTime(req.GetStartDate()),
+		EndDate:      pb.TimestampToTime(req.GetEndDate()),
+		ProductIDs:   req.GetProductIds(),
+		Categories:   req.GetCategories(),
+		ValidateOnly: req.GetValidateOnly(),
+	}
+}
+
+// mapToEndCampaignRequest converts proto request to application request.
+func mapToEndCampaignRequest(req *pb.EndCampaignRequest) end_campaign.Request {
+	return end_campaign.Request{
+		CampaignID:   req.GetCampaignId(),
+		ValidateOnly: req.GetValidateOnly(),
+	}
+}
+
+// mapToListCampaignsRequest converts proto request to query request.
+func mapToListCampaignsRequest(req *pb.ListCampaignsRequest) list_campaigns.Request {
+	queryReq := list_campaigns.Request{
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	}
+
+	if req.Status != nil {
+		status := req.GetStatus()
+		queryReq.Status = &status
+	}
+
+	return queryReq
+}
+
+// mapCampaignResultToProto converts the campaign state returned by a command to proto message.
+func mapCampaignResultToProto(dto *command_result.CampaignDTO) *pb.Campaign {
+	campaign := &pb.Campaign{
+		CampaignId: dto.ID,
+		Name:       dto.Name,
+		Percentage: dto.DiscountPercent,
+		StartDate:  timestamppb.New(dto.StartDate),
+		EndDate:    timestamppb.New(dto.EndDate),
+		Status:     dto.Status,
+		ProductIds: dto.ProductIDs,
+		Categories: dto.Categories,
+		CreatedAt:  timestamppb.New(dto.CreatedAt),
+		UpdatedAt:  timestamppb.New(dto.UpdatedAt),
+	}
+
+	if dto.EndedAt != nil {
+		campaign.EndedAt = timestamppb.New(*dto.EndedAt)
+	}
+
+	return campaign
+}
+
+// mapCampaignDTOToProto converts a campaign DTO to proto message.
+func mapCampaignDTOToProto(dto *get_campaign.CampaignDTO) *pb.Campaign {
+	campaign := &pb.Campaign{
+		CampaignId: dto.ID,
+		Name:       dto.Name,
+		Percentage: dto.DiscountPercent,
+		StartDate:  timestamppb.New(dto.StartDate),
+		EndDate:    timestamppb.New(dto.EndDate),
+		Status:     dto.Status,
+		ProductIds: dto.ProductIDs,
+		Categories: dto.Categories,
+		CreatedAt:  timestamppb.New(dto.CreatedAt),
+		UpdatedAt:  timestamppb.New(dto.UpdatedAt),
+	}
+
+	if dto.EndedAt != nil {
+		campaign.EndedAt = timestamppb.New(*dto.EndedAt)
+	}
+
+	return campaign
+}
+
+// mapCampaignListItemDTOToProto converts a campaign list item DTO to proto message.
+func mapCampaignListItemDTOToProto(dto *list_campaigns.CampaignDTO) *pb.Campaign {
+	campaign := &pb.Campaign{
+		CampaignId: dto.ID,
+		Name:       dto.Name,
+		Percentage: dto.DiscountPercent,
+		StartDate:  timestamppb.New(dto.StartDate),
+		EndDate:    timestamppb.New(dto.EndDate),
+		Status:     dto.Status,
+		ProductIds: dto.ProductIDs,
+		Categories: dto.Categories,
+		CreatedAt:  timestamppb.New(dto.CreatedAt),
+		UpdatedAt:  timestamppb.New(dto.UpdatedAt),
+	}
+
+	if dto.EndedAt != nil {
+		campaign.EndedAt = timestamppb.New(*dto.EndedAt)
+	}
+
+	return campaign
+}
+
+// mapCampaignListToProto converts a campaign list DTO to proto response.
+func mapCampaignListToProto(result *list_campaigns.ListResultDTO) *pb.ListCampaignsReply {
+	reply := &pb.ListCampaignsReply{
+		Campaigns: make([]*pb.Campaign, len(result.Campaigns)),
+		HasMore:   result.HasMore,
+	}
+
+	for i, dto := range result.Campaigns {
+		reply.Campaigns[i] = mapCampaignListItemDTOToProto(dto)
+	}
+
+	return reply
+}
+
+// mapToCreateCouponRequest converts proto request to application request.
+func mapToCreateCouponRequest(req *pb.CreateCouponRequest) create_coupon.Request {
+	return create_coupon.Request{
+		Code:                 req.GetCode(),
+		Percentage:           req.GetPercentage(),
+		AmountOffNumerator:   req.GetAmountOff().GetNumerator(),
+		AmountOffDenominator: req.GetAmountOff().GetDenominator(),
+		ProductIDs:           req.GetProductIds(),
+		Categories:           req.GetCategories(),
+		StartDate:            pb.TimestampToTime(req.GetStartDate()),
+		EndDate:              pb.TimestampToTime(req.GetEndDate()),
+		MaxRedemptions:       req.GetMaxRedemptions(),
+		ValidateOnly:         req.GetValidateOnly(),
+	}
+}
+
+// mapToValidateCouponRequest converts proto request to application request.
+func mapToValidateCouponRequest(req *pb.ValidateCouponRequest) validate_coupon.Request {
+	return validate_coupon.Request{
+		Code:       req.GetCode(),
+		ProductIDs: req.GetProductIds(),
+	}
+}
+
+// mapToRedeemCouponRequest converts proto request to application request.
+func mapToRedeemCouponRequest(req *pb.RedeemCouponRequest) redeem_coupon.Request {
+	return redeem_coupon.Request{
+		Code:         req.GetCode(),
+		OrderID:      req.GetOrderId(),
+		ProductIDs:   req.GetProductIds(),
+		ValidateOnly: req.GetValidateOnly(),
+	}
+}
+
+// mapCouponResultToProto converts the coupon state returned by a command to proto message.
+func mapCouponResultToProto(dto *command_result.CouponDTO) *pb.Coupon {
+	coupon := &pb.Coupon{
+		CouponId:        dto.ID,
+		Code:            dto.Code,
+		Percentage:      dto.Percentage,
+		ProductIds:      dto.ProductIDs,
+		Categories:      dto.Categories,
+		StartDate:       timestamppb.New(dto.StartDate),
+		EndDate:         timestamppb.New(dto.EndDate),
+		MaxRedemptions:  dto.MaxRedemptions,
+		RedemptionCount: dto.RedemptionCount,
+		CreatedAt:       timestamppb.New(dto.CreatedAt),
+		UpdatedAt:       timestamppb.New(dto.UpdatedAt),
+	}
+
+	if dto.AmountOffDenominator != 0 {
+		coupon.AmountOff = &pb.Money{
+			Numerator:   dto.AmountOffNumerator,
+			Denominator: dto.AmountOffDenominator,
+		}
+	}
+
+	return coupon
+}
+
+// mapCouponLinesToProto converts priced coupon lines to proto messages. An
+// ineligible line carries the ErrorInfo reason of why the coupon does not
+// apply.
+func mapCouponLinesToProto(lines []command_result.CouponLineDTO) []*pb.CouponLine {
+	protoLines := make([]*pb.CouponLine, len(lines))
+	for i, line := range lines {
+		protoLine := &pb.CouponLine{
+			ProductId: line.ProductID,
+			Eligible:  line.Err == nil,
+		}
+		if line.EffectivePriceDenom != 0 {
+			protoLine.Price = &pb.Money{
+				Numerator:   line.EffectivePriceNum,
+				Denominator: line.EffectivePriceDenom,
+			}
+			protoLine.DiscountedPrice = &pb.Money{
+				Numerator:   line.DiscountedPriceNum,
+				Denominator: line.DiscountedPriceDenom,
+			}
+		}
+		if line.Err != nil {
+			protoLine.IneligibleReason = "INTERNAL"
+			if known, ok := lookupDomainError(line.Err); ok {
+				protoLine.IneligibleReason = known.reason
+			}
+		}
+		protoLines[i] = protoLine
+	}
+	return protoLines
+}
+
+// mapToCreateCategoryRequest converts proto request to application request.
+func mapToCreateCategoryRequest(req *pb.CreateCategoryRequest) create_category.Request {
+	return create_category.Request{
+		Slug:         req.GetSlug(),
+		DisplayName:  req.GetDisplayName(),
+		ParentID:     req.GetParentId(),
+		ValidateOnly: req.GetValidateOnly(),
+	}
+}
+
+// mapToUpdateCategoryRequest converts proto request to application request.
+func mapToUpdateCategoryRequest(req *pb.UpdateCategoryRequest) update_category.Request {
+	appReq := update_category.Request{
+		CategoryID:   req.GetCategoryId(),
+		ValidateOnly: req.GetValidateOnly(),
+	}
+
+	if req.Slug != nil {
+		slug := req.GetSlug()
+		appReq.Slug = &slug
+	}
+
+	if req.ParentId != nil {
+		parentID := req.GetParentId()
+		appReq.ParentID = &parentID
+	}
+
+	return appReq
+}
+
+// mapToListCategoriesRequest converts proto request to query request.
+func mapToListCategoriesRequest(req *pb.ListCategoriesRequest) list_categories.Request {
+	queryReq := list_categories.Request{
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	}
+
+	if req.ParentId != nil {
+		parentID := req.GetParentId()
+		queryReq.ParentID = &parentID
+	}
+
+	return queryReq
+}
+
+// mapCategoryResultToProto converts the category state returned by a command to proto message.
+func mapCategoryResultToProto(dto *command_result.CategoryDTO) *pb.Category {
+	return &pb.Category{
+		CategoryId:  dto.ID,
+		Slug:        dto.Slug,
+		ParentId:    dto.ParentID,
+		DisplayName: dto.DisplayName,
+		CreatedAt:   timestamppb.New(dto.CreatedAt),
+		UpdatedAt:   timestamppb.New(dto.UpdatedAt),
+	}
+}
+
+// mapCategoryOperationToProto converts the bulk operation a category command
+// started to proto message.
+func mapCategoryOperationToProto(dto *command_result.BulkOperationDTO) *pb.BulkOperation {
+	if dto == nil {
+		return nil
+	}
+
+	return &pb.BulkOperation{
+		OperationId:  dto.ID,
+		Command:      mapBulkCommandToProto(string(dto.Command)),
+		Status:       string(dto.Status),
+		MatchedCount: dto.MatchedCount,
+		CreatedAt:    timestamppb.New(dto.CreatedAt),
+		UpdatedAt:    timestamppb.New(dto.CreatedAt),
+	}
+}
+
+// mapCategoryDTOToProto converts a category DTO to proto message.
+func mapCategoryDTOToProto(dto *get_category.CategoryDTO) *pb.Category {
+	return &pb.Category{
+		CategoryId:  dto.ID,
+		Slug:        dto.Slug,
+		ParentId:    dto.ParentID,
+		DisplayName: dto.DisplayName,
+		CreatedAt:   timestamppb.New(dto.CreatedAt),
+		UpdatedAt:   timestamppb.New(dto.UpdatedAt),
+	}
+}
+
+// mapCategoryListToProto converts a category list DTO to proto response.
+func mapCategoryListToProto(result *list_categories.ListResultDTO) *pb.ListCategoriesReply {
+	reply := &pb.ListCategoriesReply{
+		Categories: make([]*pb.Category, len(result.Categories)),
+		HasMore:    result.HasMore,
+	}
+
+	for i, dto := range result.Categories {
+		reply.Categories[i] = &pb.Category{
+			CategoryId:  dto.ID,
+			Slug:        dto.Slug,
+			ParentId:    dto.ParentID,
+			DisplayName: dto.DisplayName,
+			CreatedAt:   timestamppb.New(dto.CreatedAt),
+			UpdatedAt:   timestamppb.New(dto.UpdatedAt),
+		}
+	}
+
+	return reply
+}
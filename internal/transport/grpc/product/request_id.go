@@ -0,0 +1,75 @@
+package product
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is the metadata key carrying the request ID, both on the
+// incoming request and on the response headers.
+const RequestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestIDInterceptor assigns every unary call a request ID: the caller's
+// x-request-id when present, otherwise a new UUID. The ID is echoed in the
+// response headers and reported in the RequestInfo detail of errors.
+func RequestIDInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := incomingRequestID(ctx)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	return handler(context.WithValue(ctx, requestIDKey{}, requestID), req)
+}
+
+// StreamRequestIDInterceptor is RequestIDInterceptor for streaming calls.
+func StreamRequestIDInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := ss.Context()
+	requestID := incomingRequestID(ctx)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, requestID))
+
+	return handler(srv, &requestIDStream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ctx, requestIDKey{}, requestID),
+	})
+}
+
+// requestIDStream overrides the context of a server stream.
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStream) Context() context.Context {
+	return s.ctx
+}
+
+// requestIDFromContext returns the request ID assigned by the interceptors.
+// Calls that bypassed them fall back to the incoming header, or a new UUID
+// so errors can still be correlated.
+func requestIDFromContext(ctx context.Context) string {
+	if requestID, ok := ctx.Value(requestIDKey{}).(string); ok {
+		return requestID
+	}
+	if requestID := incomingRequestID(ctx); requestID != "" {
+		return requestID
+	}
+	return uuid.NewString()
+}
+
+func incomingRequestID(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, RequestIDHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
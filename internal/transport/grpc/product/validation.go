@@ -3,27 +3,119 @@ package product
 import (
 	"errors"
 
+	"google.golang.org/grpc/codes"
+
 	pb "github.com/product-catalog-service/proto/product/v1"
 )
 
 var (
-	ErrMissingProductID   = errors.New("product_id is required")
-	ErrMissingName        = errors.New("name is required")
-	ErrMissingCategory    = errors.New("category is required")
-	ErrMissingBasePrice   = errors.New("base_price is required")
-	ErrInvalidPercentage  = errors.New("percentage must be between 1 and 100")
-	ErrMissingStartDate   = errors.New("start_date is required")
-	ErrMissingEndDate     = errors.New("end_date is required")
-	ErrInvalidDenominator = errors.New("base_price denominator must be positive")
-	ErrInvalidNumerator   = errors.New("base_price numerator must be positive")
+	ErrMissingProductID    = errors.New("product_id is required")
+	ErrMissingTargetStatus = errors.New("target_status is required")
+	ErrMissingName         = errors.New("name is required")
+	ErrMissingCategory     = errors.New("category is required")
+	ErrMissingBasePrice    = errors.New("base_price is required")
+	ErrInvalidPercentage   = errors.New("percentage must be between 1 and 100")
+	ErrMissingStartDate    = errors.New("start_date is required")
+	ErrMissingEndDate      = errors.New("end_date is required")
+	ErrInvalidDenominator  = errors.New("base_price denominator must be positive")
+	ErrInvalidNumerator    = errors.New("base_price numerator must be positive")
+	ErrOffsetWithToken     = errors.New("offset cannot be combined with page_token")
+	ErrNegativeOffset      = errors.New("offset must not be negative")
+	ErrInvalidPriceFilter  = errors.New("price filters must have a positive denominator and a non-negative numerator")
+	ErrInvalidMinDiscount  = errors.New("min_discount_percent must be between 1 and 100")
+	ErrMissingQuery        = errors.New("query is required")
+	ErrMissingProductIDs   = errors.New("product_ids is required")
+	ErrEmptyProductID      = errors.New("product_ids must not contain empty values")
+	ErrInvalidArchivedMode = errors.New("archived_mode is not a known value")
+	ErrMissingImportFormat = errors.New("format is required in the first message")
+	ErrInvalidExportFormat = errors.New("format is not a known value")
+	ErrMissingBulkCommand  = errors.New("command is required")
+	ErrInvalidBulkCommand  = errors.New("command is not a known value")
+	ErrMissingNewCategory  = errors.New("new_category is required")
+	ErrMissingOperationID  = errors.New("operation_id is required")
+	ErrMissingCampaignID   = errors.New("campaign_id is required")
+	ErrMissingCouponCode   = errors.New("code is required")
+	ErrInvalidAmountOff    = errors.New("amount_off must have a positive denominator and a positive numerator")
+	ErrMissingOrderID      = errors.New("order_id is required")
+	ErrMissingCategoryID   = errors.New("category_id is required")
+	ErrMissingSlug         = errors.New("slug is required")
+	ErrMissingDisplayName  = errors.New("display_name is required")
+	ErrMissingTargetID     = errors.New("target_category_id is required")
+	ErrMissingSourceIDs    = errors.New("source_category_ids is required")
+	ErrEmptySourceID       = errors.New("source_category_ids must not contain empty values")
 )
 
-// validateCreateRequest validates CreateProductRequest.
+// requestErrors gives each request validation error its ErrorInfo reason and
+// the request field it concerns.
+var requestErrors = []errorReason{
+	{ErrMissingProductID, codes.InvalidArgument, "MISSING_FIELD", "product_id"},
+	{ErrMissingTargetStatus, codes.InvalidArgument, "MISSING_FIELD", "target_status"},
+	{ErrMissingName, codes.InvalidArgument, "MISSING_FIELD", "name"},
+	{ErrMissingCategory, codes.InvalidArgument, "MISSING_FIELD", "category"},
+	{ErrMissingBasePrice, codes.InvalidArgument, "MISSING_FIELD", "base_price"},
+	{ErrInvalidPercentage, codes.InvalidArgument, "INVALID_FIELD", "percentage"},
+	{ErrMissingStartDate, codes.InvalidArgument, "MISSING_FIELD", "start_date"},
+	{ErrMissingEndDate, codes.InvalidArgument, "MISSING_FIELD", "end_date"},
+	{ErrInvalidDenominator, codes.InvalidArgument, "INVALID_FIELD", "base_price.denominator"},
+	{ErrInvalidNumerator, codes.InvalidArgument, "INVALID_FIELD", "base_price.numerator"},
+	{ErrOffsetWithToken, codes.InvalidArgument, "INVALID_FIELD", "offset"},
+	{ErrNegativeOffset, codes.InvalidArgument, "INVALID_FIELD", "offset"},
+	{ErrInvalidMinDiscount, codes.InvalidArgument, "INVALID_FIELD", "min_discount_percent"},
+	{ErrMissingQuery, codes.InvalidArgument, "MISSING_FIELD", "query"},
+	{ErrMissingProductIDs, codes.InvalidArgument, "MISSING_FIELD", "product_ids"},
+	{ErrEmptyProductID, codes.InvalidArgument, "INVALID_FIELD", "product_ids"},
+	{ErrInvalidArchivedMode, codes.InvalidArgument, "INVALID_FIELD", "archived_mode"},
+	{ErrMissingImportFormat, codes.InvalidArgument, "MISSING_FIELD", "format"},
+	{ErrInvalidExportFormat, codes.InvalidArgument, "INVALID_FIELD", "format"},
+	{ErrMissingBulkCommand, codes.InvalidArgument, "MISSING_FIELD", "command"},
+	{ErrInvalidBulkCommand, codes.InvalidArgument, "INVALID_FIELD", "command"},
+	{ErrMissingNewCategory, codes.InvalidArgument, "MISSING_FIELD", "new_category"},
+	{ErrMissingOperationID, codes.InvalidArgument, "MISSING_FIELD", "operation_id"},
+	{ErrMissingCampaignID, codes.InvalidArgument, "MISSING_FIELD", "campaign_id"},
+	{ErrMissingCouponCode, codes.InvalidArgument, "MISSING_FIELD", "code"},
+	{ErrInvalidAmountOff, codes.InvalidArgument, "INVALID_FIELD", "amount_off"},
+	{ErrMissingOrderID, codes.InvalidArgument, "MISSING_FIELD", "order_id"},
+	{ErrMissingCategoryID, codes.InvalidArgument, "MISSING_FIELD", "category_id"},
+	{ErrMissingSlug, codes.InvalidArgument, "MISSING_FIELD", "slug"},
+	{ErrMissingDisplayName, codes.InvalidArgument, "MISSING_FIELD", "display_name"},
+	{ErrMissingTargetID, codes.InvalidArgument, "MISSING_FIELD", "target_category_id"},
+	{ErrMissingSourceIDs, codes.InvalidArgument, "MISSING_FIELD", "source_category_ids"},
+	{ErrEmptySourceID, codes.InvalidArgument, "INVALID_FIELD", "source_category_ids"},
+}
+
+// fieldError attributes a validation error shared by several fields, such as
+// ErrInvalidPriceFilter, to the field that failed.
+type fieldError struct {
+	field string
+	err   error
+}
+
+func (e *fieldError) Error() string { return e.err.Error() }
+
+func (e *fieldError) Unwrap() error { return e.err }
+
+// requestErrorReason returns the ErrorInfo reason and request field of a
+// validation error.
+func requestErrorReason(err error) (reason, field string) {
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		return "INVALID_FIELD", fe.field
+	}
+	for _, known := range requestErrors {
+		if errors.Is(err, known.err) {
+			return known.reason, known.field
+		}
+	}
+	return "INVALID_ARGUMENT", ""
+}
+
+// validateCreateRequest validates CreateProductRequest. A category_id stands
+// in for the category.
 func validateCreateRequest(req *pb.CreateProductRequest) error {
 	if req.GetName() == "" {
 		return ErrMissingName
 	}
-	if req.GetCategory() == "" {
+	if req.GetCategory() == "" && req.GetCategoryId() == "" {
 		return ErrMissingCategory
 	}
 	if req.GetBasePrice() == nil {
@@ -38,15 +130,25 @@ func validateCreateRequest(req *pb.CreateProductRequest) error {
 	return nil
 }
 
-// validateUpdateRequest validates UpdateProductRequest.
+// validateUpdateRequest validates UpdateProductRequest. With an update mask
+// only the masked fields are checked, by the domain, except that a masked
+// category_id must be set.
 func validateUpdateRequest(req *pb.UpdateProductRequest) error {
 	if req.GetProductId() == "" {
 		return ErrMissingProductID
 	}
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		for _, path := range paths {
+			if path == "category_id" && req.GetCategoryId() == "" {
+				return ErrMissingCategoryID
+			}
+		}
+		return nil
+	}
 	if req.GetName() == "" {
 		return ErrMissingName
 	}
-	if req.GetCategory() == "" {
+	if req.GetCategory() == "" && req.GetCategoryId() == "" {
 		return ErrMissingCategory
 	}
 	return nil
@@ -76,6 +178,41 @@ func validateArchiveRequest(req *pb.ArchiveProductRequest) error {
 	return nil
 }
 
+// validateRestoreRequest validates RestoreProductRequest.
+func validateRestoreRequest(req *pb.RestoreProductRequest) error {
+	if req.GetProductId() == "" {
+		return ErrMissingProductID
+	}
+	return nil
+}
+
+// validateTransitionRequest validates TransitionProductRequest.
+func validateTransitionRequest(req *pb.TransitionProductRequest) error {
+	if req.GetProductId() == "" {
+		return ErrMissingProductID
+	}
+	if req.GetTargetStatus() == "" {
+		return ErrMissingTargetStatus
+	}
+	return nil
+}
+
+// validatePurgeRequest validates PurgeProductRequest.
+func validatePurgeRequest(req *pb.PurgeProductRequest) error {
+	if req.GetProductId() == "" {
+		return ErrMissingProductID
+	}
+	return nil
+}
+
+// validateScheduleRequest validates ScheduleProductRequest.
+func validateScheduleRequest(req *pb.ScheduleProductRequest) error {
+	if req.GetProductId() == "" {
+		return ErrMissingProductID
+	}
+	return nil
+}
+
 // validateApplyDiscountRequest validates ApplyDiscountRequest.
 func validateApplyDiscountRequest(req *pb.ApplyDiscountRequest) error {
 	if req.GetProductId() == "" {
@@ -101,6 +238,14 @@ func validateRemoveDiscountRequest(req *pb.RemoveDiscountRequest) error {
 	return nil
 }
 
+// validateImportRequest validates the first ImportProductsRequest of a stream.
+func validateImportRequest(req *pb.ImportProductsRequest) error {
+	if req.GetFormat() == pb.ImportFormat_IMPORT_FORMAT_UNSPECIFIED {
+		return ErrMissingImportFormat
+	}
+	return nil
+}
+
 // validateGetProductRequest validates GetProductRequest.
 func validateGetProductRequest(req *pb.GetProductRequest) error {
 	if req.GetProductId() == "" {
@@ -109,8 +254,347 @@ func validateGetProductRequest(req *pb.GetProductRequest) error {
 	return nil
 }
 
+// validateBatchGetProductsRequest validates BatchGetProductsRequest.
+func validateBatchGetProductsRequest(req *pb.BatchGetProductsRequest) error {
+	if len(req.GetProductIds()) == 0 {
+		return ErrMissingProductIDs
+	}
+	for _, id := range req.GetProductIds() {
+		if id == "" {
+			return ErrEmptyProductID
+		}
+	}
+	return nil
+}
+
 // validateListProductsRequest validates ListProductsRequest.
 func validateListProductsRequest(req *pb.ListProductsRequest) error {
-	// Limit and offset have sensible defaults, so no validation needed
+	// Limit has a sensible default; offset and page_token are mutually exclusive
+	if req.GetOffset() < 0 {
+		return ErrNegativeOffset
+	}
+	if req.GetPageToken() != "" && req.GetOffset() != 0 {
+		return ErrOffsetWithToken
+	}
+	if err := validatePriceFilters(
+		req.GetMinBasePrice(),
+		req.GetMaxBasePrice(),
+		req.GetMinEffectivePrice(),
+		req.GetMaxEffectivePrice(),
+	); err != nil {
+		return err
+	}
+	if req.MinDiscountPercent != nil &&
+		(req.GetMinDiscountPercent() < 1 || req.GetMinDiscountPercent() > 100) {
+		return ErrInvalidMinDiscount
+	}
+	if _, ok := pb.ArchivedMode_name[int32(req.GetArchivedMode())]; !ok {
+		return ErrInvalidArchivedMode
+	}
+	if req.CampaignId != nil && req.GetCampaignId() == "" {
+		return ErrMissingCampaignID
+	}
+	if req.CategoryId != nil && req.GetCategoryId() == "" {
+		return ErrMissingCategoryID
+	}
+	return nil
+}
+
+// validateExportProductsRequest validates ExportProductsRequest.
+func validateExportProductsRequest(req *pb.ExportProductsRequest) error {
+	if err := validatePriceFilters(
+		req.GetMinBasePrice(),
+		req.GetMaxBasePrice(),
+		req.GetMinEffectivePrice(),
+		req.GetMaxEffectivePrice(),
+	); err != nil {
+		return err
+	}
+	if req.MinDiscountPercent != nil &&
+		(req.GetMinDiscountPercent() < 1 || req.GetMinDiscountPercent() > 100) {
+		return ErrInvalidMinDiscount
+	}
+	if _, ok := pb.ArchivedMode_name[int32(req.GetArchivedMode())]; !ok {
+		return ErrInvalidArchivedMode
+	}
+	if _, ok := pb.ExportFormat_name[int32(req.GetFormat())]; !ok {
+		return ErrInvalidExportFormat
+	}
+	return nil
+}
+
+// validateBulkUpdateRequest validates BulkUpdateRequest. Only the arguments
+// of the requested command are checked.
+func validateBulkUpdateRequest(req *pb.BulkUpdateRequest) error {
+	if err := validatePriceFilters(
+		req.GetMinBasePrice(),
+		req.GetMaxBasePrice(),
+		req.GetMinEffectivePrice(),
+		req.GetMaxEffectivePrice(),
+	); err != nil {
+		return err
+	}
+	if req.MinDiscountPercent != nil &&
+		(req.GetMinDiscountPercent() < 1 || req.GetMinDiscountPercent() > 100) {
+		return ErrInvalidMinDiscount
+	}
+	if _, ok := pb.ArchivedMode_name[int32(req.GetArchivedMode())]; !ok {
+		return ErrInvalidArchivedMode
+	}
+
+	switch req.GetCommand() {
+	case pb.BulkCommand_BULK_COMMAND_UNSPECIFIED:
+		return ErrMissingBulkCommand
+	case pb.BulkCommand_BULK_COMMAND_APPLY_DISCOUNT:
+		if req.GetPercentage() < 1 || req.GetPercentage() > 100 {
+			return ErrInvalidPercentage
+		}
+		if req.GetStartDate() == nil {
+			return ErrMissingStartDate
+		}
+		if req.GetEndDate() == nil {
+			return ErrMissingEndDate
+		}
+	case pb.BulkCommand_BULK_COMMAND_CHANGE_CATEGORY:
+		if req.GetNewCategory() == "" {
+			return ErrMissingNewCategory
+		}
+	case pb.BulkCommand_BULK_COMMAND_ASSIGN_CATEGORY:
+		// Only started by RenameCategory and MergeCategories
+		return ErrInvalidBulkCommand
+	default:
+		if _, ok := bulkCommands[req.GetCommand()]; !ok {
+			return ErrInvalidBulkCommand
+		}
+	}
+	return nil
+}
+
+// validateGetBulkOperationRequest validates GetBulkOperationRequest.
+func validateGetBulkOperationRequest(req *pb.GetBulkOperationRequest) error {
+	if req.GetOperationId() == "" {
+		return ErrMissingOperationID
+	}
+	return nil
+}
+
+// validateCreateCampaignRequest validates CreateCampaignRequest. The
+// selection is checked by the domain.
+func validateCreateCampaignRequest(req *pb.CreateCampaignRequest) error {
+	if req.GetName() == "" {
+		return ErrMissingName
+	}
+	if req.GetPercentage() < 1 || req.GetPercentage() > 100 {
+		return ErrInvalidPercentage
+	}
+	if req.GetStartDate() == nil {
+		return ErrMissingStartDate
+	}
+	if req.GetEndDate() == nil {
+		return ErrMissingEndDate
+	}
+	return nil
+}
+
+// validateEndCampaignRequest validates EndCampaignRequest.
+func validateEndCampaignRequest(req *pb.EndCampaignRequest) error {
+	if req.GetCampaignId() == "" {
+		return ErrMissingCampaignID
+	}
+	return nil
+}
+
+// validateGetCampaignRequest validates GetCampaignRequest.
+func validateGetCampaignRequest(req *pb.GetCampaignRequest) error {
+	if req.GetCampaignId() == "" {
+		return ErrMissingCampaignID
+	}
+	return nil
+}
+
+// validateListCampaignsRequest validates ListCampaignsRequest. The status
+// filter is checked by the query.
+func validateListCampaignsRequest(req *pb.ListCampaignsRequest) error {
+	if req.GetOffset() < 0 {
+		return ErrNegativeOffset
+	}
+	return nil
+}
+
+// validateCreateCouponRequest validates CreateCouponRequest. The rule, code
+// format and eligibility are checked by the domain.
+func validateCreateCouponRequest(req *pb.CreateCouponRequest) error {
+	if req.GetCode() == "" {
+		return ErrMissingCouponCode
+	}
+	if amountOff := req.GetAmountOff(); amountOff != nil &&
+		(amountOff.GetDenominator() <= 0 || amountOff.GetNumerator() <= 0) {
+		return ErrInvalidAmountOff
+	}
+	if req.GetStartDate() == nil {
+		return ErrMissingStartDate
+	}
+	if req.GetEndDate() == nil {
+		return ErrMissingEndDate
+	}
+	return nil
+}
+
+// validateValidateCouponRequest validates ValidateCouponRequest.
+func validateValidateCouponRequest(req *pb.ValidateCouponRequest) error {
+	if req.GetCode() == "" {
+		return ErrMissingCouponCode
+	}
+	return validateCouponProductIDs(req.GetProductIds())
+}
+
+// validateRedeemCouponRequest validates RedeemCouponRequest.
+func validateRedeemCouponRequest(req *pb.RedeemCouponRequest) error {
+	if req.GetCode() == "" {
+		return ErrMissingCouponCode
+	}
+	if req.GetOrderId() == "" {
+		return ErrMissingOrderID
+	}
+	return validateCouponProductIDs(req.GetProductIds())
+}
+
+// validateCreateCategoryRequest validates CreateCategoryRequest. The slug
+// format is checked by the domain.
+func validateCreateCategoryRequest(req *pb.CreateCategoryRequest) error {
+	if req.GetSlug() == "" {
+		return ErrMissingSlug
+	}
+	if req.GetDisplayName() == "" {
+		return ErrMissingDisplayName
+	}
+	return nil
+}
+
+// validateUpdateCategoryRequest validates UpdateCategoryRequest.
+func validateUpdateCategoryRequest(req *pb.UpdateCategoryRequest) error {
+	if req.GetCategoryId() == "" {
+		return ErrMissingCategoryID
+	}
+	return nil
+}
+
+// validateDeleteCategoryRequest validates DeleteCategoryRequest.
+func validateDeleteCategoryRequest(req *pb.DeleteCategoryRequest) error {
+	if req.GetCategoryId() == "" {
+		return ErrMissingCategoryID
+	}
+	return nil
+}
+
+// validateRenameCategoryRequest validates RenameCategoryRequest. The display
+// name is checked by the domain.
+func validateRenameCategoryRequest(req *pb.RenameCategoryRequest) error {
+	if req.GetCategoryId() == "" {
+		return ErrMissingCategoryID
+	}
+	if req.GetDisplayName() == "" {
+		return ErrMissingDisplayName
+	}
+	return nil
+}
+
+// validateMergeCategoriesRequest validates MergeCategoriesRequest.
+func validateMergeCategoriesRequest(req *pb.MergeCategoriesRequest) error {
+	if len(req.GetSourceCategoryIds()) == 0 {
+		return ErrMissingSourceIDs
+	}
+	for _, id := range req.GetSourceCategoryIds() {
+		if id == "" {
+			return ErrEmptySourceID
+		}
+	}
+	if req.GetTargetCategoryId() == "" {
+		return ErrMissingTargetID
+	}
+	return nil
+}
+
+// validateGetCategoryRequest validates GetCategoryRequest.
+func validateGetCategoryRequest(req *pb.GetCategoryRequest) error {
+	if req.GetCategoryId() == "" {
+		return ErrMissingCategoryID
+	}
+	return nil
+}
+
+// validateListCategoriesRequest validates ListCategoriesRequest.
+func validateListCategoriesRequest(req *pb.ListCategoriesRequest) error {
+	if req.GetOffset() < 0 {
+		return ErrNegativeOffset
+	}
+	return nil
+}
+
+// validateCouponProductIDs checks the products a coupon is priced for.
+func validateCouponProductIDs(ids []string) error {
+	if len(ids) == 0 {
+		return ErrMissingProductIDs
+	}
+	for _, id := range ids {
+		if id == "" {
+			return ErrEmptyProductID
+		}
+	}
+	return nil
+}
+
+// priceFilterFields names the arguments of validatePriceFilters, in order.
+var priceFilterFields = []string{
+	"min_base_price",
+	"max_base_price",
+	"min_effective_price",
+	"max_effective_price",
+}
+
+// validatePriceFilters checks that optional prices, given in the order of
+// priceFilterFields, are well-formed, non-negative amounts.
+func validatePriceFilters(prices ...*pb.Money) error {
+	for i, price := range prices {
+		if price != nil && (price.GetDenominator() <= 0 || price.GetNumerator() < 0) {
+			return &fieldError{field: priceFilterFields[i], err: ErrInvalidPriceFilter}
+		}
+	}
+	return nil
+}
+
+// validateSearchProductsRequest validates SearchProductsRequest.
+func validateSearchProductsRequest(req *pb.SearchProductsRequest) error {
+	if req.GetQuery() == "" {
+		return ErrMissingQuery
+	}
+	if req.GetOffset() < 0 {
+		return ErrNegativeOffset
+	}
+	return nil
+}
+
+// validateGetProductFacetsRequest validates GetProductFacetsRequest.
+func validateGetProductFacetsRequest(req *pb.GetProductFacetsRequest) error {
+	if err := validatePriceFilters(
+		req.GetMinBasePrice(),
+		req.GetMaxBasePrice(),
+		req.GetMinEffectivePrice(),
+		req.GetMaxEffectivePrice(),
+	); err != nil {
+		return err
+	}
+	for _, boundary := range req.GetPriceBandBoundaries() {
+		if boundary == nil || boundary.GetDenominator() <= 0 || boundary.GetNumerator() < 0 {
+			return &fieldError{field: "price_band_boundaries", err: ErrInvalidPriceFilter}
+		}
+	}
+	if req.MinDiscountPercent != nil &&
+		(req.GetMinDiscountPercent() < 1 || req.GetMinDiscountPercent() > 100) {
+		return ErrInvalidMinDiscount
+	}
+	if _, ok := pb.ArchivedMode_name[int32(req.GetArchivedMode())]; !ok {
+		return ErrInvalidArchivedMode
+	}
 	return nil
 }
@@ -0,0 +1,42 @@
+-- Migration: 002_product_views
+-- Description: Denormalized read model for the CQRS query side, maintained by the projector
+-- Created: 2026-10-18
+
+-- Product views mirror products with precomputed prices so queries can filter
+-- and sort on them server-side. The effective price depends on the time and on
+-- running campaigns, so it is computed at query time from the stored discounted
+-- and base prices. Rows are written only by the projector.
+CREATE TABLE product_views (
+    product_id STRING(36) NOT NULL,
+    name STRING(255) NOT NULL,
+    description STRING(MAX),
+    category STRING(100) NOT NULL,
+    base_price_numerator INT64 NOT NULL,
+    base_price_denominator INT64 NOT NULL,
+    discount_percent NUMERIC,
+    discount_start_date TIMESTAMP,
+    discount_end_date TIMESTAMP,
+    status STRING(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    archived_at TIMESTAMP,
+    base_price NUMERIC NOT NULL,
+    discounted_price NUMERIC,
+    projected_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id);
+
+-- Index for filtering product views by status (for active products listing)
+CREATE INDEX idx_product_views_status ON product_views(status, created_at DESC);
+
+-- Index for filtering product views by category and status
+CREATE INDEX idx_product_views_category ON product_views(category, status);
+
+-- Index for sweeping rows left behind by a replay
+CREATE INDEX idx_product_views_projected_at ON product_views(projected_at);
+
+-- The projector records its progress in projected_at and leaves status to the
+-- publisher, which delivers the same events
+ALTER TABLE outbox_events ADD COLUMN projected_at TIMESTAMP;
+
+-- Index for finding the events the projector has not handled yet
+CREATE INDEX idx_outbox_projected_at ON outbox_events(projected_at, created_at);
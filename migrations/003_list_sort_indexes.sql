@@ -0,0 +1,17 @@
+-- Migration: 003_list_sort_indexes
+-- Description: Secondary indexes backing the ListProducts sort orders
+-- Created: 2026-10-18
+
+-- Spanner appends the primary key to every secondary index, so each index
+-- below also covers the product_id tiebreaker used by cursor pagination.
+
+-- Sort orders on the write table (prices are computed there, so not indexable)
+CREATE INDEX idx_products_created_at ON products(created_at DESC);
+CREATE INDEX idx_products_updated_at ON products(updated_at DESC);
+CREATE INDEX idx_products_name ON products(name);
+
+-- Sort orders on the read model, including the precomputed base price
+CREATE INDEX idx_product_views_created_at ON product_views(created_at DESC);
+CREATE INDEX idx_product_views_updated_at ON product_views(updated_at DESC);
+CREATE INDEX idx_product_views_name ON product_views(name);
+CREATE INDEX idx_product_views_base_price ON product_views(base_price);
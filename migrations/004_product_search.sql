@@ -0,0 +1,21 @@
+-- Migration: 004_product_search
+-- Description: Full-text search over product_views for the SearchProducts RPC
+-- Created: 2026-10-18
+
+-- Per-field full-text tokens, scored separately so name matches rank highest
+ALTER TABLE product_views ADD COLUMN name_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(name)) HIDDEN;
+ALTER TABLE product_views ADD COLUMN description_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(description)) HIDDEN;
+ALTER TABLE product_views ADD COLUMN category_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(category)) HIDDEN;
+
+-- Trigrams over all searchable text, used for word-prefix matches and typo
+-- tolerance. SEARCH_SUBSTRING with relative_search_type=>'word_prefix' needs
+-- the tokens built with that relative search type.
+ALTER TABLE product_views ADD COLUMN text_ngram_tokens TOKENLIST AS (
+    TOKENIZE_SUBSTRING(CONCAT(name, ' ', IFNULL(description, ''), ' ', category),
+        ngram_size_min=>3, ngram_size_max=>3, relative_search_types=>['word_prefix'])
+) HIDDEN;
+
+-- Search index storing the columns the listing filters need
+CREATE SEARCH INDEX idx_product_views_search
+    ON product_views(name_tokens, description_tokens, category_tokens, text_ngram_tokens)
+    STORING (status, category);
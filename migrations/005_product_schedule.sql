@@ -0,0 +1,16 @@
+-- Migration: 005_product_schedule
+-- Description: Scheduled activation and deactivation (publish_at / unpublish_at)
+-- Created: 2026-10-18
+
+-- Times at which the scheduler activates / deactivates a product. Each is
+-- cleared in the transaction that fires it.
+ALTER TABLE products ADD COLUMN publish_at TIMESTAMP;
+ALTER TABLE products ADD COLUMN unpublish_at TIMESTAMP;
+
+ALTER TABLE product_views ADD COLUMN publish_at TIMESTAMP;
+ALTER TABLE product_views ADD COLUMN unpublish_at TIMESTAMP;
+
+-- Null-filtered so the indexes only hold products with a pending schedule,
+-- which keeps the scheduler's due scan small.
+CREATE NULL_FILTERED INDEX idx_products_publish_at ON products(publish_at);
+CREATE NULL_FILTERED INDEX idx_products_unpublish_at ON products(unpublish_at);
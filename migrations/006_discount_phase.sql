@@ -0,0 +1,14 @@
+-- Migration: 006_discount_phase
+-- Description: Track which discount boundary was last announced
+-- Created: 2026-10-18
+
+-- none: no discount; pending: set but not started; active: start announced;
+-- expired: end announced. Existing discounts read as "none" and are caught up
+-- by the scheduler on its first pass.
+ALTER TABLE products ADD COLUMN discount_phase STRING(20) NOT NULL DEFAULT ("none");
+
+ALTER TABLE product_views ADD COLUMN discount_phase STRING(20) NOT NULL DEFAULT ("none");
+
+-- Back the scheduler's boundary scans.
+CREATE INDEX idx_products_discount_start ON products(discount_phase, discount_start_date);
+CREATE INDEX idx_products_discount_end ON products(discount_phase, discount_end_date);
@@ -0,0 +1,9 @@
+-- Migration: 007_product_version
+-- Description: Track a revision number per product
+-- Created: 2026-10-18
+
+-- Starts at 1 on creation and is incremented by every committed change.
+-- Existing products start at 1.
+ALTER TABLE products ADD COLUMN version INT64 NOT NULL DEFAULT (1);
+
+ALTER TABLE product_views ADD COLUMN version INT64 NOT NULL DEFAULT (1);
@@ -0,0 +1,11 @@
+-- Migration: 008_product_external_key
+-- Description: Importer-supplied key used to upsert products in bulk imports
+-- Created: 2026-10-18
+
+-- NULL for products created through CreateProduct.
+ALTER TABLE products ADD COLUMN external_key STRING(255);
+
+-- Null-filtered so the uniqueness check only covers imported products.
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_external_key ON products(external_key);
+
+ALTER TABLE product_views ADD COLUMN external_key STRING(255);
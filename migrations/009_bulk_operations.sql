@@ -0,0 +1,34 @@
+-- Migration: 009_bulk_operations
+-- Description: Long-running bulk updates over filtered product sets
+-- Created: 2026-10-18
+
+-- One row per BulkUpdate request. Products are processed in product_id order
+-- and last_product_id records how far the operation got, so any replica can
+-- resume it.
+CREATE TABLE bulk_operations (
+    operation_id STRING(36) NOT NULL,
+    command STRING(32) NOT NULL,
+    params JSON,
+    filters JSON NOT NULL,
+    status STRING(20) NOT NULL,
+    last_product_id STRING(36),
+    matched_count INT64 NOT NULL,
+    processed_count INT64 NOT NULL,
+    succeeded_count INT64 NOT NULL,
+    failed_count INT64 NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP,
+) PRIMARY KEY (operation_id);
+
+-- Index for the worker picking up running operations
+CREATE INDEX idx_bulk_operations_status ON bulk_operations(status, created_at);
+
+-- Products the command was rejected for, with the domain error message.
+CREATE TABLE bulk_operation_failures (
+    operation_id STRING(36) NOT NULL,
+    product_id STRING(36) NOT NULL,
+    message STRING(MAX) NOT NULL,
+    failed_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (operation_id, product_id),
+  INTERLEAVE IN PARENT bulk_operations ON DELETE CASCADE;
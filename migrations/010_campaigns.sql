@@ -0,0 +1,25 @@
+-- Migration: 010_campaigns
+-- Description: Promotion campaigns discounting a selection of products
+-- Created: 2026-10-18
+
+-- One row per campaign. The selection is stored on the row: a product is in
+-- the campaign if its ID is in product_ids or its category is in categories.
+-- ended_at is set when the campaign is ended before end_date.
+CREATE TABLE campaigns (
+    campaign_id STRING(36) NOT NULL,
+    name STRING(255) NOT NULL,
+    discount_percent INT64 NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    product_ids ARRAY<STRING(36)> NOT NULL,
+    categories ARRAY<STRING(100)> NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (campaign_id);
+
+-- Index for finding the campaigns running at a given time
+CREATE INDEX idx_campaigns_end_date ON campaigns(end_date);
+
+-- Index for listing campaigns newest first
+CREATE INDEX idx_campaigns_created_at ON campaigns(created_at DESC);
@@ -0,0 +1,36 @@
+-- Migration: 011_coupons
+-- Description: Coupon codes and their redemptions
+-- Created: 2026-10-18
+
+-- One row per coupon. A coupon takes either percentage or the amount_*
+-- columns off each eligible product; empty product_ids and categories make
+-- every product eligible. max_redemptions is 0 for no limit.
+CREATE TABLE coupons (
+    coupon_id STRING(36) NOT NULL,
+    code STRING(64) NOT NULL,
+    percentage INT64,
+    amount_off_numerator INT64,
+    amount_off_denominator INT64,
+    product_ids ARRAY<STRING(36)> NOT NULL,
+    categories ARRAY<STRING(100)> NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    max_redemptions INT64 NOT NULL,
+    redemption_count INT64 NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (coupon_id);
+
+-- Codes are unique and looked up on every validation
+CREATE UNIQUE INDEX idx_coupons_code ON coupons(code);
+
+-- One row per order a coupon was redeemed for, with the eligible products.
+-- Keying on the order makes a retried redemption fail instead of counting
+-- twice.
+CREATE TABLE coupon_redemptions (
+    coupon_id STRING(36) NOT NULL,
+    order_id STRING(100) NOT NULL,
+    product_ids ARRAY<STRING(36)> NOT NULL,
+    redeemed_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (coupon_id, order_id),
+  INTERLEAVE IN PARENT coupons ON DELETE CASCADE;
@@ -0,0 +1,29 @@
+-- Migration: 012_categories
+-- Description: Category tree, and products referencing it by ID
+-- Created: 2026-10-18
+
+-- One row per node of the category tree; parent_id is NULL for roots.
+-- display_name is copied into products.category for the products assigned
+-- to the category.
+CREATE TABLE categories (
+    category_id STRING(36) NOT NULL,
+    slug STRING(100) NOT NULL,
+    parent_id STRING(36),
+    display_name STRING(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (category_id);
+
+-- Slugs are unique and used to look categories up
+CREATE UNIQUE INDEX idx_categories_slug ON categories(slug);
+
+-- Children of a category, for listing the tree and its descendants
+CREATE INDEX idx_categories_parent ON categories(parent_id);
+
+-- NULL for products whose category is free-form.
+ALTER TABLE products ADD COLUMN category_id STRING(36);
+
+-- Null-filtered: only products in the tree are looked up by category ID.
+CREATE NULL_FILTERED INDEX idx_products_category_id ON products(category_id);
+
+ALTER TABLE product_views ADD COLUMN category_id STRING(36);
@@ -0,0 +1,9 @@
+-- Migration: 013_product_attributes
+-- Description: Free-form product attributes, checked by the activation policy
+-- Created: 2026-10-18
+
+-- Attributes are a JSON object of string values by name, NULL when a product
+-- has none. The read model carries them too.
+ALTER TABLE products ADD COLUMN attributes JSON;
+
+ALTER TABLE product_views ADD COLUMN attributes JSON;
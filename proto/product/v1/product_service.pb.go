@@ -6,9 +6,132 @@ package productv1
 import (
 	"time"
 
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ArchivedMode controls whether listings include archived products.
+type ArchivedMode int32
+
+const (
+	ArchivedMode_ARCHIVED_MODE_UNSPECIFIED ArchivedMode = 0
+	ArchivedMode_ARCHIVED_MODE_EXCLUDE     ArchivedMode = 1
+	ArchivedMode_ARCHIVED_MODE_INCLUDE     ArchivedMode = 2
+	ArchivedMode_ARCHIVED_MODE_ONLY        ArchivedMode = 3
+)
+
+var ArchivedMode_name = map[int32]string{
+	0: "ARCHIVED_MODE_UNSPECIFIED",
+	1: "ARCHIVED_MODE_EXCLUDE",
+	2: "ARCHIVED_MODE_INCLUDE",
+	3: "ARCHIVED_MODE_ONLY",
+}
+
+var ArchivedMode_value = map[string]int32{
+	"ARCHIVED_MODE_UNSPECIFIED": 0,
+	"ARCHIVED_MODE_EXCLUDE":     1,
+	"ARCHIVED_MODE_INCLUDE":     2,
+	"ARCHIVED_MODE_ONLY":        3,
+}
+
+func (x ArchivedMode) String() string {
+	return ArchivedMode_name[int32(x)]
+}
+
+// ImportFormat is the encoding of an ImportProducts source.
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	ImportFormat_IMPORT_FORMAT_CSV         ImportFormat = 1
+	ImportFormat_IMPORT_FORMAT_NDJSON      ImportFormat = 2
+)
+
+var ImportFormat_name = map[int32]string{
+	0: "IMPORT_FORMAT_UNSPECIFIED",
+	1: "IMPORT_FORMAT_CSV",
+	2: "IMPORT_FORMAT_NDJSON",
+}
+
+var ImportFormat_value = map[string]int32{
+	"IMPORT_FORMAT_UNSPECIFIED": 0,
+	"IMPORT_FORMAT_CSV":         1,
+	"IMPORT_FORMAT_NDJSON":      2,
+}
+
+func (x ImportFormat) String() string {
+	return ImportFormat_name[int32(x)]
+}
+
+// ExportFormat is the encoding of an ExportProducts stream.
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_PROTO       ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_NDJSON      ExportFormat = 3
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "EXPORT_FORMAT_UNSPECIFIED",
+	1: "EXPORT_FORMAT_PROTO",
+	2: "EXPORT_FORMAT_CSV",
+	3: "EXPORT_FORMAT_NDJSON",
+}
+
+var ExportFormat_value = map[string]int32{
+	"EXPORT_FORMAT_UNSPECIFIED": 0,
+	"EXPORT_FORMAT_PROTO":       1,
+	"EXPORT_FORMAT_CSV":         2,
+	"EXPORT_FORMAT_NDJSON":      3,
+}
+
+func (x ExportFormat) String() string {
+	return ExportFormat_name[int32(x)]
+}
+
+// BulkCommand is the command a bulk operation runs on every matching product.
+type BulkCommand int32
+
+const (
+	BulkCommand_BULK_COMMAND_UNSPECIFIED     BulkCommand = 0
+	BulkCommand_BULK_COMMAND_ACTIVATE        BulkCommand = 1
+	BulkCommand_BULK_COMMAND_DEACTIVATE      BulkCommand = 2
+	BulkCommand_BULK_COMMAND_ARCHIVE         BulkCommand = 3
+	BulkCommand_BULK_COMMAND_APPLY_DISCOUNT  BulkCommand = 4
+	BulkCommand_BULK_COMMAND_REMOVE_DISCOUNT BulkCommand = 5
+	BulkCommand_BULK_COMMAND_CHANGE_CATEGORY BulkCommand = 6
+	BulkCommand_BULK_COMMAND_ASSIGN_CATEGORY BulkCommand = 7
+)
+
+var BulkCommand_name = map[int32]string{
+	0: "BULK_COMMAND_UNSPECIFIED",
+	1: "BULK_COMMAND_ACTIVATE",
+	2: "BULK_COMMAND_DEACTIVATE",
+	3: "BULK_COMMAND_ARCHIVE",
+	4: "BULK_COMMAND_APPLY_DISCOUNT",
+	5: "BULK_COMMAND_REMOVE_DISCOUNT",
+	6: "BULK_COMMAND_CHANGE_CATEGORY",
+	7: "BULK_COMMAND_ASSIGN_CATEGORY",
+}
+
+var BulkCommand_value = map[string]int32{
+	"BULK_COMMAND_UNSPECIFIED":     0,
+	"BULK_COMMAND_ACTIVATE":        1,
+	"BULK_COMMAND_DEACTIVATE":      2,
+	"BULK_COMMAND_ARCHIVE":         3,
+	"BULK_COMMAND_APPLY_DISCOUNT":  4,
+	"BULK_COMMAND_REMOVE_DISCOUNT": 5,
+	"BULK_COMMAND_CHANGE_CATEGORY": 6,
+	"BULK_COMMAND_ASSIGN_CATEGORY": 7,
+}
+
+func (x BulkCommand) String() string {
+	return BulkCommand_name[int32(x)]
+}
+
 // Money represents a monetary value with precise arithmetic.
 type Money struct {
 	Numerator   int64 `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
@@ -69,6 +192,16 @@ type Product struct {
 	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ArchivedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	PublishAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	// Revision number, incremented by every committed change.
+	Version int64 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// Importer-supplied key, set only for products created by ImportProducts.
+	ExternalKey string `protobuf:"bytes,15,opt,name=external_key,json=externalKey,proto3" json:"external_key,omitempty"`
+	CategoryId  string `protobuf:"bytes,16,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Free-form name/value attributes, e.g. "brand".
+	Attributes map[string]string `protobuf:"bytes,17,rep,name=attributes,proto3" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" json:"attributes,omitempty"`
 }
 
 func (p *Product) GetId() string {
@@ -141,6 +274,55 @@ func (p *Product) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (p *Product) GetArchivedAt() *timestamppb.Timestamp {
+	if p != nil {
+		return p.ArchivedAt
+	}
+	return nil
+}
+
+func (p *Product) GetPublishAt() *timestamppb.Timestamp {
+	if p != nil {
+		return p.PublishAt
+	}
+	return nil
+}
+
+func (p *Product) GetUnpublishAt() *timestamppb.Timestamp {
+	if p != nil {
+		return p.UnpublishAt
+	}
+	return nil
+}
+
+func (p *Product) GetVersion() int64 {
+	if p != nil {
+		return p.Version
+	}
+	return 0
+}
+
+func (p *Product) GetExternalKey() string {
+	if p != nil {
+		return p.ExternalKey
+	}
+	return ""
+}
+
+func (p *Product) GetCategoryId() string {
+	if p != nil {
+		return p.CategoryId
+	}
+	return ""
+}
+
+func (p *Product) GetAttributes() map[string]string {
+	if p != nil {
+		return p.Attributes
+	}
+	return nil
+}
+
 // ProductListItem represents a product in a list response.
 type ProductListItem struct {
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -152,6 +334,8 @@ type ProductListItem struct {
 	DiscountPercent *int64                 `protobuf:"varint,7,opt,name=discount_percent,json=discountPercent,proto3,oneof" json:"discount_percent,omitempty"`
 	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	CategoryId      string                 `protobuf:"bytes,11,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 }
 
 func (p *ProductListItem) GetId() string {
@@ -217,12 +401,28 @@ func (p *ProductListItem) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (p *ProductListItem) GetArchivedAt() *timestamppb.Timestamp {
+	if p != nil {
+		return p.ArchivedAt
+	}
+	return nil
+}
+
+func (p *ProductListItem) GetCategoryId() string {
+	if p != nil {
+		return p.CategoryId
+	}
+	return ""
+}
+
 // CreateProductRequest is the request to create a new product.
 type CreateProductRequest struct {
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Category    string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	BasePrice   *Money `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Category     string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	BasePrice    *Money `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	ValidateOnly bool   `protobuf:"varint,5,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	CategoryId   string `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 }
 
 func (r *CreateProductRequest) GetName() string {
@@ -253,9 +453,61 @@ func (r *CreateProductRequest) GetBasePrice() *Money {
 	return nil
 }
 
+func (r *CreateProductRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+func (r *CreateProductRequest) GetCategoryId() string {
+	if r != nil {
+		return r.CategoryId
+	}
+	return ""
+}
+
+// DomainEvent is an event emitted by a command, as written to the outbox.
+type DomainEvent struct {
+	EventType   string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	AggregateId string                 `protobuf:"bytes,2,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	OccurredAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Payload     *structpb.Struct       `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (e *DomainEvent) GetEventType() string {
+	if e != nil {
+		return e.EventType
+	}
+	return ""
+}
+
+func (e *DomainEvent) GetAggregateId() string {
+	if e != nil {
+		return e.AggregateId
+	}
+	return ""
+}
+
+func (e *DomainEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if e != nil {
+		return e.OccurredAt
+	}
+	return nil
+}
+
+func (e *DomainEvent) GetPayload() *structpb.Struct {
+	if e != nil {
+		return e.Payload
+	}
+	return nil
+}
+
 // CreateProductReply is the response after creating a product.
 type CreateProductReply struct {
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductId string         `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Product   *Product       `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Events    []*DomainEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
 }
 
 func (r *CreateProductReply) GetProductId() string {
@@ -265,12 +517,36 @@ func (r *CreateProductReply) GetProductId() string {
 	return ""
 }
 
+func (r *CreateProductReply) GetProduct() *Product {
+	if r != nil {
+		return r.Product
+	}
+	return nil
+}
+
+func (r *CreateProductReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
 // UpdateProductRequest is the request to update a product.
 type UpdateProductRequest struct {
 	ProductId   string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Category    string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// Fields to change: "name", "description", "category", "category_id",
+	// "attributes". When unset, all but attributes are replaced and name
+	// and one of category and category_id are required.
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ValidateOnly    bool                   `protobuf:"varint,6,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	CategoryId      string                 `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,8,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// Replaces the product's attributes when the mask names "attributes";
+	// an empty map clears them.
+	Attributes map[string]string `protobuf:"bytes,9,rep,name=attributes,proto3" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" json:"attributes,omitempty"`
 }
 
 func (r *UpdateProductRequest) GetProductId() string {
@@ -301,198 +577,2670 @@ func (r *UpdateProductRequest) GetCategory() string {
 	return ""
 }
 
-// UpdateProductReply is the response after updating a product.
-type UpdateProductReply struct{}
+func (r *UpdateProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if r != nil {
+		return r.UpdateMask
+	}
+	return nil
+}
 
-// ActivateProductRequest is the request to activate a product.
-type ActivateProductRequest struct {
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+func (r *UpdateProductRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
 }
 
-func (r *ActivateProductRequest) GetProductId() string {
+func (r *UpdateProductRequest) GetCategoryId() string {
 	if r != nil {
-		return r.ProductId
+		return r.CategoryId
 	}
 	return ""
 }
 
-// ActivateProductReply is the response after activating a product.
-type ActivateProductReply struct{}
-
-// DeactivateProductRequest is the request to deactivate a product.
-type DeactivateProductRequest struct {
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+func (r *UpdateProductRequest) GetExpectedVersion() int64 {
+	if r != nil {
+		return r.ExpectedVersion
+	}
+	return 0
 }
 
-func (r *DeactivateProductRequest) GetProductId() string {
+func (r *UpdateProductRequest) GetAttributes() map[string]string {
 	if r != nil {
-		return r.ProductId
+		return r.Attributes
 	}
-	return ""
+	return nil
 }
 
-// DeactivateProductReply is the response after deactivating a product.
-type DeactivateProductReply struct{}
-
-// ArchiveProductRequest is the request to archive a product.
-type ArchiveProductRequest struct {
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+// UpdateProductReply is the response after updating a product.
+type UpdateProductReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (r *ArchiveProductRequest) GetProductId() string {
+func (r *UpdateProductReply) GetProduct() *Product {
 	if r != nil {
-		return r.ProductId
+		return r.Product
 	}
-	return ""
+	return nil
 }
 
-// ArchiveProductReply is the response after archiving a product.
-type ArchiveProductReply struct{}
+func (r *UpdateProductReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
 
-// ApplyDiscountRequest is the request to apply a discount to a product.
-type ApplyDiscountRequest struct {
-	ProductId  string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Percentage int64                  `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	StartDate  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+// ActivateProductRequest is the request to activate a product.
+type ActivateProductRequest struct {
+	ProductId       string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ValidateOnly    bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
-func (r *ApplyDiscountRequest) GetProductId() string {
+func (r *ActivateProductRequest) GetProductId() string {
 	if r != nil {
 		return r.ProductId
 	}
 	return ""
 }
 
-func (r *ApplyDiscountRequest) GetPercentage() int64 {
+func (r *ActivateProductRequest) GetValidateOnly() bool {
 	if r != nil {
-		return r.Percentage
+		return r.ValidateOnly
+	}
+	return false
+}
+
+func (r *ActivateProductRequest) GetExpectedVersion() int64 {
+	if r != nil {
+		return r.ExpectedVersion
 	}
 	return 0
 }
 
-func (r *ApplyDiscountRequest) GetStartDate() *timestamppb.Timestamp {
+// ActivateProductReply is the response after activating a product.
+type ActivateProductReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *ActivateProductReply) GetProduct() *Product {
 	if r != nil {
-		return r.StartDate
+		return r.Product
 	}
 	return nil
 }
 
-func (r *ApplyDiscountRequest) GetEndDate() *timestamppb.Timestamp {
+func (r *ActivateProductReply) GetEvents() []*DomainEvent {
 	if r != nil {
-		return r.EndDate
+		return r.Events
 	}
 	return nil
 }
 
-// ApplyDiscountReply is the response after applying a discount.
-type ApplyDiscountReply struct{}
-
-// RemoveDiscountRequest is the request to remove a discount from a product.
-type RemoveDiscountRequest struct {
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+// DeactivateProductRequest is the request to deactivate a product.
+type DeactivateProductRequest struct {
+	ProductId       string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ValidateOnly    bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
-func (r *RemoveDiscountRequest) GetProductId() string {
+func (r *DeactivateProductRequest) GetProductId() string {
 	if r != nil {
 		return r.ProductId
 	}
 	return ""
 }
 
-// RemoveDiscountReply is the response after removing a discount.
-type RemoveDiscountReply struct{}
-
-// GetProductRequest is the request to get a product by ID.
-type GetProductRequest struct {
-	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+func (r *DeactivateProductRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
 }
 
-func (r *GetProductRequest) GetProductId() string {
+func (r *DeactivateProductRequest) GetExpectedVersion() int64 {
 	if r != nil {
-		return r.ProductId
+		return r.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-// GetProductReply is the response containing a product.
-type GetProductReply struct {
-	Product *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+// DeactivateProductReply is the response after deactivating a product.
+type DeactivateProductReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (r *GetProductReply) GetProduct() *Product {
+func (r *DeactivateProductReply) GetProduct() *Product {
 	if r != nil {
 		return r.Product
 	}
 	return nil
 }
 
-// ListProductsRequest is the request to list products.
-type ListProductsRequest struct {
-	Category   *string `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
-	Status     *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	ActiveOnly bool    `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	Limit      int32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset     int32   `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+func (r *DeactivateProductReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
 }
 
-func (r *ListProductsRequest) GetCategory() string {
-	if r != nil && r.Category != nil {
-		return *r.Category
-	}
-	return ""
+// ArchiveProductRequest is the request to archive a product.
+type ArchiveProductRequest struct {
+	ProductId       string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ValidateOnly    bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
-func (r *ListProductsRequest) GetStatus() string {
-	if r != nil && r.Status != nil {
-		return *r.Status
+func (r *ArchiveProductRequest) GetProductId() string {
+	if r != nil {
+		return r.ProductId
 	}
 	return ""
 }
 
-func (r *ListProductsRequest) GetActiveOnly() bool {
+func (r *ArchiveProductRequest) GetValidateOnly() bool {
 	if r != nil {
-		return r.ActiveOnly
+		return r.ValidateOnly
 	}
 	return false
 }
 
-func (r *ListProductsRequest) GetLimit() int32 {
+func (r *ArchiveProductRequest) GetExpectedVersion() int64 {
 	if r != nil {
-		return r.Limit
+		return r.ExpectedVersion
 	}
 	return 0
 }
 
-func (r *ListProductsRequest) GetOffset() int32 {
+// ArchiveProductReply is the response after archiving a product.
+type ArchiveProductReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *ArchiveProductReply) GetProduct() *Product {
 	if r != nil {
-		return r.Offset
+		return r.Product
 	}
-	return 0
+	return nil
 }
 
-// ListProductsReply is the response containing a list of products.
-type ListProductsReply struct {
-	Products   []*ProductListItem `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	TotalCount int64              `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasMore    bool               `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+func (r *ArchiveProductReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
 }
 
-func (r *ListProductsReply) GetProducts() []*ProductListItem {
+// RestoreProductRequest is the request to restore an archived product.
+type RestoreProductRequest struct {
+	ProductId       string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ValidateOnly    bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (r *RestoreProductRequest) GetProductId() string {
 	if r != nil {
-		return r.Products
+		return r.ProductId
 	}
-	return nil
+	return ""
 }
 
-func (r *ListProductsReply) GetTotalCount() int64 {
+func (r *RestoreProductRequest) GetValidateOnly() bool {
 	if r != nil {
-		return r.TotalCount
+		return r.ValidateOnly
 	}
-	return 0
+	return false
 }
 
-func (r *ListProductsReply) GetHasMore() bool {
+func (r *RestoreProductRequest) GetExpectedVersion() int64 {
+	if r != nil {
+		return r.ExpectedVersion
+	}
+	return 0
+}
+
+// RestoreProductReply is the response after restoring a product.
+type RestoreProductReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *RestoreProductReply) GetProduct() *Product {
+	if r != nil {
+		return r.Product
+	}
+	return nil
+}
+
+func (r *RestoreProductReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// TransitionProductRequest is the request to move a product to another
+// status of the configured lifecycle, e.g. "pending_review" or "discontinued".
+type TransitionProductRequest struct {
+	ProductId       string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	TargetStatus    string `protobuf:"bytes,2,opt,name=target_status,json=targetStatus,proto3" json:"target_status,omitempty"`
+	ValidateOnly    bool   `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (r *TransitionProductRequest) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+func (r *TransitionProductRequest) GetTargetStatus() string {
+	if r != nil {
+		return r.TargetStatus
+	}
+	return ""
+}
+
+func (r *TransitionProductRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+func (r *TransitionProductRequest) GetExpectedVersion() int64 {
+	if r != nil {
+		return r.ExpectedVersion
+	}
+	return 0
+}
+
+// TransitionProductReply is the response after transitioning a product.
+type TransitionProductReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *TransitionProductReply) GetProduct() *Product {
+	if r != nil {
+		return r.Product
+	}
+	return nil
+}
+
+func (r *TransitionProductReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// PurgeProductRequest is the admin request to permanently delete an archived
+// product whose purge retention period has elapsed.
+type PurgeProductRequest struct {
+	ProductId    string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ValidateOnly bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *PurgeProductRequest) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+func (r *PurgeProductRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// PurgeProductReply is the response after purging a product.
+type PurgeProductReply struct {
+	Events []*DomainEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *PurgeProductReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// ImportProductsRequest carries one chunk of an import source. Format and
+// ValidateOnly are read from the first message only. Chunks are joined in
+// order, so a row may span several messages.
+type ImportProductsRequest struct {
+	Format       ImportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=product.v1.ImportFormat" json:"format,omitempty"`
+	Chunk        []byte       `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	ValidateOnly bool         `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *ImportProductsRequest) GetFormat() ImportFormat {
+	if r != nil {
+		return r.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (r *ImportProductsRequest) GetChunk() []byte {
+	if r != nil {
+		return r.Chunk
+	}
+	return nil
+}
+
+func (r *ImportProductsRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// ImportRowResult is the outcome of one imported row.
+type ImportRowResult struct {
+	Line         int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	ExternalKey  string `protobuf:"bytes,2,opt,name=external_key,json=externalKey,proto3" json:"external_key,omitempty"`
+	ProductId    string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Action       string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ErrorReason  string `protobuf:"bytes,5,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ErrorField   string `protobuf:"bytes,7,opt,name=error_field,json=errorField,proto3" json:"error_field,omitempty"`
+}
+
+func (r *ImportRowResult) GetLine() int32 {
+	if r != nil {
+		return r.Line
+	}
+	return 0
+}
+
+func (r *ImportRowResult) GetExternalKey() string {
+	if r != nil {
+		return r.ExternalKey
+	}
+	return ""
+}
+
+func (r *ImportRowResult) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+func (r *ImportRowResult) GetAction() string {
+	if r != nil {
+		return r.Action
+	}
+	return ""
+}
+
+func (r *ImportRowResult) GetErrorReason() string {
+	if r != nil {
+		return r.ErrorReason
+	}
+	return ""
+}
+
+func (r *ImportRowResult) GetErrorMessage() string {
+	if r != nil {
+		return r.ErrorMessage
+	}
+	return ""
+}
+
+func (r *ImportRowResult) GetErrorField() string {
+	if r != nil {
+		return r.ErrorField
+	}
+	return ""
+}
+
+// ImportProductsReply reports the rows of an import in source order, capped
+// for large imports; the counts cover every row.
+type ImportProductsReply struct {
+	Rows      []*ImportRowResult `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Created   int32              `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   int32              `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged int32              `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Failed    int32              `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Set when some rows are left out of rows.
+	Truncated bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (r *ImportProductsReply) GetRows() []*ImportRowResult {
+	if r != nil {
+		return r.Rows
+	}
+	return nil
+}
+
+func (r *ImportProductsReply) GetCreated() int32 {
+	if r != nil {
+		return r.Created
+	}
+	return 0
+}
+
+func (r *ImportProductsReply) GetUpdated() int32 {
+	if r != nil {
+		return r.Updated
+	}
+	return 0
+}
+
+func (r *ImportProductsReply) GetUnchanged() int32 {
+	if r != nil {
+		return r.Unchanged
+	}
+	return 0
+}
+
+func (r *ImportProductsReply) GetFailed() int32 {
+	if r != nil {
+		return r.Failed
+	}
+	return 0
+}
+
+func (r *ImportProductsReply) GetTruncated() bool {
+	if r != nil {
+		return r.Truncated
+	}
+	return false
+}
+
+// BulkUpdateRequest is the request to run a command on every product matching the filters.
+type BulkUpdateRequest struct {
+	Category           *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Status             *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ActiveOnly         bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	ArchivedMode       ArchivedMode           `protobuf:"varint,4,opt,name=archived_mode,json=archivedMode,proto3,enum=product.v1.ArchivedMode" json:"archived_mode,omitempty"`
+	MinBasePrice       *Money                 `protobuf:"bytes,5,opt,name=min_base_price,json=minBasePrice,proto3" json:"min_base_price,omitempty"`
+	MaxBasePrice       *Money                 `protobuf:"bytes,6,opt,name=max_base_price,json=maxBasePrice,proto3" json:"max_base_price,omitempty"`
+	MinEffectivePrice  *Money                 `protobuf:"bytes,7,opt,name=min_effective_price,json=minEffectivePrice,proto3" json:"min_effective_price,omitempty"`
+	MaxEffectivePrice  *Money                 `protobuf:"bytes,8,opt,name=max_effective_price,json=maxEffectivePrice,proto3" json:"max_effective_price,omitempty"`
+	OnSaleAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=on_sale_at,json=onSaleAt,proto3" json:"on_sale_at,omitempty"`
+	MinDiscountPercent *int64                 `protobuf:"varint,10,opt,name=min_discount_percent,json=minDiscountPercent,proto3,oneof" json:"min_discount_percent,omitempty"`
+	Command            BulkCommand            `protobuf:"varint,11,opt,name=command,proto3,enum=product.v1.BulkCommand" json:"command,omitempty"`
+	Percentage         int64                  `protobuf:"varint,12,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	StartDate          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	NewCategory        string                 `protobuf:"bytes,15,opt,name=new_category,json=newCategory,proto3" json:"new_category,omitempty"`
+	ValidateOnly       bool                   `protobuf:"varint,16,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *BulkUpdateRequest) GetCategory() string {
+	if r != nil && r.Category != nil {
+		return *r.Category
+	}
+	return ""
+}
+
+func (r *BulkUpdateRequest) GetStatus() string {
+	if r != nil && r.Status != nil {
+		return *r.Status
+	}
+	return ""
+}
+
+func (r *BulkUpdateRequest) GetActiveOnly() bool {
+	if r != nil {
+		return r.ActiveOnly
+	}
+	return false
+}
+
+func (r *BulkUpdateRequest) GetArchivedMode() ArchivedMode {
+	if r != nil {
+		return r.ArchivedMode
+	}
+	return ArchivedMode_ARCHIVED_MODE_UNSPECIFIED
+}
+
+func (r *BulkUpdateRequest) GetMinBasePrice() *Money {
+	if r != nil {
+		return r.MinBasePrice
+	}
+	return nil
+}
+
+func (r *BulkUpdateRequest) GetMaxBasePrice() *Money {
+	if r != nil {
+		return r.MaxBasePrice
+	}
+	return nil
+}
+
+func (r *BulkUpdateRequest) GetMinEffectivePrice() *Money {
+	if r != nil {
+		return r.MinEffectivePrice
+	}
+	return nil
+}
+
+func (r *BulkUpdateRequest) GetMaxEffectivePrice() *Money {
+	if r != nil {
+		return r.MaxEffectivePrice
+	}
+	return nil
+}
+
+func (r *BulkUpdateRequest) GetOnSaleAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.OnSaleAt
+	}
+	return nil
+}
+
+func (r *BulkUpdateRequest) GetMinDiscountPercent() int64 {
+	if r != nil && r.MinDiscountPercent != nil {
+		return *r.MinDiscountPercent
+	}
+	return 0
+}
+
+func (r *BulkUpdateRequest) GetCommand() BulkCommand {
+	if r != nil {
+		return r.Command
+	}
+	return BulkCommand_BULK_COMMAND_UNSPECIFIED
+}
+
+func (r *BulkUpdateRequest) GetPercentage() int64 {
+	if r != nil {
+		return r.Percentage
+	}
+	return 0
+}
+
+func (r *BulkUpdateRequest) GetStartDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.StartDate
+	}
+	return nil
+}
+
+func (r *BulkUpdateRequest) GetEndDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.EndDate
+	}
+	return nil
+}
+
+func (r *BulkUpdateRequest) GetNewCategory() string {
+	if r != nil {
+		return r.NewCategory
+	}
+	return ""
+}
+
+func (r *BulkUpdateRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// BulkOperationFailure is a product a bulk operation could not be applied to.
+type BulkOperationFailure struct {
+	ProductId    string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ErrorReason  string `protobuf:"bytes,2,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (r *BulkOperationFailure) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+func (r *BulkOperationFailure) GetErrorReason() string {
+	if r != nil {
+		return r.ErrorReason
+	}
+	return ""
+}
+
+func (r *BulkOperationFailure) GetErrorMessage() string {
+	if r != nil {
+		return r.ErrorMessage
+	}
+	return ""
+}
+
+// BulkOperation reports the progress of a bulk update.
+type BulkOperation struct {
+	OperationId    string                  `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Command        BulkCommand             `protobuf:"varint,2,opt,name=command,proto3,enum=product.v1.BulkCommand" json:"command,omitempty"`
+	Status         string                  `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	MatchedCount   int64                   `protobuf:"varint,4,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	ProcessedCount int64                   `protobuf:"varint,5,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
+	SucceededCount int64                   `protobuf:"varint,6,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	FailedCount    int64                   `protobuf:"varint,7,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Failures       []*BulkOperationFailure `protobuf:"bytes,8,rep,name=failures,proto3" json:"failures,omitempty"`
+	CreatedAt      *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt    *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Done           bool                    `protobuf:"varint,12,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (r *BulkOperation) GetOperationId() string {
+	if r != nil {
+		return r.OperationId
+	}
+	return ""
+}
+
+func (r *BulkOperation) GetCommand() BulkCommand {
+	if r != nil {
+		return r.Command
+	}
+	return BulkCommand_BULK_COMMAND_UNSPECIFIED
+}
+
+func (r *BulkOperation) GetStatus() string {
+	if r != nil {
+		return r.Status
+	}
+	return ""
+}
+
+func (r *BulkOperation) GetMatchedCount() int64 {
+	if r != nil {
+		return r.MatchedCount
+	}
+	return 0
+}
+
+func (r *BulkOperation) GetProcessedCount() int64 {
+	if r != nil {
+		return r.ProcessedCount
+	}
+	return 0
+}
+
+func (r *BulkOperation) GetSucceededCount() int64 {
+	if r != nil {
+		return r.SucceededCount
+	}
+	return 0
+}
+
+func (r *BulkOperation) GetFailedCount() int64 {
+	if r != nil {
+		return r.FailedCount
+	}
+	return 0
+}
+
+func (r *BulkOperation) GetFailures() []*BulkOperationFailure {
+	if r != nil {
+		return r.Failures
+	}
+	return nil
+}
+
+func (r *BulkOperation) GetCreatedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.CreatedAt
+	}
+	return nil
+}
+
+func (r *BulkOperation) GetUpdatedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.UpdatedAt
+	}
+	return nil
+}
+
+func (r *BulkOperation) GetCompletedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.CompletedAt
+	}
+	return nil
+}
+
+func (r *BulkOperation) GetDone() bool {
+	if r != nil {
+		return r.Done
+	}
+	return false
+}
+
+// BulkUpdateReply is the response after starting a bulk update.
+type BulkUpdateReply struct {
+	Operation *BulkOperation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (r *BulkUpdateReply) GetOperation() *BulkOperation {
+	if r != nil {
+		return r.Operation
+	}
+	return nil
+}
+
+// ScheduleProductRequest sets when a product is activated and deactivated
+// automatically. An unset time clears that side of the schedule.
+type ScheduleProductRequest struct {
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PublishAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	ValidateOnly    bool                   `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (r *ScheduleProductRequest) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+func (r *ScheduleProductRequest) GetPublishAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.PublishAt
+	}
+	return nil
+}
+
+func (r *ScheduleProductRequest) GetUnpublishAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.UnpublishAt
+	}
+	return nil
+}
+
+func (r *ScheduleProductRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+func (r *ScheduleProductRequest) GetExpectedVersion() int64 {
+	if r != nil {
+		return r.ExpectedVersion
+	}
+	return 0
+}
+
+// ScheduleProductReply is the response after scheduling a product.
+type ScheduleProductReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *ScheduleProductReply) GetProduct() *Product {
+	if r != nil {
+		return r.Product
+	}
+	return nil
+}
+
+func (r *ScheduleProductReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// ApplyDiscountRequest is the request to apply a discount to a product.
+type ApplyDiscountRequest struct {
+	ProductId       string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Percentage      int64                  `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ValidateOnly    bool                   `protobuf:"varint,5,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (r *ApplyDiscountRequest) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+func (r *ApplyDiscountRequest) GetPercentage() int64 {
+	if r != nil {
+		return r.Percentage
+	}
+	return 0
+}
+
+func (r *ApplyDiscountRequest) GetStartDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.StartDate
+	}
+	return nil
+}
+
+func (r *ApplyDiscountRequest) GetEndDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.EndDate
+	}
+	return nil
+}
+
+func (r *ApplyDiscountRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+func (r *ApplyDiscountRequest) GetExpectedVersion() int64 {
+	if r != nil {
+		return r.ExpectedVersion
+	}
+	return 0
+}
+
+// ApplyDiscountReply is the response after applying a discount.
+type ApplyDiscountReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *ApplyDiscountReply) GetProduct() *Product {
+	if r != nil {
+		return r.Product
+	}
+	return nil
+}
+
+func (r *ApplyDiscountReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// RemoveDiscountRequest is the request to remove a discount from a product.
+type RemoveDiscountRequest struct {
+	ProductId       string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ValidateOnly    bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (r *RemoveDiscountRequest) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+func (r *RemoveDiscountRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+func (r *RemoveDiscountRequest) GetExpectedVersion() int64 {
+	if r != nil {
+		return r.ExpectedVersion
+	}
+	return 0
+}
+
+// RemoveDiscountReply is the response after removing a discount.
+type RemoveDiscountReply struct {
+	Product *Product       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *RemoveDiscountReply) GetProduct() *Product {
+	if r != nil {
+		return r.Product
+	}
+	return nil
+}
+
+func (r *RemoveDiscountReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// Campaign is a promotion discounting a selection of products for a time
+// window. Status is "scheduled", "running" or "ended" at the time of the reply.
+type Campaign struct {
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Percentage int64                  `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	StartDate  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	EndedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Status     string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ProductIds []string               `protobuf:"bytes,8,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories []string               `protobuf:"bytes,9,rep,name=categories,proto3" json:"categories,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (r *Campaign) GetCampaignId() string {
+	if r != nil {
+		return r.CampaignId
+	}
+	return ""
+}
+
+func (r *Campaign) GetName() string {
+	if r != nil {
+		return r.Name
+	}
+	return ""
+}
+
+func (r *Campaign) GetPercentage() int64 {
+	if r != nil {
+		return r.Percentage
+	}
+	return 0
+}
+
+func (r *Campaign) GetStartDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.StartDate
+	}
+	return nil
+}
+
+func (r *Campaign) GetEndDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.EndDate
+	}
+	return nil
+}
+
+func (r *Campaign) GetEndedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.EndedAt
+	}
+	return nil
+}
+
+func (r *Campaign) GetStatus() string {
+	if r != nil {
+		return r.Status
+	}
+	return ""
+}
+
+func (r *Campaign) GetProductIds() []string {
+	if r != nil {
+		return r.ProductIds
+	}
+	return nil
+}
+
+func (r *Campaign) GetCategories() []string {
+	if r != nil {
+		return r.Categories
+	}
+	return nil
+}
+
+func (r *Campaign) GetCreatedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.CreatedAt
+	}
+	return nil
+}
+
+func (r *Campaign) GetUpdatedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.UpdatedAt
+	}
+	return nil
+}
+
+// CreateCampaignRequest is the request to create a campaign.
+type CreateCampaignRequest struct {
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Percentage   int64                  `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	StartDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ProductIds   []string               `protobuf:"bytes,5,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories   []string               `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
+	ValidateOnly bool                   `protobuf:"varint,7,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *CreateCampaignRequest) GetName() string {
+	if r != nil {
+		return r.Name
+	}
+	return ""
+}
+
+func (r *CreateCampaignRequest) GetPercentage() int64 {
+	if r != nil {
+		return r.Percentage
+	}
+	return 0
+}
+
+func (r *CreateCampaignRequest) GetStartDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.StartDate
+	}
+	return nil
+}
+
+func (r *CreateCampaignRequest) GetEndDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.EndDate
+	}
+	return nil
+}
+
+func (r *CreateCampaignRequest) GetProductIds() []string {
+	if r != nil {
+		return r.ProductIds
+	}
+	return nil
+}
+
+func (r *CreateCampaignRequest) GetCategories() []string {
+	if r != nil {
+		return r.Categories
+	}
+	return nil
+}
+
+func (r *CreateCampaignRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// CreateCampaignReply is the response after creating a campaign.
+type CreateCampaignReply struct {
+	Campaign *Campaign      `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Events   []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *CreateCampaignReply) GetCampaign() *Campaign {
+	if r != nil {
+		return r.Campaign
+	}
+	return nil
+}
+
+func (r *CreateCampaignReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// EndCampaignRequest is the request to end a campaign before its end_date.
+type EndCampaignRequest struct {
+	CampaignId   string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ValidateOnly bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *EndCampaignRequest) GetCampaignId() string {
+	if r != nil {
+		return r.CampaignId
+	}
+	return ""
+}
+
+func (r *EndCampaignRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// EndCampaignReply is the response after ending a campaign.
+type EndCampaignReply struct {
+	Campaign *Campaign      `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Events   []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *EndCampaignReply) GetCampaign() *Campaign {
+	if r != nil {
+		return r.Campaign
+	}
+	return nil
+}
+
+func (r *EndCampaignReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// Coupon is a code customers redeem at checkout for a percentage or a fixed
+// amount off eligible products.
+type Coupon struct {
+	CouponId        string                 `protobuf:"bytes,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Percentage      int64                  `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	AmountOff       *Money                 `protobuf:"bytes,4,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	ProductIds      []string               `protobuf:"bytes,5,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories      []string               `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	MaxRedemptions  int64                  `protobuf:"varint,9,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	RedemptionCount int64                  `protobuf:"varint,10,opt,name=redemption_count,json=redemptionCount,proto3" json:"redemption_count,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (r *Coupon) GetCouponId() string {
+	if r != nil {
+		return r.CouponId
+	}
+	return ""
+}
+
+func (r *Coupon) GetCode() string {
+	if r != nil {
+		return r.Code
+	}
+	return ""
+}
+
+func (r *Coupon) GetPercentage() int64 {
+	if r != nil {
+		return r.Percentage
+	}
+	return 0
+}
+
+func (r *Coupon) GetAmountOff() *Money {
+	if r != nil {
+		return r.AmountOff
+	}
+	return nil
+}
+
+func (r *Coupon) GetProductIds() []string {
+	if r != nil {
+		return r.ProductIds
+	}
+	return nil
+}
+
+func (r *Coupon) GetCategories() []string {
+	if r != nil {
+		return r.Categories
+	}
+	return nil
+}
+
+func (r *Coupon) GetStartDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.StartDate
+	}
+	return nil
+}
+
+func (r *Coupon) GetEndDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.EndDate
+	}
+	return nil
+}
+
+func (r *Coupon) GetMaxRedemptions() int64 {
+	if r != nil {
+		return r.MaxRedemptions
+	}
+	return 0
+}
+
+func (r *Coupon) GetRedemptionCount() int64 {
+	if r != nil {
+		return r.RedemptionCount
+	}
+	return 0
+}
+
+func (r *Coupon) GetCreatedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.CreatedAt
+	}
+	return nil
+}
+
+func (r *Coupon) GetUpdatedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.UpdatedAt
+	}
+	return nil
+}
+
+// CouponLine is the price of one requested product with a coupon.
+type CouponLine struct {
+	ProductId        string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Eligible         bool   `protobuf:"varint,2,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Price            *Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	DiscountedPrice  *Money `protobuf:"bytes,4,opt,name=discounted_price,json=discountedPrice,proto3" json:"discounted_price,omitempty"`
+	IneligibleReason string `protobuf:"bytes,5,opt,name=ineligible_reason,json=ineligibleReason,proto3" json:"ineligible_reason,omitempty"`
+}
+
+func (r *CouponLine) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+func (r *CouponLine) GetEligible() bool {
+	if r != nil {
+		return r.Eligible
+	}
+	return false
+}
+
+func (r *CouponLine) GetPrice() *Money {
+	if r != nil {
+		return r.Price
+	}
+	return nil
+}
+
+func (r *CouponLine) GetDiscountedPrice() *Money {
+	if r != nil {
+		return r.DiscountedPrice
+	}
+	return nil
+}
+
+func (r *CouponLine) GetIneligibleReason() string {
+	if r != nil {
+		return r.IneligibleReason
+	}
+	return ""
+}
+
+// CreateCouponRequest is the request to create a coupon.
+type CreateCouponRequest struct {
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Percentage     int64                  `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	AmountOff      *Money                 `protobuf:"bytes,3,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	ProductIds     []string               `protobuf:"bytes,4,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories     []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	StartDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	MaxRedemptions int64                  `protobuf:"varint,8,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	ValidateOnly   bool                   `protobuf:"varint,9,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *CreateCouponRequest) GetCode() string {
+	if r != nil {
+		return r.Code
+	}
+	return ""
+}
+
+func (r *CreateCouponRequest) GetPercentage() int64 {
+	if r != nil {
+		return r.Percentage
+	}
+	return 0
+}
+
+func (r *CreateCouponRequest) GetAmountOff() *Money {
+	if r != nil {
+		return r.AmountOff
+	}
+	return nil
+}
+
+func (r *CreateCouponRequest) GetProductIds() []string {
+	if r != nil {
+		return r.ProductIds
+	}
+	return nil
+}
+
+func (r *CreateCouponRequest) GetCategories() []string {
+	if r != nil {
+		return r.Categories
+	}
+	return nil
+}
+
+func (r *CreateCouponRequest) GetStartDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.StartDate
+	}
+	return nil
+}
+
+func (r *CreateCouponRequest) GetEndDate() *timestamppb.Timestamp {
+	if r != nil {
+		return r.EndDate
+	}
+	return nil
+}
+
+func (r *CreateCouponRequest) GetMaxRedemptions() int64 {
+	if r != nil {
+		return r.MaxRedemptions
+	}
+	return 0
+}
+
+func (r *CreateCouponRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// CreateCouponReply is the response after creating a coupon.
+type CreateCouponReply struct {
+	Coupon *Coupon        `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Events []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *CreateCouponReply) GetCoupon() *Coupon {
+	if r != nil {
+		return r.Coupon
+	}
+	return nil
+}
+
+func (r *CreateCouponReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// ValidateCouponRequest is the request to price products with a coupon
+// without redeeming it.
+type ValidateCouponRequest struct {
+	Code       string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ProductIds []string `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+}
+
+func (r *ValidateCouponRequest) GetCode() string {
+	if r != nil {
+		return r.Code
+	}
+	return ""
+}
+
+func (r *ValidateCouponRequest) GetProductIds() []string {
+	if r != nil {
+		return r.ProductIds
+	}
+	return nil
+}
+
+// ValidateCouponReply is the response with each product priced, in request
+// order.
+type ValidateCouponReply struct {
+	Coupon *Coupon       `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Lines  []*CouponLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+}
+
+func (r *ValidateCouponReply) GetCoupon() *Coupon {
+	if r != nil {
+		return r.Coupon
+	}
+	return nil
+}
+
+func (r *ValidateCouponReply) GetLines() []*CouponLine {
+	if r != nil {
+		return r.Lines
+	}
+	return nil
+}
+
+// RedeemCouponRequest is the request to redeem a coupon for an order.
+type RedeemCouponRequest struct {
+	Code         string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	OrderId      string   `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductIds   []string `protobuf:"bytes,3,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	ValidateOnly bool     `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *RedeemCouponRequest) GetCode() string {
+	if r != nil {
+		return r.Code
+	}
+	return ""
+}
+
+func (r *RedeemCouponRequest) GetOrderId() string {
+	if r != nil {
+		return r.OrderId
+	}
+	return ""
+}
+
+func (r *RedeemCouponRequest) GetProductIds() []string {
+	if r != nil {
+		return r.ProductIds
+	}
+	return nil
+}
+
+func (r *RedeemCouponRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// RedeemCouponReply is the response after redeeming a coupon.
+type RedeemCouponReply struct {
+	Coupon *Coupon        `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	Lines  []*CouponLine  `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	Events []*DomainEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *RedeemCouponReply) GetCoupon() *Coupon {
+	if r != nil {
+		return r.Coupon
+	}
+	return nil
+}
+
+func (r *RedeemCouponReply) GetLines() []*CouponLine {
+	if r != nil {
+		return r.Lines
+	}
+	return nil
+}
+
+func (r *RedeemCouponReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// Category is a node of the category tree. Products assigned to a category
+// reference it by category_id and carry its display_name as their category.
+type Category struct {
+	CategoryId  string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Slug        string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	ParentId    string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	DisplayName string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (r *Category) GetCategoryId() string {
+	if r != nil {
+		return r.CategoryId
+	}
+	return ""
+}
+
+func (r *Category) GetSlug() string {
+	if r != nil {
+		return r.Slug
+	}
+	return ""
+}
+
+func (r *Category) GetParentId() string {
+	if r != nil {
+		return r.ParentId
+	}
+	return ""
+}
+
+func (r *Category) GetDisplayName() string {
+	if r != nil {
+		return r.DisplayName
+	}
+	return ""
+}
+
+func (r *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.CreatedAt
+	}
+	return nil
+}
+
+func (r *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.UpdatedAt
+	}
+	return nil
+}
+
+// CreateCategoryRequest is the request to create a category.
+type CreateCategoryRequest struct {
+	Slug         string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	DisplayName  string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	ParentId     string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	ValidateOnly bool   `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *CreateCategoryRequest) GetSlug() string {
+	if r != nil {
+		return r.Slug
+	}
+	return ""
+}
+
+func (r *CreateCategoryRequest) GetDisplayName() string {
+	if r != nil {
+		return r.DisplayName
+	}
+	return ""
+}
+
+func (r *CreateCategoryRequest) GetParentId() string {
+	if r != nil {
+		return r.ParentId
+	}
+	return ""
+}
+
+func (r *CreateCategoryRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// CreateCategoryReply is the response after creating a category.
+type CreateCategoryReply struct {
+	Category *Category      `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Events   []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *CreateCategoryReply) GetCategory() *Category {
+	if r != nil {
+		return r.Category
+	}
+	return nil
+}
+
+func (r *CreateCategoryReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// UpdateCategoryRequest is the request to change a category's slug or move
+// it under another parent.
+type UpdateCategoryRequest struct {
+	CategoryId   string  `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Slug         *string `protobuf:"bytes,2,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	ParentId     *string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	ValidateOnly bool    `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *UpdateCategoryRequest) GetCategoryId() string {
+	if r != nil {
+		return r.CategoryId
+	}
+	return ""
+}
+
+func (r *UpdateCategoryRequest) GetSlug() string {
+	if r != nil && r.Slug != nil {
+		return *r.Slug
+	}
+	return ""
+}
+
+func (r *UpdateCategoryRequest) GetParentId() string {
+	if r != nil && r.ParentId != nil {
+		return *r.ParentId
+	}
+	return ""
+}
+
+func (r *UpdateCategoryRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// UpdateCategoryReply is the response after updating a category.
+type UpdateCategoryReply struct {
+	Category *Category      `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Events   []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *UpdateCategoryReply) GetCategory() *Category {
+	if r != nil {
+		return r.Category
+	}
+	return nil
+}
+
+func (r *UpdateCategoryReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// DeleteCategoryRequest is the request to delete a category.
+type DeleteCategoryRequest struct {
+	CategoryId   string `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ValidateOnly bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *DeleteCategoryRequest) GetCategoryId() string {
+	if r != nil {
+		return r.CategoryId
+	}
+	return ""
+}
+
+func (r *DeleteCategoryRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// DeleteCategoryReply is the response after deleting a category, with the
+// category as it was.
+type DeleteCategoryReply struct {
+	Category *Category      `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Events   []*DomainEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *DeleteCategoryReply) GetCategory() *Category {
+	if r != nil {
+		return r.Category
+	}
+	return nil
+}
+
+func (r *DeleteCategoryReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// RenameCategoryRequest is the request to change a category's display name.
+type RenameCategoryRequest struct {
+	CategoryId   string `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	DisplayName  string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	ValidateOnly bool   `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *RenameCategoryRequest) GetCategoryId() string {
+	if r != nil {
+		return r.CategoryId
+	}
+	return ""
+}
+
+func (r *RenameCategoryRequest) GetDisplayName() string {
+	if r != nil {
+		return r.DisplayName
+	}
+	return ""
+}
+
+func (r *RenameCategoryRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// RenameCategoryReply is the response after renaming a category.
+type RenameCategoryReply struct {
+	Category  *Category      `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Operation *BulkOperation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Events    []*DomainEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *RenameCategoryReply) GetCategory() *Category {
+	if r != nil {
+		return r.Category
+	}
+	return nil
+}
+
+func (r *RenameCategoryReply) GetOperation() *BulkOperation {
+	if r != nil {
+		return r.Operation
+	}
+	return nil
+}
+
+func (r *RenameCategoryReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// MergeCategoriesRequest is the request to merge categories into a target.
+type MergeCategoriesRequest struct {
+	SourceCategoryIds []string `protobuf:"bytes,1,rep,name=source_category_ids,json=sourceCategoryIds,proto3" json:"source_category_ids,omitempty"`
+	TargetCategoryId  string   `protobuf:"bytes,2,opt,name=target_category_id,json=targetCategoryId,proto3" json:"target_category_id,omitempty"`
+	ValidateOnly      bool     `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (r *MergeCategoriesRequest) GetSourceCategoryIds() []string {
+	if r != nil {
+		return r.SourceCategoryIds
+	}
+	return nil
+}
+
+func (r *MergeCategoriesRequest) GetTargetCategoryId() string {
+	if r != nil {
+		return r.TargetCategoryId
+	}
+	return ""
+}
+
+func (r *MergeCategoriesRequest) GetValidateOnly() bool {
+	if r != nil {
+		return r.ValidateOnly
+	}
+	return false
+}
+
+// MergeCategoriesReply is the response after merging categories, with the
+// target category.
+type MergeCategoriesReply struct {
+	Category  *Category      `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Operation *BulkOperation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Events    []*DomainEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (r *MergeCategoriesReply) GetCategory() *Category {
+	if r != nil {
+		return r.Category
+	}
+	return nil
+}
+
+func (r *MergeCategoriesReply) GetOperation() *BulkOperation {
+	if r != nil {
+		return r.Operation
+	}
+	return nil
+}
+
+func (r *MergeCategoriesReply) GetEvents() []*DomainEvent {
+	if r != nil {
+		return r.Events
+	}
+	return nil
+}
+
+// GetProductRequest is the request to get a product by ID.
+type GetProductRequest struct {
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+}
+
+func (r *GetProductRequest) GetProductId() string {
+	if r != nil {
+		return r.ProductId
+	}
+	return ""
+}
+
+// GetProductReply is the response containing a product.
+type GetProductReply struct {
+	Product *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+}
+
+func (r *GetProductReply) GetProduct() *Product {
+	if r != nil {
+		return r.Product
+	}
+	return nil
+}
+
+// ListProductsRequest is the request to list products.
+type ListProductsRequest struct {
+	Category           *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Status             *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ActiveOnly         bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Limit              int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset             int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken          string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	IncludeTotalCount  *bool                  `protobuf:"varint,7,opt,name=include_total_count,json=includeTotalCount,proto3,oneof" json:"include_total_count,omitempty"`
+	OrderBy            string                 `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	MinBasePrice       *Money                 `protobuf:"bytes,9,opt,name=min_base_price,json=minBasePrice,proto3" json:"min_base_price,omitempty"`
+	MaxBasePrice       *Money                 `protobuf:"bytes,10,opt,name=max_base_price,json=maxBasePrice,proto3" json:"max_base_price,omitempty"`
+	MinEffectivePrice  *Money                 `protobuf:"bytes,11,opt,name=min_effective_price,json=minEffectivePrice,proto3" json:"min_effective_price,omitempty"`
+	MaxEffectivePrice  *Money                 `protobuf:"bytes,12,opt,name=max_effective_price,json=maxEffectivePrice,proto3" json:"max_effective_price,omitempty"`
+	OnSaleAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=on_sale_at,json=onSaleAt,proto3" json:"on_sale_at,omitempty"`
+	MinDiscountPercent *int64                 `protobuf:"varint,14,opt,name=min_discount_percent,json=minDiscountPercent,proto3,oneof" json:"min_discount_percent,omitempty"`
+	ArchivedMode       ArchivedMode           `protobuf:"varint,15,opt,name=archived_mode,json=archivedMode,proto3,enum=product.v1.ArchivedMode" json:"archived_mode,omitempty"`
+	CampaignId         *string                `protobuf:"bytes,16,opt,name=campaign_id,json=campaignId,proto3,oneof" json:"campaign_id,omitempty"`
+	CategoryId         *string                `protobuf:"bytes,17,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,18,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+}
+
+func (r *ListProductsRequest) GetCategory() string {
+	if r != nil && r.Category != nil {
+		return *r.Category
+	}
+	return ""
+}
+
+func (r *ListProductsRequest) GetStatus() string {
+	if r != nil && r.Status != nil {
+		return *r.Status
+	}
+	return ""
+}
+
+func (r *ListProductsRequest) GetActiveOnly() bool {
+	if r != nil {
+		return r.ActiveOnly
+	}
+	return false
+}
+
+func (r *ListProductsRequest) GetLimit() int32 {
+	if r != nil {
+		return r.Limit
+	}
+	return 0
+}
+
+func (r *ListProductsRequest) GetOffset() int32 {
+	if r != nil {
+		return r.Offset
+	}
+	return 0
+}
+
+func (r *ListProductsRequest) GetPageToken() string {
+	if r != nil {
+		return r.PageToken
+	}
+	return ""
+}
+
+func (r *ListProductsRequest) GetIncludeTotalCount() bool {
+	if r != nil && r.IncludeTotalCount != nil {
+		return *r.IncludeTotalCount
+	}
+	return false
+}
+
+func (r *ListProductsRequest) GetOrderBy() string {
+	if r != nil {
+		return r.OrderBy
+	}
+	return ""
+}
+
+func (r *ListProductsRequest) GetMinBasePrice() *Money {
+	if r != nil {
+		return r.MinBasePrice
+	}
+	return nil
+}
+
+func (r *ListProductsRequest) GetMaxBasePrice() *Money {
+	if r != nil {
+		return r.MaxBasePrice
+	}
+	return nil
+}
+
+func (r *ListProductsRequest) GetMinEffectivePrice() *Money {
+	if r != nil {
+		return r.MinEffectivePrice
+	}
+	return nil
+}
+
+func (r *ListProductsRequest) GetMaxEffectivePrice() *Money {
+	if r != nil {
+		return r.MaxEffectivePrice
+	}
+	return nil
+}
+
+func (r *ListProductsRequest) GetOnSaleAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.OnSaleAt
+	}
+	return nil
+}
+
+func (r *ListProductsRequest) GetMinDiscountPercent() int64 {
+	if r != nil && r.MinDiscountPercent != nil {
+		return *r.MinDiscountPercent
+	}
+	return 0
+}
+
+func (r *ListProductsRequest) GetArchivedMode() ArchivedMode {
+	if r != nil {
+		return r.ArchivedMode
+	}
+	return ArchivedMode_ARCHIVED_MODE_UNSPECIFIED
+}
+
+func (r *ListProductsRequest) GetCampaignId() string {
+	if r != nil && r.CampaignId != nil {
+		return *r.CampaignId
+	}
+	return ""
+}
+
+func (r *ListProductsRequest) GetCategoryId() string {
+	if r != nil && r.CategoryId != nil {
+		return *r.CategoryId
+	}
+	return ""
+}
+
+func (r *ListProductsRequest) GetIncludeDescendants() bool {
+	if r != nil {
+		return r.IncludeDescendants
+	}
+	return false
+}
+
+// ListProductsReply is the response containing a list of products.
+type ListProductsReply struct {
+	Products      []*ProductListItem `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	TotalCount    int64              `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasMore       bool               `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextPageToken string             `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (r *ListProductsReply) GetProducts() []*ProductListItem {
+	if r != nil {
+		return r.Products
+	}
+	return nil
+}
+
+func (r *ListProductsReply) GetTotalCount() int64 {
+	if r != nil {
+		return r.TotalCount
+	}
+	return 0
+}
+
+func (r *ListProductsReply) GetHasMore() bool {
+	if r != nil {
+		return r.HasMore
+	}
+	return false
+}
+
+func (r *ListProductsReply) GetNextPageToken() string {
+	if r != nil {
+		return r.NextPageToken
+	}
+	return ""
+}
+
+// SearchProductsRequest is the request to search products by keyword.
+type SearchProductsRequest struct {
+	Query      string  `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Category   *string `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Status     *string `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ActiveOnly bool    `protobuf:"varint,4,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Limit      int32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset     int32   `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (r *SearchProductsRequest) GetQuery() string {
+	if r != nil {
+		return r.Query
+	}
+	return ""
+}
+
+func (r *SearchProductsRequest) GetCategory() string {
+	if r != nil && r.Category != nil {
+		return *r.Category
+	}
+	return ""
+}
+
+func (r *SearchProductsRequest) GetStatus() string {
+	if r != nil && r.Status != nil {
+		return *r.Status
+	}
+	return ""
+}
+
+func (r *SearchProductsRequest) GetActiveOnly() bool {
+	if r != nil {
+		return r.ActiveOnly
+	}
+	return false
+}
+
+func (r *SearchProductsRequest) GetLimit() int32 {
+	if r != nil {
+		return r.Limit
+	}
+	return 0
+}
+
+func (r *SearchProductsRequest) GetOffset() int32 {
+	if r != nil {
+		return r.Offset
+	}
+	return 0
+}
+
+// ProductSearchResult is a product matched by a search with its relevance.
+type ProductSearchResult struct {
+	Product *ProductListItem `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Score   float64          `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (r *ProductSearchResult) GetProduct() *ProductListItem {
+	if r != nil {
+		return r.Product
+	}
+	return nil
+}
+
+func (r *ProductSearchResult) GetScore() float64 {
+	if r != nil {
+		return r.Score
+	}
+	return 0
+}
+
+// SearchProductsReply is the response containing matches, most relevant first.
+type SearchProductsReply struct {
+	Results    []*ProductSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalCount int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasMore    bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (r *SearchProductsReply) GetResults() []*ProductSearchResult {
+	if r != nil {
+		return r.Results
+	}
+	return nil
+}
+
+func (r *SearchProductsReply) GetTotalCount() int64 {
+	if r != nil {
+		return r.TotalCount
+	}
+	return 0
+}
+
+func (r *SearchProductsReply) GetHasMore() bool {
+	if r != nil {
+		return r.HasMore
+	}
+	return false
+}
+
+// GetProductFacetsRequest is the request for facet counts.
+type GetProductFacetsRequest struct {
+	Category            *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Status              *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ActiveOnly          bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	MinBasePrice        *Money                 `protobuf:"bytes,4,opt,name=min_base_price,json=minBasePrice,proto3" json:"min_base_price,omitempty"`
+	MaxBasePrice        *Money                 `protobuf:"bytes,5,opt,name=max_base_price,json=maxBasePrice,proto3" json:"max_base_price,omitempty"`
+	MinEffectivePrice   *Money                 `protobuf:"bytes,6,opt,name=min_effective_price,json=minEffectivePrice,proto3" json:"min_effective_price,omitempty"`
+	MaxEffectivePrice   *Money                 `protobuf:"bytes,7,opt,name=max_effective_price,json=maxEffectivePrice,proto3" json:"max_effective_price,omitempty"`
+	OnSaleAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=on_sale_at,json=onSaleAt,proto3" json:"on_sale_at,omitempty"`
+	MinDiscountPercent  *int64                 `protobuf:"varint,9,opt,name=min_discount_percent,json=minDiscountPercent,proto3,oneof" json:"min_discount_percent,omitempty"`
+	PriceBandBoundaries []*Money               `protobuf:"bytes,10,rep,name=price_band_boundaries,json=priceBandBoundaries,proto3" json:"price_band_boundaries,omitempty"`
+	ArchivedMode        ArchivedMode           `protobuf:"varint,11,opt,name=archived_mode,json=archivedMode,proto3,enum=product.v1.ArchivedMode" json:"archived_mode,omitempty"`
+	CategoryId          *string                `protobuf:"bytes,12,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	IncludeDescendants  bool                   `protobuf:"varint,13,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+}
+
+func (r *GetProductFacetsRequest) GetCategory() string {
+	if r != nil && r.Category != nil {
+		return *r.Category
+	}
+	return ""
+}
+
+func (r *GetProductFacetsRequest) GetStatus() string {
+	if r != nil && r.Status != nil {
+		return *r.Status
+	}
+	return ""
+}
+
+func (r *GetProductFacetsRequest) GetActiveOnly() bool {
+	if r != nil {
+		return r.ActiveOnly
+	}
+	return false
+}
+
+func (r *GetProductFacetsRequest) GetMinBasePrice() *Money {
+	if r != nil {
+		return r.MinBasePrice
+	}
+	return nil
+}
+
+func (r *GetProductFacetsRequest) GetMaxBasePrice() *Money {
+	if r != nil {
+		return r.MaxBasePrice
+	}
+	return nil
+}
+
+func (r *GetProductFacetsRequest) GetMinEffectivePrice() *Money {
+	if r != nil {
+		return r.MinEffectivePrice
+	}
+	return nil
+}
+
+func (r *GetProductFacetsRequest) GetMaxEffectivePrice() *Money {
+	if r != nil {
+		return r.MaxEffectivePrice
+	}
+	return nil
+}
+
+func (r *GetProductFacetsRequest) GetOnSaleAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.OnSaleAt
+	}
+	return nil
+}
+
+func (r *GetProductFacetsRequest) GetMinDiscountPercent() int64 {
+	if r != nil && r.MinDiscountPercent != nil {
+		return *r.MinDiscountPercent
+	}
+	return 0
+}
+
+func (r *GetProductFacetsRequest) GetPriceBandBoundaries() []*Money {
+	if r != nil {
+		return r.PriceBandBoundaries
+	}
+	return nil
+}
+
+func (r *GetProductFacetsRequest) GetArchivedMode() ArchivedMode {
+	if r != nil {
+		return r.ArchivedMode
+	}
+	return ArchivedMode_ARCHIVED_MODE_UNSPECIFIED
+}
+
+func (r *GetProductFacetsRequest) GetCategoryId() string {
+	if r != nil && r.CategoryId != nil {
+		return *r.CategoryId
+	}
+	return ""
+}
+
+func (r *GetProductFacetsRequest) GetIncludeDescendants() bool {
+	if r != nil {
+		return r.IncludeDescendants
+	}
+	return false
+}
+
+// FacetValue is the number of products sharing a facet value.
+type FacetValue struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (r *FacetValue) GetValue() string {
+	if r != nil {
+		return r.Value
+	}
+	return ""
+}
+
+func (r *FacetValue) GetCount() int64 {
+	if r != nil {
+		return r.Count
+	}
+	return 0
+}
+
+// PriceBandFacet counts products with an effective price in [min, max).
+type PriceBandFacet struct {
+	Min   *Money `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max   *Money `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (r *PriceBandFacet) GetMin() *Money {
+	if r != nil {
+		return r.Min
+	}
+	return nil
+}
+
+func (r *PriceBandFacet) GetMax() *Money {
+	if r != nil {
+		return r.Max
+	}
+	return nil
+}
+
+func (r *PriceBandFacet) GetCount() int64 {
+	if r != nil {
+		return r.Count
+	}
+	return 0
+}
+
+// GetProductFacetsReply contains facet counts for the filtered products.
+type GetProductFacetsReply struct {
+	TotalCount      int64             `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Categories      []*FacetValue     `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	Statuses        []*FacetValue     `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	DiscountBuckets []*FacetValue     `protobuf:"bytes,4,rep,name=discount_buckets,json=discountBuckets,proto3" json:"discount_buckets,omitempty"`
+	PriceBands      []*PriceBandFacet `protobuf:"bytes,5,rep,name=price_bands,json=priceBands,proto3" json:"price_bands,omitempty"`
+}
+
+func (r *GetProductFacetsReply) GetTotalCount() int64 {
+	if r != nil {
+		return r.TotalCount
+	}
+	return 0
+}
+
+func (r *GetProductFacetsReply) GetCategories() []*FacetValue {
+	if r != nil {
+		return r.Categories
+	}
+	return nil
+}
+
+func (r *GetProductFacetsReply) GetStatuses() []*FacetValue {
+	if r != nil {
+		return r.Statuses
+	}
+	return nil
+}
+
+func (r *GetProductFacetsReply) GetDiscountBuckets() []*FacetValue {
+	if r != nil {
+		return r.DiscountBuckets
+	}
+	return nil
+}
+
+func (r *GetProductFacetsReply) GetPriceBands() []*PriceBandFacet {
+	if r != nil {
+		return r.PriceBands
+	}
+	return nil
+}
+
+// BatchGetProductsRequest is the request to get up to 200 products at once.
+type BatchGetProductsRequest struct {
+	ProductIds []string `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+}
+
+func (r *BatchGetProductsRequest) GetProductIds() []string {
+	if r != nil {
+		return r.ProductIds
+	}
+	return nil
+}
+
+// BatchGetProductsReply contains the found products in request order.
+type BatchGetProductsReply struct {
+	Products   []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	MissingIds []string   `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+}
+
+func (r *BatchGetProductsReply) GetProducts() []*Product {
+	if r != nil {
+		return r.Products
+	}
+	return nil
+}
+
+func (r *BatchGetProductsReply) GetMissingIds() []string {
+	if r != nil {
+		return r.MissingIds
+	}
+	return nil
+}
+
+// ExportProductsRequest is the request to export every product matching the filters.
+type ExportProductsRequest struct {
+	Category           *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Status             *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ActiveOnly         bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	ArchivedMode       ArchivedMode           `protobuf:"varint,4,opt,name=archived_mode,json=archivedMode,proto3,enum=product.v1.ArchivedMode" json:"archived_mode,omitempty"`
+	MinBasePrice       *Money                 `protobuf:"bytes,5,opt,name=min_base_price,json=minBasePrice,proto3" json:"min_base_price,omitempty"`
+	MaxBasePrice       *Money                 `protobuf:"bytes,6,opt,name=max_base_price,json=maxBasePrice,proto3" json:"max_base_price,omitempty"`
+	MinEffectivePrice  *Money                 `protobuf:"bytes,7,opt,name=min_effective_price,json=minEffectivePrice,proto3" json:"min_effective_price,omitempty"`
+	MaxEffectivePrice  *Money                 `protobuf:"bytes,8,opt,name=max_effective_price,json=maxEffectivePrice,proto3" json:"max_effective_price,omitempty"`
+	OnSaleAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=on_sale_at,json=onSaleAt,proto3" json:"on_sale_at,omitempty"`
+	MinDiscountPercent *int64                 `protobuf:"varint,10,opt,name=min_discount_percent,json=minDiscountPercent,proto3,oneof" json:"min_discount_percent,omitempty"`
+	Format             ExportFormat           `protobuf:"varint,11,opt,name=format,proto3,enum=product.v1.ExportFormat" json:"format,omitempty"`
+	Parallelism        int32                  `protobuf:"varint,12,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	CategoryId         *string                `protobuf:"bytes,13,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,14,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+}
+
+func (r *ExportProductsRequest) GetCategory() string {
+	if r != nil && r.Category != nil {
+		return *r.Category
+	}
+	return ""
+}
+
+func (r *ExportProductsRequest) GetStatus() string {
+	if r != nil && r.Status != nil {
+		return *r.Status
+	}
+	return ""
+}
+
+func (r *ExportProductsRequest) GetActiveOnly() bool {
+	if r != nil {
+		return r.ActiveOnly
+	}
+	return false
+}
+
+func (r *ExportProductsRequest) GetArchivedMode() ArchivedMode {
+	if r != nil {
+		return r.ArchivedMode
+	}
+	return ArchivedMode_ARCHIVED_MODE_UNSPECIFIED
+}
+
+func (r *ExportProductsRequest) GetMinBasePrice() *Money {
+	if r != nil {
+		return r.MinBasePrice
+	}
+	return nil
+}
+
+func (r *ExportProductsRequest) GetMaxBasePrice() *Money {
+	if r != nil {
+		return r.MaxBasePrice
+	}
+	return nil
+}
+
+func (r *ExportProductsRequest) GetMinEffectivePrice() *Money {
+	if r != nil {
+		return r.MinEffectivePrice
+	}
+	return nil
+}
+
+func (r *ExportProductsRequest) GetMaxEffectivePrice() *Money {
+	if r != nil {
+		return r.MaxEffectivePrice
+	}
+	return nil
+}
+
+func (r *ExportProductsRequest) GetOnSaleAt() *timestamppb.Timestamp {
+	if r != nil {
+		return r.OnSaleAt
+	}
+	return nil
+}
+
+func (r *ExportProductsRequest) GetMinDiscountPercent() int64 {
+	if r != nil && r.MinDiscountPercent != nil {
+		return *r.MinDiscountPercent
+	}
+	return 0
+}
+
+func (r *ExportProductsRequest) GetFormat() ExportFormat {
+	if r != nil {
+		return r.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (r *ExportProductsRequest) GetParallelism() int32 {
+	if r != nil {
+		return r.Parallelism
+	}
+	return 0
+}
+
+func (r *ExportProductsRequest) GetCategoryId() string {
+	if r != nil && r.CategoryId != nil {
+		return *r.CategoryId
+	}
+	return ""
+}
+
+func (r *ExportProductsRequest) GetIncludeDescendants() bool {
+	if r != nil {
+		return r.IncludeDescendants
+	}
+	return false
+}
+
+// ExportProductsReply is one batch of an export.
+type ExportProductsReply struct {
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	ReadTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=read_timestamp,json=readTimestamp,proto3" json:"read_timestamp,omitempty"`
+}
+
+func (r *ExportProductsReply) GetProducts() []*Product {
+	if r != nil {
+		return r.Products
+	}
+	return nil
+}
+
+func (r *ExportProductsReply) GetChunk() []byte {
+	if r != nil {
+		return r.Chunk
+	}
+	return nil
+}
+
+func (r *ExportProductsReply) GetReadTimestamp() *timestamppb.Timestamp {
+	if r != nil {
+		return r.ReadTimestamp
+	}
+	return nil
+}
+
+// GetBulkOperationRequest is the request to get a bulk operation by ID.
+type GetBulkOperationRequest struct {
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (r *GetBulkOperationRequest) GetOperationId() string {
+	if r != nil {
+		return r.OperationId
+	}
+	return ""
+}
+
+// GetBulkOperationReply is the response containing a bulk operation.
+type GetBulkOperationReply struct {
+	Operation *BulkOperation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (r *GetBulkOperationReply) GetOperation() *BulkOperation {
+	if r != nil {
+		return r.Operation
+	}
+	return nil
+}
+
+// GetCampaignRequest is the request to get a campaign by ID.
+type GetCampaignRequest struct {
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (r *GetCampaignRequest) GetCampaignId() string {
+	if r != nil {
+		return r.CampaignId
+	}
+	return ""
+}
+
+// GetCampaignReply is the response containing a campaign.
+type GetCampaignReply struct {
+	Campaign *Campaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+}
+
+func (r *GetCampaignReply) GetCampaign() *Campaign {
+	if r != nil {
+		return r.Campaign
+	}
+	return nil
+}
+
+// ListCampaignsRequest is the request to list campaigns, newest first.
+type ListCampaignsRequest struct {
+	Status *string `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Limit  int32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (r *ListCampaignsRequest) GetStatus() string {
+	if r != nil && r.Status != nil {
+		return *r.Status
+	}
+	return ""
+}
+
+func (r *ListCampaignsRequest) GetLimit() int32 {
+	if r != nil {
+		return r.Limit
+	}
+	return 0
+}
+
+func (r *ListCampaignsRequest) GetOffset() int32 {
+	if r != nil {
+		return r.Offset
+	}
+	return 0
+}
+
+// ListCampaignsReply is the response containing a page of campaigns.
+type ListCampaignsReply struct {
+	Campaigns []*Campaign `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	HasMore   bool        `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (r *ListCampaignsReply) GetCampaigns() []*Campaign {
+	if r != nil {
+		return r.Campaigns
+	}
+	return nil
+}
+
+func (r *ListCampaignsReply) GetHasMore() bool {
+	if r != nil {
+		return r.HasMore
+	}
+	return false
+}
+
+// GetCategoryRequest is the request to get a category by ID.
+type GetCategoryRequest struct {
+	CategoryId string `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+}
+
+func (r *GetCategoryRequest) GetCategoryId() string {
+	if r != nil {
+		return r.CategoryId
+	}
+	return ""
+}
+
+// GetCategoryReply is the response containing a category.
+type GetCategoryReply struct {
+	Category *Category `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (r *GetCategoryReply) GetCategory() *Category {
+	if r != nil {
+		return r.Category
+	}
+	return nil
+}
+
+// ListCategoriesRequest is the request to list categories, ordered by slug.
+type ListCategoriesRequest struct {
+	ParentId *string `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Limit    int32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset   int32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (r *ListCategoriesRequest) GetParentId() string {
+	if r != nil && r.ParentId != nil {
+		return *r.ParentId
+	}
+	return ""
+}
+
+func (r *ListCategoriesRequest) GetLimit() int32 {
+	if r != nil {
+		return r.Limit
+	}
+	return 0
+}
+
+func (r *ListCategoriesRequest) GetOffset() int32 {
+	if r != nil {
+		return r.Offset
+	}
+	return 0
+}
+
+// ListCategoriesReply is the response containing a page of categories.
+type ListCategoriesReply struct {
+	Categories []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	HasMore    bool        `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (r *ListCategoriesReply) GetCategories() []*Category {
+	if r != nil {
+		return r.Categories
+	}
+	return nil
+}
+
+func (r *ListCategoriesReply) GetHasMore() bool {
 	if r != nil {
 		return r.HasMore
 	}
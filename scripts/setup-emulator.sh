@@ -45,7 +45,57 @@ curl -X PATCH "http://localhost:9020/v1/projects/test-project/instances/test-ins
       "CREATE TABLE outbox_events (event_id STRING(36) NOT NULL, event_type STRING(100) NOT NULL, aggregate_id STRING(36) NOT NULL, payload JSON NOT NULL, status STRING(20) NOT NULL, created_at TIMESTAMP NOT NULL, processed_at TIMESTAMP) PRIMARY KEY (event_id)",
       "CREATE INDEX idx_outbox_status ON outbox_events(status, created_at)",
       "CREATE INDEX idx_products_category ON products(category, status)",
-      "CREATE INDEX idx_products_status ON products(status, created_at DESC)"
+      "CREATE INDEX idx_products_status ON products(status, created_at DESC)",
+      "CREATE TABLE product_views (product_id STRING(36) NOT NULL, name STRING(255) NOT NULL, description STRING(MAX), category STRING(100) NOT NULL, base_price_numerator INT64 NOT NULL, base_price_denominator INT64 NOT NULL, discount_percent NUMERIC, discount_start_date TIMESTAMP, discount_end_date TIMESTAMP, status STRING(20) NOT NULL, created_at TIMESTAMP NOT NULL, updated_at TIMESTAMP NOT NULL, archived_at TIMESTAMP, base_price NUMERIC NOT NULL, discounted_price NUMERIC, projected_at TIMESTAMP NOT NULL) PRIMARY KEY (product_id)",
+      "CREATE INDEX idx_product_views_status ON product_views(status, created_at DESC)",
+      "CREATE INDEX idx_product_views_category ON product_views(category, status)",
+      "CREATE INDEX idx_product_views_projected_at ON product_views(projected_at)",
+      "ALTER TABLE outbox_events ADD COLUMN projected_at TIMESTAMP",
+      "CREATE INDEX idx_outbox_projected_at ON outbox_events(projected_at, created_at)",
+      "CREATE INDEX idx_products_created_at ON products(created_at DESC)",
+      "CREATE INDEX idx_products_updated_at ON products(updated_at DESC)",
+      "CREATE INDEX idx_products_name ON products(name)",
+      "CREATE INDEX idx_product_views_created_at ON product_views(created_at DESC)",
+      "CREATE INDEX idx_product_views_updated_at ON product_views(updated_at DESC)",
+      "CREATE INDEX idx_product_views_name ON product_views(name)",
+      "CREATE INDEX idx_product_views_base_price ON product_views(base_price)",
+      "ALTER TABLE product_views ADD COLUMN name_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(name)) HIDDEN",
+      "ALTER TABLE product_views ADD COLUMN description_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(description)) HIDDEN",
+      "ALTER TABLE product_views ADD COLUMN category_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(category)) HIDDEN",
+      "ALTER TABLE product_views ADD COLUMN text_ngram_tokens TOKENLIST AS (TOKENIZE_SUBSTRING(CONCAT(name, \" \", IFNULL(description, \"\"), \" \", category), ngram_size_min=>3, ngram_size_max=>3, relative_search_types=>[\"word_prefix\"])) HIDDEN",
+      "CREATE SEARCH INDEX idx_product_views_search ON product_views(name_tokens, description_tokens, category_tokens, text_ngram_tokens) STORING (status, category)",
+      "ALTER TABLE products ADD COLUMN publish_at TIMESTAMP",
+      "ALTER TABLE products ADD COLUMN unpublish_at TIMESTAMP",
+      "ALTER TABLE product_views ADD COLUMN publish_at TIMESTAMP",
+      "ALTER TABLE product_views ADD COLUMN unpublish_at TIMESTAMP",
+      "CREATE NULL_FILTERED INDEX idx_products_publish_at ON products(publish_at)",
+      "CREATE NULL_FILTERED INDEX idx_products_unpublish_at ON products(unpublish_at)",
+      "ALTER TABLE products ADD COLUMN discount_phase STRING(20) NOT NULL DEFAULT (\"none\")",
+      "ALTER TABLE product_views ADD COLUMN discount_phase STRING(20) NOT NULL DEFAULT (\"none\")",
+      "CREATE INDEX idx_products_discount_start ON products(discount_phase, discount_start_date)",
+      "CREATE INDEX idx_products_discount_end ON products(discount_phase, discount_end_date)",
+      "ALTER TABLE products ADD COLUMN version INT64 NOT NULL DEFAULT (1)",
+      "ALTER TABLE product_views ADD COLUMN version INT64 NOT NULL DEFAULT (1)",
+      "ALTER TABLE products ADD COLUMN external_key STRING(255)",
+      "CREATE UNIQUE NULL_FILTERED INDEX idx_products_external_key ON products(external_key)",
+      "ALTER TABLE product_views ADD COLUMN external_key STRING(255)",
+      "CREATE TABLE bulk_operations (operation_id STRING(36) NOT NULL, command STRING(32) NOT NULL, params JSON, filters JSON NOT NULL, status STRING(20) NOT NULL, last_product_id STRING(36), matched_count INT64 NOT NULL, processed_count INT64 NOT NULL, succeeded_count INT64 NOT NULL, failed_count INT64 NOT NULL, created_at TIMESTAMP NOT NULL, updated_at TIMESTAMP NOT NULL, completed_at TIMESTAMP) PRIMARY KEY (operation_id)",
+      "CREATE INDEX idx_bulk_operations_status ON bulk_operations(status, created_at)",
+      "CREATE TABLE bulk_operation_failures (operation_id STRING(36) NOT NULL, product_id STRING(36) NOT NULL, message STRING(MAX) NOT NULL, failed_at TIMESTAMP NOT NULL) PRIMARY KEY (operation_id, product_id), INTERLEAVE IN PARENT bulk_operations ON DELETE CASCADE",
+      "CREATE TABLE campaigns (campaign_id STRING(36) NOT NULL, name STRING(255) NOT NULL, discount_percent INT64 NOT NULL, start_date TIMESTAMP NOT NULL, end_date TIMESTAMP NOT NULL, ended_at TIMESTAMP, product_ids ARRAY<STRING(36)> NOT NULL, categories ARRAY<STRING(100)> NOT NULL, created_at TIMESTAMP NOT NULL, updated_at TIMESTAMP NOT NULL) PRIMARY KEY (campaign_id)",
+      "CREATE INDEX idx_campaigns_end_date ON campaigns(end_date)",
+      "CREATE INDEX idx_campaigns_created_at ON campaigns(created_at DESC)",
+      "CREATE TABLE coupons (coupon_id STRING(36) NOT NULL, code STRING(64) NOT NULL, percentage INT64, amount_off_numerator INT64, amount_off_denominator INT64, product_ids ARRAY<STRING(36)> NOT NULL, categories ARRAY<STRING(100)> NOT NULL, start_date TIMESTAMP NOT NULL, end_date TIMESTAMP NOT NULL, max_redemptions INT64 NOT NULL, redemption_count INT64 NOT NULL, created_at TIMESTAMP NOT NULL, updated_at TIMESTAMP NOT NULL) PRIMARY KEY (coupon_id)",
+      "CREATE UNIQUE INDEX idx_coupons_code ON coupons(code)",
+      "CREATE TABLE coupon_redemptions (coupon_id STRING(36) NOT NULL, order_id STRING(100) NOT NULL, product_ids ARRAY<STRING(36)> NOT NULL, redeemed_at TIMESTAMP NOT NULL) PRIMARY KEY (coupon_id, order_id), INTERLEAVE IN PARENT coupons ON DELETE CASCADE",
+      "CREATE TABLE categories (category_id STRING(36) NOT NULL, slug STRING(100) NOT NULL, parent_id STRING(36), display_name STRING(100) NOT NULL, created_at TIMESTAMP NOT NULL, updated_at TIMESTAMP NOT NULL) PRIMARY KEY (category_id)",
+      "CREATE UNIQUE INDEX idx_categories_slug ON categories(slug)",
+      "CREATE INDEX idx_categories_parent ON categories(parent_id)",
+      "ALTER TABLE products ADD COLUMN category_id STRING(36)",
+      "CREATE NULL_FILTERED INDEX idx_products_category_id ON products(category_id)",
+      "ALTER TABLE product_views ADD COLUMN category_id STRING(36)",
+      "ALTER TABLE products ADD COLUMN attributes JSON",
+      "ALTER TABLE product_views ADD COLUMN attributes JSON"
     ]
   }' || true
 
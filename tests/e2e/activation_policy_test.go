@@ -0,0 +1,126 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/product-catalog-service/internal/services"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// TestActivationPolicy tests activation readiness rules and their gRPC details
+func TestActivationPolicy(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	floor, err := domain.NewMoney(2500, 100)
+	require.NoError(t, err)
+
+	container := services.NewContainerWithOptions(testClient, services.Options{
+		Clock: testClock,
+		ActivationPolicy: domain.NewActivationPolicy(
+			domain.MinDescriptionLength(30),
+			domain.ForCategory("Test Category", domain.MinBasePrice(floor)),
+		),
+	})
+
+	// createTestProduct has a short description and a 19.99 price
+	productID := createTestProduct(t, ctx)
+
+	t.Run("usecase returns all violations", func(t *testing.T) {
+		_, err := container.ActivateProductUsecase.Execute(ctx, activate_product.Request{ProductID: productID})
+		assert.ErrorIs(t, err, domain.ErrActivationPolicyViolated)
+	})
+
+	t.Run("grpc reports violations as details", func(t *testing.T) {
+		_, err := container.ProductHandler.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: productID})
+		st := status.Convert(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+
+		var preconditions *errdetails.PreconditionFailure
+		var fields *errdetails.BadRequest
+		for _, detail := range st.Details() {
+			switch d := detail.(type) {
+			case *errdetails.PreconditionFailure:
+				preconditions = d
+			case *errdetails.BadRequest:
+				fields = d
+			}
+		}
+		require.NotNil(t, preconditions)
+		require.NotNil(t, fields)
+
+		var types []string
+		for _, v := range preconditions.GetViolations() {
+			types = append(types, v.GetType())
+		}
+		assert.Equal(t, []string{"min_description_length", "min_base_price"}, types)
+
+		var paths []string
+		for _, v := range fields.GetFieldViolations() {
+			paths = append(paths, v.GetField())
+		}
+		assert.Equal(t, []string{"description", "base_price"}, paths)
+	})
+
+	t.Run("default container has no rules", func(t *testing.T) {
+		other := createTestProduct(t, ctx)
+		_, err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{ProductID: other})
+		assert.NoError(t, err)
+	})
+
+	t.Run("activates once fixed", func(t *testing.T) {
+		_, err := container.UpdateProductUsecase.Execute(ctx, update_product.Request{
+			ProductID:   productID,
+			Name:        "Ready Product",
+			Description: "A long enough description for the policy",
+			Category:    "Other Category",
+		})
+		require.NoError(t, err)
+
+		_, err = container.ActivateProductUsecase.Execute(ctx, activate_product.Request{ProductID: productID})
+		assert.NoError(t, err)
+	})
+}
+
+// TestActivationPolicy_RequiredAttributes tests that a category can require
+// attributes set through UpdateProduct before activation
+func TestActivationPolicy_RequiredAttributes(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	container := services.NewContainerWithOptions(testClient, services.Options{
+		Clock: testClock,
+		ActivationPolicy: domain.NewActivationPolicy(
+			domain.ForCategory("Test Category", domain.RequireAttributes("brand")),
+		),
+	})
+
+	productID := createTestProduct(t, ctx)
+
+	_, err := container.ActivateProductUsecase.Execute(ctx, activate_product.Request{ProductID: productID})
+	assert.ErrorIs(t, err, domain.ErrActivationPolicyViolated)
+
+	_, err = container.UpdateProductUsecase.Execute(ctx, update_product.Request{
+		ProductID:  productID,
+		Attributes: map[string]string{"brand": "Acme"},
+		UpdateMask: []string{domain.FieldAttributes},
+	})
+	require.NoError(t, err)
+
+	got, err := container.ProductHandler.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"brand": "Acme"}, got.GetProduct().GetAttributes())
+
+	_, err = container.ActivateProductUsecase.Execute(ctx, activate_product.Request{ProductID: productID})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,195 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/product-catalog-service/internal/services"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// TestBulkUpdate verifies bulk operations run chunk by chunk through the domain and outbox
+func TestBulkUpdate(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	// Small chunks, so an operation spans several transactions
+	container := services.NewContainerWithOptions(testClient, services.Options{
+		Clock:         testClock,
+		BulkChunkSize: 2,
+	})
+
+	create := func(name, category string, activate bool) string {
+		result, err := container.CreateProductUsecase.Execute(ctx, create_product.Request{
+			Name:                 name,
+			Description:          "Bulk test product",
+			Category:             category,
+			BasePriceNumerator:   1000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		if activate {
+			_, err = container.ActivateProductUsecase.Execute(ctx, activate_product.Request{ProductID: result.Product.ID})
+			require.NoError(t, err)
+		}
+		return result.Product.ID
+	}
+
+	active := []string{
+		create("Lamp", "Lighting", true),
+		create("Bulb", "Lighting", true),
+		create("Shade", "Lighting", true),
+	}
+	inactive := create("Switch", "Lighting", false)
+	other := create("Chair", "Furniture", true)
+	archived := create("Old lamp", "Lighting", false)
+	_, err := container.ArchiveProductUsecase.Execute(ctx, archive_product.Request{ProductID: archived})
+	require.NoError(t, err)
+
+	now := testClock.Now()
+	category := "Lighting"
+
+	t.Run("apply discount to a category", func(t *testing.T) {
+		reply, err := container.ProductHandler.BulkUpdate(ctx, &pb.BulkUpdateRequest{
+			Category:   &category,
+			Command:    pb.BulkCommand_BULK_COMMAND_APPLY_DISCOUNT,
+			Percentage: 15,
+			StartDate:  timestamppb.New(now),
+			EndDate:    timestamppb.New(now.Add(7 * 24 * time.Hour)),
+		})
+		require.NoError(t, err)
+
+		started := reply.GetOperation()
+		require.NotEmpty(t, started.GetOperationId())
+		assert.Equal(t, "running", started.GetStatus())
+		assert.Equal(t, int64(4), started.GetMatchedCount(), "archived products are excluded by default")
+		assert.False(t, started.GetDone())
+
+		completed, err := container.BulkUpdater.ProcessRunning(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, completed)
+
+		got, err := container.ProductHandler.GetBulkOperation(ctx, &pb.GetBulkOperationRequest{
+			OperationId: started.GetOperationId(),
+		})
+		require.NoError(t, err)
+
+		op := got.GetOperation()
+		assert.True(t, op.GetDone())
+		assert.Equal(t, "completed", op.GetStatus())
+		assert.Equal(t, pb.BulkCommand_BULK_COMMAND_APPLY_DISCOUNT, op.GetCommand())
+		assert.Equal(t, int64(4), op.GetProcessedCount())
+		assert.Equal(t, int64(3), op.GetSucceededCount())
+		assert.Equal(t, int64(1), op.GetFailedCount())
+		assert.NotNil(t, op.GetCompletedAt())
+
+		require.Len(t, op.GetFailures(), 1)
+		assert.Equal(t, inactive, op.GetFailures()[0].GetProductId())
+		assert.Equal(t, "PRODUCT_NOT_ACTIVE", op.GetFailures()[0].GetErrorReason())
+
+		for _, id := range active {
+			product, err := container.ProductRepo.GetByID(ctx, id)
+			require.NoError(t, err)
+			require.NotNil(t, product.Discount())
+			assert.Equal(t, int64(15), product.Discount().Percentage())
+
+			var applied int
+			for _, event := range getOutboxEvents(t, ctx, id) {
+				if event.EventType == "product.discount_applied" {
+					applied++
+				}
+			}
+			assert.Equal(t, 1, applied, "one discount event per product")
+		}
+
+		unchanged, err := container.ProductRepo.GetByID(ctx, other)
+		require.NoError(t, err)
+		assert.Nil(t, unchanged.Discount())
+	})
+
+	t.Run("running a completed operation changes nothing", func(t *testing.T) {
+		completed, err := container.BulkUpdater.ProcessRunning(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, completed)
+	})
+
+	t.Run("activation policy failures keep their reason", func(t *testing.T) {
+		strict := services.NewContainerWithOptions(testClient, services.Options{
+			Clock:            testClock,
+			ActivationPolicy: domain.NewActivationPolicy(domain.MinDescriptionLength(50)),
+		})
+
+		status := "inactive"
+		reply, err := strict.ProductHandler.BulkUpdate(ctx, &pb.BulkUpdateRequest{
+			Category: &category,
+			Status:   &status,
+			Command:  pb.BulkCommand_BULK_COMMAND_ACTIVATE,
+		})
+		require.NoError(t, err)
+
+		_, err = strict.BulkUpdater.ProcessRunning(ctx)
+		require.NoError(t, err)
+
+		got, err := strict.ProductHandler.GetBulkOperation(ctx, &pb.GetBulkOperationRequest{
+			OperationId: reply.GetOperation().GetOperationId(),
+		})
+		require.NoError(t, err)
+		require.Len(t, got.GetOperation().GetFailures(), 1)
+		assert.Equal(t, "ACTIVATION_POLICY_VIOLATED", got.GetOperation().GetFailures()[0].GetErrorReason())
+	})
+
+	t.Run("validate only records nothing", func(t *testing.T) {
+		reply, err := container.ProductHandler.BulkUpdate(ctx, &pb.BulkUpdateRequest{
+			Category:     &category,
+			Command:      pb.BulkCommand_BULK_COMMAND_DEACTIVATE,
+			ValidateOnly: true,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, reply.GetOperation().GetOperationId())
+		assert.Equal(t, int64(4), reply.GetOperation().GetMatchedCount())
+
+		completed, err := container.BulkUpdater.ProcessRunning(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, completed)
+	})
+
+	t.Run("invalid requests are rejected", func(t *testing.T) {
+		_, err := container.ProductHandler.BulkUpdate(ctx, &pb.BulkUpdateRequest{})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "command", details.info.GetMetadata()["field"])
+
+		_, err = container.ProductHandler.BulkUpdate(ctx, &pb.BulkUpdateRequest{
+			Command: pb.BulkCommand_BULK_COMMAND_CHANGE_CATEGORY,
+		})
+		code, details = detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "new_category", details.info.GetMetadata()["field"])
+
+		_, err = container.ProductHandler.BulkUpdate(ctx, &pb.BulkUpdateRequest{
+			Command:      pb.BulkCommand_BULK_COMMAND_ARCHIVE,
+			MinBasePrice: &pb.Money{Numerator: 5, Denominator: 1},
+			MaxBasePrice: &pb.Money{Numerator: 1, Denominator: 1},
+		})
+		code, details = detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "INVALID_PRICE_RANGE", details.info.GetReason())
+
+		_, err = container.ProductHandler.GetBulkOperation(ctx, &pb.GetBulkOperationRequest{
+			OperationId: "00000000-0000-0000-0000-000000000000",
+		})
+		code, details = detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+		assert.Equal(t, "BULK_OPERATION_NOT_FOUND", details.info.GetReason())
+	})
+}
@@ -0,0 +1,157 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// TestCampaignLifecycle verifies that a campaign lowers the effective price of
+// the products it selects while it runs, without stacking on their own discounts
+func TestCampaignLifecycle(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+	now := testClock.Now()
+
+	// $10.00 each: one selected by category, one by ID with a bigger own
+	// discount, and one the campaign leaves out
+	inCategory := createProductInCategory(t, ctx, "Sale", true)
+	withOwnDiscount := createProductInCategory(t, ctx, "Other", false)
+	applyTestDiscount(t, ctx, withOwnDiscount, 40, now.Add(-time.Hour), now.Add(24*time.Hour))
+	unselected := createProductInCategory(t, ctx, "Other", true)
+
+	created, err := handler.CreateCampaign(ctx, &pb.CreateCampaignRequest{
+		Name:       "Black Friday",
+		Percentage: 25,
+		StartDate:  timestamppb.New(now.Add(-time.Hour)),
+		EndDate:    timestamppb.New(now.Add(24 * time.Hour)),
+		ProductIds: []string{withOwnDiscount},
+		Categories: []string{"Sale"},
+	})
+	require.NoError(t, err)
+	campaign := created.GetCampaign()
+	campaignID := campaign.GetCampaignId()
+	assert.Equal(t, "running", campaign.GetStatus())
+	require.Len(t, created.GetEvents(), 1)
+	assert.Equal(t, "campaign.created", created.GetEvents()[0].GetEventType())
+
+	effectivePrice := func(productID string) *big.Rat {
+		reply, err := handler.GetProduct(ctx, &pb.GetProductRequest{ProductId: productID})
+		require.NoError(t, err)
+		price := reply.GetProduct().GetEffectivePrice()
+		return big.NewRat(price.GetNumerator(), price.GetDenominator())
+	}
+	assert.Equal(t, 0, effectivePrice(inCategory).Cmp(big.NewRat(750, 100)))
+	assert.Equal(t, 0, effectivePrice(withOwnDiscount).Cmp(big.NewRat(600, 100)))
+	assert.Equal(t, 0, effectivePrice(unselected).Cmp(big.NewRat(1000, 100)))
+
+	t.Run("lists the products in the campaign", func(t *testing.T) {
+		reply, err := handler.ListProducts(ctx, &pb.ListProductsRequest{CampaignId: &campaignID})
+		require.NoError(t, err)
+		ids := make([]string, 0, len(reply.GetProducts()))
+		for _, p := range reply.GetProducts() {
+			ids = append(ids, p.GetId())
+		}
+		assert.ElementsMatch(t, []string{inCategory, withOwnDiscount}, ids)
+	})
+
+	t.Run("effective price filters include campaigns", func(t *testing.T) {
+		reply, err := handler.ListProducts(ctx, &pb.ListProductsRequest{
+			MaxEffectivePrice: &pb.Money{Numerator: 800, Denominator: 100},
+		})
+		require.NoError(t, err)
+		assert.Len(t, reply.GetProducts(), 2)
+	})
+
+	t.Run("lists campaigns by status", func(t *testing.T) {
+		running := "running"
+		reply, err := handler.ListCampaigns(ctx, &pb.ListCampaignsRequest{Status: &running})
+		require.NoError(t, err)
+		require.Len(t, reply.GetCampaigns(), 1)
+		assert.Equal(t, campaignID, reply.GetCampaigns()[0].GetCampaignId())
+
+		unknown := "paused"
+		_, err = handler.ListCampaigns(ctx, &pb.ListCampaignsRequest{Status: &unknown})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "INVALID_CAMPAIGN_STATUS", details.info.GetReason())
+	})
+
+	// Ending the campaign restores the prices at once
+	ended, err := handler.EndCampaign(ctx, &pb.EndCampaignRequest{CampaignId: campaignID})
+	require.NoError(t, err)
+	assert.Equal(t, "ended", ended.GetCampaign().GetStatus())
+	require.NotNil(t, ended.GetCampaign().GetEndedAt())
+	assert.Equal(t, 0, effectivePrice(inCategory).Cmp(big.NewRat(1000, 100)))
+	assert.Equal(t, 0, effectivePrice(withOwnDiscount).Cmp(big.NewRat(600, 100)))
+
+	fetched, err := handler.GetCampaign(ctx, &pb.GetCampaignRequest{CampaignId: campaignID})
+	require.NoError(t, err)
+	assert.Equal(t, "ended", fetched.GetCampaign().GetStatus())
+	assert.Equal(t, []string{"Sale"}, fetched.GetCampaign().GetCategories())
+
+	_, err = handler.EndCampaign(ctx, &pb.EndCampaignRequest{CampaignId: campaignID})
+	code, details := detailsOf(t, err)
+	assert.Equal(t, codes.FailedPrecondition, code)
+	assert.Equal(t, "CAMPAIGN_ALREADY_ENDED", details.info.GetReason())
+
+	events := getOutboxEvents(t, ctx, campaignID)
+	require.Len(t, events, 2)
+	assert.Equal(t, "campaign.created", events[0].EventType)
+	assert.Equal(t, "campaign.ended", events[1].EventType)
+}
+
+// TestCampaignValidation verifies campaign request and domain errors
+func TestCampaignValidation(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+	now := testClock.Now()
+
+	t.Run("empty selection", func(t *testing.T) {
+		_, err := handler.CreateCampaign(ctx, &pb.CreateCampaignRequest{
+			Name:       "Nothing",
+			Percentage: 10,
+			StartDate:  timestamppb.New(now),
+			EndDate:    timestamppb.New(now.Add(time.Hour)),
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "EMPTY_CAMPAIGN_SELECTION", details.info.GetReason())
+	})
+
+	t.Run("validate only does not persist", func(t *testing.T) {
+		reply, err := handler.CreateCampaign(ctx, &pb.CreateCampaignRequest{
+			Name:         "Dry run",
+			Percentage:   10,
+			StartDate:    timestamppb.New(now),
+			EndDate:      timestamppb.New(now.Add(time.Hour)),
+			Categories:   []string{"Sale"},
+			ValidateOnly: true,
+		})
+		require.NoError(t, err)
+
+		_, err = handler.GetCampaign(ctx, &pb.GetCampaignRequest{CampaignId: reply.GetCampaign().GetCampaignId()})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+		assert.Equal(t, "CAMPAIGN_NOT_FOUND", details.info.GetReason())
+	})
+
+	t.Run("missing campaign id", func(t *testing.T) {
+		_, err := handler.EndCampaign(ctx, &pb.EndCampaignRequest{})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "campaign_id", details.info.GetMetadata()["field"])
+	})
+}
@@ -0,0 +1,500 @@
+package e2e
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/run_bulk_update"
+	"github.com/product-catalog-service/internal/services"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// TestCategoryTree verifies category CRUD, product assignment by ID and
+// listing the products of a category with its descendants
+func TestCategoryTree(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+
+	createCategory := func(slug, displayName, parentID string) *pb.Category {
+		reply, err := handler.CreateCategory(ctx, &pb.CreateCategoryRequest{
+			Slug:        slug,
+			DisplayName: displayName,
+			ParentId:    parentID,
+		})
+		require.NoError(t, err)
+		return reply.GetCategory()
+	}
+
+	// home > garden > tools
+	home := createCategory("Home", "Home", "")
+	garden := createCategory("garden", "Garden", home.GetCategoryId())
+	tools := createCategory("garden-tools", "Garden Tools", garden.GetCategoryId())
+	assert.Equal(t, "home", home.GetSlug())
+	assert.Equal(t, home.GetCategoryId(), garden.GetParentId())
+
+	t.Run("slugs are unique", func(t *testing.T) {
+		_, err := handler.CreateCategory(ctx, &pb.CreateCategoryRequest{Slug: "GARDEN", DisplayName: "Other"})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.AlreadyExists, code)
+		assert.Equal(t, "CATEGORY_SLUG_TAKEN", details.info.GetReason())
+	})
+
+	t.Run("parent must exist", func(t *testing.T) {
+		_, err := handler.CreateCategory(ctx, &pb.CreateCategoryRequest{Slug: "orphan", DisplayName: "Orphan", ParentId: "missing"})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+		assert.Equal(t, "CATEGORY_PARENT_NOT_FOUND", details.info.GetReason())
+	})
+
+	t.Run("cannot move under a descendant", func(t *testing.T) {
+		parentID := tools.GetCategoryId()
+		_, err := handler.UpdateCategory(ctx, &pb.UpdateCategoryRequest{
+			CategoryId: home.GetCategoryId(),
+			ParentId:   &parentID,
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "CATEGORY_CYCLE", details.info.GetReason())
+	})
+
+	// Products: one in each category of the branch, one free-form
+	created, err := handler.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:       "Rake",
+		CategoryId: tools.GetCategoryId(),
+		BasePrice:  &pb.Money{Numerator: 1000, Denominator: 100},
+	})
+	require.NoError(t, err)
+	rake := created.GetProduct()
+	assert.Equal(t, "Garden Tools", rake.GetCategory())
+	assert.Equal(t, tools.GetCategoryId(), rake.GetCategoryId())
+
+	inGarden := createProductInCategory(t, ctx, "Anything", false)
+	_, err = handler.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		ProductId:  inGarden,
+		CategoryId: garden.GetCategoryId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"category_id"}},
+	})
+	require.NoError(t, err)
+	freeForm := createProductInCategory(t, ctx, "Garden", false)
+
+	t.Run("unknown category is rejected", func(t *testing.T) {
+		_, err := handler.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:       "Hoe",
+			CategoryId: "missing",
+			BasePrice:  &pb.Money{Numerator: 1000, Denominator: 100},
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+		assert.Equal(t, "CATEGORY_NOT_FOUND", details.info.GetReason())
+	})
+
+	t.Run("list a category with its descendants", func(t *testing.T) {
+		listIDs := func(categoryID string, descendants bool) []string {
+			reply, err := handler.ListProducts(ctx, &pb.ListProductsRequest{
+				CategoryId:         &categoryID,
+				IncludeDescendants: descendants,
+			})
+			require.NoError(t, err)
+			ids := make([]string, 0, len(reply.GetProducts()))
+			for _, p := range reply.GetProducts() {
+				ids = append(ids, p.GetId())
+			}
+			return ids
+		}
+
+		assert.ElementsMatch(t, []string{inGarden}, listIDs(garden.GetCategoryId(), false))
+		assert.ElementsMatch(t, []string{inGarden, rake.GetId()}, listIDs(garden.GetCategoryId(), true))
+		assert.ElementsMatch(t, []string{inGarden, rake.GetId()}, listIDs(home.GetCategoryId(), true))
+		assert.NotContains(t, listIDs(home.GetCategoryId(), true), freeForm)
+	})
+
+	t.Run("facets and export filter by category", func(t *testing.T) {
+		gardenID := garden.GetCategoryId()
+
+		facets, err := handler.GetProductFacets(ctx, &pb.GetProductFacetsRequest{CategoryId: &gardenID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), facets.GetTotalCount())
+
+		facets, err = handler.GetProductFacets(ctx, &pb.GetProductFacetsRequest{
+			CategoryId:         &gardenID,
+			IncludeDescendants: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), facets.GetTotalCount())
+
+		replies, err := export(ctx, &pb.ExportProductsRequest{
+			CategoryId:         &gardenID,
+			IncludeDescendants: true,
+		})
+		require.NoError(t, err)
+		var ids []string
+		for _, reply := range replies {
+			for _, p := range reply.GetProducts() {
+				ids = append(ids, p.GetId())
+			}
+		}
+		assert.ElementsMatch(t, []string{inGarden, rake.GetId()}, ids)
+	})
+
+	t.Run("list children", func(t *testing.T) {
+		parentID := home.GetCategoryId()
+		reply, err := handler.ListCategories(ctx, &pb.ListCategoriesRequest{ParentId: &parentID})
+		require.NoError(t, err)
+		require.Len(t, reply.GetCategories(), 1)
+		assert.Equal(t, garden.GetCategoryId(), reply.GetCategories()[0].GetCategoryId())
+
+		root := ""
+		reply, err = handler.ListCategories(ctx, &pb.ListCategoriesRequest{ParentId: &root})
+		require.NoError(t, err)
+		require.Len(t, reply.GetCategories(), 1)
+		assert.Equal(t, "home", reply.GetCategories()[0].GetSlug())
+	})
+
+	t.Run("delete refuses categories in use", func(t *testing.T) {
+		_, err := handler.DeleteCategory(ctx, &pb.DeleteCategoryRequest{CategoryId: garden.GetCategoryId()})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "CATEGORY_HAS_CHILDREN", details.info.GetReason())
+
+		_, err = handler.DeleteCategory(ctx, &pb.DeleteCategoryRequest{CategoryId: tools.GetCategoryId()})
+		_, details = detailsOf(t, err)
+		assert.Equal(t, "CATEGORY_IN_USE", details.info.GetReason())
+	})
+
+	t.Run("a free-form category detaches the product", func(t *testing.T) {
+		reply, err := handler.UpdateProduct(ctx, &pb.UpdateProductRequest{
+			ProductId:  rake.GetId(),
+			Category:   "Yard",
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"category"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Yard", reply.GetProduct().GetCategory())
+		assert.Empty(t, reply.GetProduct().GetCategoryId())
+
+		deleted, err := handler.DeleteCategory(ctx, &pb.DeleteCategoryRequest{CategoryId: tools.GetCategoryId()})
+		require.NoError(t, err)
+		require.Len(t, deleted.GetEvents(), 1)
+		assert.Equal(t, "category.deleted", deleted.GetEvents()[0].GetEventType())
+
+		_, err = handler.GetCategory(ctx, &pb.GetCategoryRequest{CategoryId: tools.GetCategoryId()})
+		code, _ := detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+	})
+}
+
+// TestCategoryRenameAndMerge verifies that renaming and merging categories
+// rewrite their products, archived ones included, through a bulk operation
+func TestCategoryRenameAndMerge(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	// Small chunks, so an operation spans several transactions
+	container := services.NewContainerWithOptions(testClient, services.Options{
+		Clock:         testClock,
+		BulkChunkSize: 2,
+	})
+	handler := container.ProductHandler
+
+	createCategory := func(slug, displayName, parentID string) *pb.Category {
+		reply, err := handler.CreateCategory(ctx, &pb.CreateCategoryRequest{
+			Slug:        slug,
+			DisplayName: displayName,
+			ParentId:    parentID,
+		})
+		require.NoError(t, err)
+		return reply.GetCategory()
+	}
+	create := func(name, categoryID string) string {
+		result, err := container.CreateProductUsecase.Execute(ctx, create_product.Request{
+			Name:                 name,
+			Description:          "Category test product",
+			CategoryID:           categoryID,
+			BasePriceNumerator:   1000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		return result.Product.ID
+	}
+	runOperation := func(operationID string) *pb.BulkOperation {
+		_, err := container.BulkUpdater.ProcessRunning(ctx)
+		require.NoError(t, err)
+
+		got, err := handler.GetBulkOperation(ctx, &pb.GetBulkOperationRequest{OperationId: operationID})
+		require.NoError(t, err)
+		return got.GetOperation()
+	}
+	updatedEvents := func(productID string) int {
+		var updated int
+		for _, event := range getOutboxEvents(t, ctx, productID) {
+			if event.EventType == "product.updated" {
+				updated++
+			}
+		}
+		return updated
+	}
+
+	// outdoor > {garden, patio > decking}
+	outdoor := createCategory("outdoor", "Outdoor", "")
+	garden := createCategory("garden", "Garden", outdoor.GetCategoryId())
+	patio := createCategory("patio", "Patio", outdoor.GetCategoryId())
+	decking := createCategory("decking", "Decking", patio.GetCategoryId())
+
+	inGarden := []string{create("Rake", garden.GetCategoryId()), create("Hoe", garden.GetCategoryId())}
+	archived := create("Old spade", garden.GetCategoryId())
+	_, err := container.ArchiveProductUsecase.Execute(ctx, archive_product.Request{ProductID: archived})
+	require.NoError(t, err)
+	inGarden = append(inGarden, archived)
+	inPatio := []string{create("Chair", patio.GetCategoryId()), create("Table", patio.GetCategoryId())}
+
+	t.Run("rename rewrites every product in the category", func(t *testing.T) {
+		reply, err := handler.RenameCategory(ctx, &pb.RenameCategoryRequest{
+			CategoryId:  garden.GetCategoryId(),
+			DisplayName: "Garden & Yard",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Garden & Yard", reply.GetCategory().GetDisplayName())
+		require.Len(t, reply.GetEvents(), 1)
+		assert.Equal(t, "category.renamed", reply.GetEvents()[0].GetEventType())
+
+		started := reply.GetOperation()
+		require.NotEmpty(t, started.GetOperationId())
+		assert.Equal(t, pb.BulkCommand_BULK_COMMAND_ASSIGN_CATEGORY, started.GetCommand())
+		assert.Equal(t, int64(3), started.GetMatchedCount(), "archived products are included")
+
+		op := runOperation(started.GetOperationId())
+		assert.True(t, op.GetDone())
+		assert.Equal(t, int64(3), op.GetSucceededCount())
+		assert.Zero(t, op.GetFailedCount())
+
+		for _, id := range inGarden {
+			product, err := container.ProductRepo.GetByID(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, "Garden & Yard", product.Category())
+			assert.Equal(t, 1, updatedEvents(id), "one product.updated per product")
+		}
+	})
+
+	t.Run("renaming to the same name starts nothing", func(t *testing.T) {
+		reply, err := handler.RenameCategory(ctx, &pb.RenameCategoryRequest{
+			CategoryId:  garden.GetCategoryId(),
+			DisplayName: "Garden & Yard",
+		})
+		require.NoError(t, err)
+		assert.Nil(t, reply.GetOperation())
+		assert.Empty(t, reply.GetEvents())
+	})
+
+	t.Run("cannot merge into a descendant", func(t *testing.T) {
+		_, err := handler.MergeCategories(ctx, &pb.MergeCategoriesRequest{
+			SourceCategoryIds: []string{patio.GetCategoryId()},
+			TargetCategoryId:  decking.GetCategoryId(),
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "CATEGORY_MERGE_INTO_DESCENDANT", details.info.GetReason())
+	})
+
+	t.Run("merge moves children and products to the target", func(t *testing.T) {
+		reply, err := handler.MergeCategories(ctx, &pb.MergeCategoriesRequest{
+			SourceCategoryIds: []string{patio.GetCategoryId()},
+			TargetCategoryId:  garden.GetCategoryId(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, garden.GetCategoryId(), reply.GetCategory().GetCategoryId())
+		assert.Equal(t, int64(2), reply.GetOperation().GetMatchedCount())
+
+		_, err = handler.GetCategory(ctx, &pb.GetCategoryRequest{CategoryId: patio.GetCategoryId()})
+		code, _ := detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+
+		moved, err := handler.GetCategory(ctx, &pb.GetCategoryRequest{CategoryId: decking.GetCategoryId()})
+		require.NoError(t, err)
+		assert.Equal(t, garden.GetCategoryId(), moved.GetCategory().GetParentId())
+
+		op := runOperation(reply.GetOperation().GetOperationId())
+		assert.True(t, op.GetDone())
+		assert.Equal(t, int64(2), op.GetSucceededCount())
+
+		for _, id := range inPatio {
+			product, err := container.ProductRepo.GetByID(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, garden.GetCategoryId(), product.CategoryID())
+			assert.Equal(t, "Garden & Yard", product.Category())
+			assert.Equal(t, 1, updatedEvents(id))
+		}
+
+		gardenID := garden.GetCategoryId()
+		listed, err := handler.ListProducts(ctx, &pb.ListProductsRequest{CategoryId: &gardenID})
+		require.NoError(t, err)
+		assert.Len(t, listed.GetProducts(), 4, "two moved products and the unarchived garden products")
+	})
+
+	t.Run("chained merge waits for the earlier operation", func(t *testing.T) {
+		sheds := createCategory("sheds", "Sheds", outdoor.GetCategoryId())
+		storage := createCategory("storage", "Storage", outdoor.GetCategoryId())
+		buildings := createCategory("buildings", "Buildings", "")
+		inSheds := []string{create("Shed", sheds.GetCategoryId()), create("Lean-to", sheds.GetCategoryId())}
+
+		first, err := handler.MergeCategories(ctx, &pb.MergeCategoriesRequest{
+			SourceCategoryIds: []string{sheds.GetCategoryId()},
+			TargetCategoryId:  storage.GetCategoryId(),
+		})
+		require.NoError(t, err)
+
+		// storage is still receiving the sheds products
+		_, err = handler.MergeCategories(ctx, &pb.MergeCategoriesRequest{
+			SourceCategoryIds: []string{storage.GetCategoryId()},
+			TargetCategoryId:  buildings.GetCategoryId(),
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "CATEGORY_MOVE_IN_PROGRESS", details.info.GetReason())
+
+		_, err = handler.DeleteCategory(ctx, &pb.DeleteCategoryRequest{CategoryId: storage.GetCategoryId()})
+		code, details = detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "CATEGORY_MOVE_IN_PROGRESS", details.info.GetReason())
+
+		op := runOperation(first.GetOperation().GetOperationId())
+		assert.True(t, op.GetDone())
+		assert.Zero(t, op.GetFailedCount())
+
+		second, err := handler.MergeCategories(ctx, &pb.MergeCategoriesRequest{
+			SourceCategoryIds: []string{storage.GetCategoryId()},
+			TargetCategoryId:  buildings.GetCategoryId(),
+		})
+		require.NoError(t, err)
+		op = runOperation(second.GetOperation().GetOperationId())
+		assert.Equal(t, int64(2), op.GetSucceededCount())
+
+		for _, id := range inSheds {
+			product, err := container.ProductRepo.GetByID(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, buildings.GetCategoryId(), product.CategoryID())
+			assert.Equal(t, "Buildings", product.Category())
+		}
+	})
+
+	t.Run("resumes after a crash and picks up a rename made mid-operation", func(t *testing.T) {
+		tools := createCategory("tools", "Tools", "")
+		var inTools []string
+		for _, name := range []string{"Saw", "Drill", "Plane", "Chisel", "File"} {
+			inTools = append(inTools, create(name, tools.GetCategoryId()))
+		}
+		sort.Strings(inTools) // chunks visit products in ID order
+
+		reply, err := handler.RenameCategory(ctx, &pb.RenameCategoryRequest{
+			CategoryId:  tools.GetCategoryId(),
+			DisplayName: "Hand Tools",
+		})
+		require.NoError(t, err)
+		operationID := reply.GetOperation().GetOperationId()
+
+		// One chunk commits, then the process dies
+		done, err := container.RunBulkUpdateUsecase.Execute(ctx, run_bulk_update.Request{OperationID: operationID})
+		require.NoError(t, err)
+		require.False(t, done)
+
+		got, err := handler.GetBulkOperation(ctx, &pb.GetBulkOperationRequest{OperationId: operationID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), got.GetOperation().GetProcessedCount())
+
+		// Renamed again while the first operation is only part way through
+		renamed, err := handler.RenameCategory(ctx, &pb.RenameCategoryRequest{
+			CategoryId:  tools.GetCategoryId(),
+			DisplayName: "Tools & Hardware",
+		})
+		require.NoError(t, err)
+
+		// A fresh process resumes at the committed cursor
+		restarted := services.NewContainerWithOptions(testClient, services.Options{
+			Clock:         testClock,
+			BulkChunkSize: 2,
+		})
+		for {
+			done, err := restarted.RunBulkUpdateUsecase.Execute(ctx, run_bulk_update.Request{OperationID: operationID})
+			require.NoError(t, err)
+			if done {
+				break
+			}
+		}
+
+		got, err = handler.GetBulkOperation(ctx, &pb.GetBulkOperationRequest{OperationId: operationID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), got.GetOperation().GetProcessedCount(), "no product is visited twice")
+		assert.Equal(t, int64(5), got.GetOperation().GetSucceededCount())
+
+		for i, id := range inTools {
+			product, err := container.ProductRepo.GetByID(ctx, id)
+			require.NoError(t, err)
+			want := "Tools & Hardware"
+			if i < 2 {
+				want = "Hand Tools" // moved before the second rename
+			}
+			assert.Equal(t, want, product.Category())
+			assert.Equal(t, 1, updatedEvents(id))
+		}
+
+		// The second rename's operation brings the first chunk up to date
+		op := runOperation(renamed.GetOperation().GetOperationId())
+		assert.True(t, op.GetDone())
+		for _, id := range inTools {
+			product, err := container.ProductRepo.GetByID(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, "Tools & Hardware", product.Category())
+		}
+	})
+
+	t.Run("campaigns selecting the name block a rename until they end", func(t *testing.T) {
+		now := testClock.Now()
+		campaign, err := handler.CreateCampaign(ctx, &pb.CreateCampaignRequest{
+			Name:       "Yard sale",
+			Percentage: 10,
+			StartDate:  timestamppb.New(now),
+			EndDate:    timestamppb.New(now.Add(24 * time.Hour)),
+			Categories: []string{"Garden & Yard"},
+		})
+		require.NoError(t, err)
+
+		_, err = handler.RenameCategory(ctx, &pb.RenameCategoryRequest{
+			CategoryId:  garden.GetCategoryId(),
+			DisplayName: "Yard",
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "CATEGORY_IN_PROMOTION", details.info.GetReason())
+		assert.Contains(t, err.Error(), campaign.GetCampaign().GetCampaignId())
+
+		_, err = handler.EndCampaign(ctx, &pb.EndCampaignRequest{CampaignId: campaign.GetCampaign().GetCampaignId()})
+		require.NoError(t, err)
+
+		reply, err := handler.RenameCategory(ctx, &pb.RenameCategoryRequest{
+			CategoryId:  garden.GetCategoryId(),
+			DisplayName: "Yard",
+		})
+		require.NoError(t, err)
+		assert.True(t, runOperation(reply.GetOperation().GetOperationId()).GetDone())
+	})
+
+	t.Run("unknown source is rejected", func(t *testing.T) {
+		_, err := handler.MergeCategories(ctx, &pb.MergeCategoriesRequest{
+			SourceCategoryIds: []string{"missing"},
+			TargetCategoryId:  garden.GetCategoryId(),
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+		assert.Equal(t, "CATEGORY_NOT_FOUND", details.info.GetReason())
+	})
+}
@@ -0,0 +1,120 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// TestCommandRepliesReturnProduct verifies that command replies carry the committed product state
+func TestCommandRepliesReturnProduct(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+	now := testClock.Now()
+
+	created, err := handler.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        "Reply Product",
+		Description: "Description",
+		Category:    "Replies",
+		BasePrice:   &pb.Money{Numerator: 10000, Denominator: 100},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.GetProduct())
+	assert.Equal(t, created.GetProductId(), created.GetProduct().GetId())
+	assert.Equal(t, "draft", created.GetProduct().GetStatus())
+	assert.Equal(t, int64(1), created.GetProduct().GetVersion())
+	productID := created.GetProductId()
+
+	updated, err := handler.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		ProductId:   productID,
+		Description: "Updated description",
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Reply Product", updated.GetProduct().GetName())
+	assert.Equal(t, "Updated description", updated.GetProduct().GetDescription())
+	assert.Equal(t, int64(2), updated.GetProduct().GetVersion())
+
+	activated, err := handler.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: productID})
+	require.NoError(t, err)
+	assert.Equal(t, "active", activated.GetProduct().GetStatus())
+	assert.Equal(t, int64(3), activated.GetProduct().GetVersion())
+
+	discounted, err := handler.ApplyDiscount(ctx, &pb.ApplyDiscountRequest{
+		ProductId:  productID,
+		Percentage: 25,
+		StartDate:  timestamppb.New(now.Add(-time.Hour)),
+		EndDate:    timestamppb.New(now.Add(24 * time.Hour)),
+	})
+	require.NoError(t, err)
+	product := discounted.GetProduct()
+	assert.Equal(t, int64(4), product.GetVersion())
+	assert.Equal(t, int64(25), product.GetDiscount().GetPercentage())
+	effective := big.NewRat(product.GetEffectivePrice().GetNumerator(), product.GetEffectivePrice().GetDenominator())
+	assert.Equal(t, 0, effective.Cmp(big.NewRat(75, 1)))
+	assert.True(t, product.GetUpdatedAt().AsTime().Equal(now))
+
+	// The stored row agrees with the reply
+	stored, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+	require.NoError(t, err)
+	assert.Equal(t, product.GetVersion(), stored.Version)
+}
+
+// TestCommandExpectedVersion verifies that a command given a stale version is aborted without changes
+func TestCommandExpectedVersion(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+
+	created, err := handler.CreateProduct(ctx, &pb.CreateProductRequest{
+		Name:        "Versioned Product",
+		Description: "Description",
+		Category:    "Versions",
+		BasePrice:   &pb.Money{Numerator: 10000, Denominator: 100},
+	})
+	require.NoError(t, err)
+	productID := created.GetProductId()
+
+	updated, err := handler.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		ProductId:       productID,
+		Description:     "First writer",
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		ExpectedVersion: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), updated.GetProduct().GetVersion())
+
+	// A second writer that read version 1 does not overwrite the first
+	_, err = handler.UpdateProduct(ctx, &pb.UpdateProductRequest{
+		ProductId:       productID,
+		Description:     "Second writer",
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		ExpectedVersion: 1,
+	})
+	code, details := detailsOf(t, err)
+	assert.Equal(t, codes.Aborted, code)
+	assert.Equal(t, "VERSION_MISMATCH", details.info.GetReason())
+
+	_, err = handler.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: productID, ExpectedVersion: 1})
+	code, _ = detailsOf(t, err)
+	assert.Equal(t, codes.Aborted, code)
+
+	stored, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+	require.NoError(t, err)
+	assert.Equal(t, "First writer", stored.Description)
+	assert.Equal(t, int64(2), stored.Version)
+	assert.Equal(t, "draft", stored.Status)
+}
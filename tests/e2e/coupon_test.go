@@ -0,0 +1,204 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// TestCouponLifecycle verifies that a coupon prices eligible products on top
+// of their effective price and that redemptions are counted once per order
+func TestCouponLifecycle(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+	now := testClock.Now()
+
+	// $10.00 each: one in the coupon's category with its own 20% discount,
+	// one in another category, and an inactive one in the category
+	discounted := createProductInCategory(t, ctx, "Garden", false)
+	applyTestDiscount(t, ctx, discounted, 20, now.Add(-time.Hour), now.Add(24*time.Hour))
+	otherCategory := createProductInCategory(t, ctx, "Books", true)
+	inactive := createProductInCategory(t, ctx, "Garden", false)
+
+	created, err := handler.CreateCoupon(ctx, &pb.CreateCouponRequest{
+		Code:           "spring10",
+		Percentage:     10,
+		Categories:     []string{"Garden"},
+		StartDate:      timestamppb.New(now.Add(-time.Hour)),
+		EndDate:        timestamppb.New(now.Add(24 * time.Hour)),
+		MaxRedemptions: 1,
+	})
+	require.NoError(t, err)
+	coupon := created.GetCoupon()
+	assert.Equal(t, "SPRING10", coupon.GetCode())
+	require.Len(t, created.GetEvents(), 1)
+	assert.Equal(t, "coupon.created", created.GetEvents()[0].GetEventType())
+
+	rat := func(m *pb.Money) *big.Rat {
+		return big.NewRat(m.GetNumerator(), m.GetDenominator())
+	}
+
+	t.Run("validate prices each product", func(t *testing.T) {
+		reply, err := handler.ValidateCoupon(ctx, &pb.ValidateCouponRequest{
+			Code:       "Spring10",
+			ProductIds: []string{discounted, otherCategory, inactive, "missing"},
+		})
+		require.NoError(t, err)
+		lines := reply.GetLines()
+		require.Len(t, lines, 4)
+
+		// $10.00 less 20% less 10%: the coupon stacks on the discount
+		assert.True(t, lines[0].GetEligible())
+		assert.Equal(t, 0, rat(lines[0].GetPrice()).Cmp(big.NewRat(800, 100)))
+		assert.Equal(t, 0, rat(lines[0].GetDiscountedPrice()).Cmp(big.NewRat(720, 100)))
+
+		assert.False(t, lines[1].GetEligible())
+		assert.Equal(t, "COUPON_NOT_APPLICABLE", lines[1].GetIneligibleReason())
+		assert.Equal(t, 0, rat(lines[1].GetDiscountedPrice()).Cmp(big.NewRat(1000, 100)))
+		assert.Equal(t, "PRODUCT_NOT_ACTIVE", lines[2].GetIneligibleReason())
+		assert.Equal(t, "PRODUCT_NOT_FOUND", lines[3].GetIneligibleReason())
+		assert.Nil(t, lines[3].GetPrice())
+	})
+
+	t.Run("codes are unique", func(t *testing.T) {
+		_, err := handler.CreateCoupon(ctx, &pb.CreateCouponRequest{
+			Code:       "SPRING10",
+			Percentage: 15,
+			StartDate:  timestamppb.New(now),
+			EndDate:    timestamppb.New(now.Add(time.Hour)),
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.AlreadyExists, code)
+		assert.Equal(t, "COUPON_CODE_TAKEN", details.info.GetReason())
+	})
+
+	t.Run("an order needs an eligible product", func(t *testing.T) {
+		_, err := handler.RedeemCoupon(ctx, &pb.RedeemCouponRequest{
+			Code:       "SPRING10",
+			OrderId:    "order-0",
+			ProductIds: []string{otherCategory},
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "COUPON_NOT_APPLICABLE", details.info.GetReason())
+	})
+
+	redeemed, err := handler.RedeemCoupon(ctx, &pb.RedeemCouponRequest{
+		Code:       "SPRING10",
+		OrderId:    "order-1",
+		ProductIds: []string{discounted, otherCategory},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), redeemed.GetCoupon().GetRedemptionCount())
+	require.Len(t, redeemed.GetLines(), 2)
+	assert.True(t, redeemed.GetLines()[0].GetEligible())
+	require.Len(t, redeemed.GetEvents(), 1)
+	assert.Equal(t, "coupon.redeemed", redeemed.GetEvents()[0].GetEventType())
+
+	// Retrying the order is rejected rather than counted twice
+	_, err = handler.RedeemCoupon(ctx, &pb.RedeemCouponRequest{
+		Code:       "SPRING10",
+		OrderId:    "order-1",
+		ProductIds: []string{discounted},
+	})
+	code, details := detailsOf(t, err)
+	assert.Equal(t, codes.AlreadyExists, code)
+	assert.Equal(t, "COUPON_ALREADY_REDEEMED", details.info.GetReason())
+
+	// The single redemption is used up
+	_, err = handler.RedeemCoupon(ctx, &pb.RedeemCouponRequest{
+		Code:       "SPRING10",
+		OrderId:    "order-2",
+		ProductIds: []string{discounted},
+	})
+	code, details = detailsOf(t, err)
+	assert.Equal(t, codes.FailedPrecondition, code)
+	assert.Equal(t, "COUPON_EXHAUSTED", details.info.GetReason())
+
+	events := getOutboxEvents(t, ctx, coupon.GetCouponId())
+	require.Len(t, events, 2)
+	assert.Equal(t, "coupon.created", events[0].EventType)
+	assert.Equal(t, "coupon.redeemed", events[1].EventType)
+}
+
+// TestCouponValidation verifies coupon request and domain errors
+func TestCouponValidation(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+	now := testClock.Now()
+
+	t.Run("fixed amount and percentage", func(t *testing.T) {
+		_, err := handler.CreateCoupon(ctx, &pb.CreateCouponRequest{
+			Code:       "BOTH",
+			Percentage: 10,
+			AmountOff:  &pb.Money{Numerator: 500, Denominator: 100},
+			StartDate:  timestamppb.New(now),
+			EndDate:    timestamppb.New(now.Add(time.Hour)),
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "INVALID_COUPON_RULE", details.info.GetReason())
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		_, err := handler.CreateCoupon(ctx, &pb.CreateCouponRequest{
+			Code:       "SPRING 10",
+			Percentage: 10,
+			StartDate:  timestamppb.New(now),
+			EndDate:    timestamppb.New(now.Add(time.Hour)),
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "INVALID_COUPON_CODE", details.info.GetReason())
+	})
+
+	t.Run("validate only does not persist", func(t *testing.T) {
+		_, err := handler.CreateCoupon(ctx, &pb.CreateCouponRequest{
+			Code:         "DRYRUN",
+			AmountOff:    &pb.Money{Numerator: 500, Denominator: 100},
+			StartDate:    timestamppb.New(now),
+			EndDate:      timestamppb.New(now.Add(time.Hour)),
+			ValidateOnly: true,
+		})
+		require.NoError(t, err)
+
+		_, err = handler.ValidateCoupon(ctx, &pb.ValidateCouponRequest{Code: "DRYRUN", ProductIds: []string{"p1"}})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+		assert.Equal(t, "COUPON_NOT_FOUND", details.info.GetReason())
+	})
+
+	t.Run("not started", func(t *testing.T) {
+		_, err := handler.CreateCoupon(ctx, &pb.CreateCouponRequest{
+			Code:       "LATER",
+			Percentage: 10,
+			StartDate:  timestamppb.New(now.Add(time.Hour)),
+			EndDate:    timestamppb.New(now.Add(2 * time.Hour)),
+		})
+		require.NoError(t, err)
+
+		_, err = handler.ValidateCoupon(ctx, &pb.ValidateCouponRequest{Code: "LATER", ProductIds: []string{"p1"}})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "COUPON_NOT_STARTED", details.info.GetReason())
+	})
+
+	t.Run("missing order id", func(t *testing.T) {
+		_, err := handler.RedeemCoupon(ctx, &pb.RedeemCouponRequest{Code: "LATER", ProductIds: []string{"p1"}})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "order_id", details.info.GetMetadata()["field"])
+	})
+}
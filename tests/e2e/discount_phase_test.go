@@ -0,0 +1,78 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiscountBoundaryEvents tests that the scheduler announces discount start and expiry
+func TestDiscountBoundaryEvents(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	start := testClock.Now()
+	defer testClock.SetTime(start)
+
+	findEvent := func(t *testing.T, productID, eventType string) map[string]interface{} {
+		t.Helper()
+		for _, e := range getOutboxEvents(t, ctx, productID) {
+			if e.EventType == eventType {
+				payload, ok := e.Payload.(map[string]interface{})
+				require.True(t, ok, "Payload should be a map")
+				return payload
+			}
+		}
+		return nil
+	}
+
+	projectedPrice := func(t *testing.T, productID string) *big.Rat {
+		t.Helper()
+		_, err := testContainer.ProjectionDispatcher.Drain(ctx)
+		require.NoError(t, err)
+		view, err := newViewReadModel().GetByID(ctx, productID)
+		require.NoError(t, err)
+		return big.NewRat(view.EffectivePriceNum, view.EffectivePriceDenom)
+	}
+
+	productID := createTestProduct(t, ctx)
+	applyTestDiscount(t, ctx, productID, 20, start.Add(time.Hour), start.Add(2*time.Hour))
+
+	// Nothing due before the discount starts
+	fired, err := testContainer.Scheduler.FireDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, fired)
+	assert.Equal(t, 0, projectedPrice(t, productID).Cmp(big.NewRat(1999, 100)))
+
+	// Start: announced once with the discounted price
+	testClock.SetTime(start.Add(time.Hour))
+	fired, err = testContainer.Scheduler.FireDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fired)
+
+	fired, err = testContainer.Scheduler.FireDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, fired)
+
+	started := findEvent(t, productID, "product.discount_started")
+	require.NotNil(t, started)
+	assert.EqualValues(t, 20, started["percentage"])
+	assert.Equal(t, 0, projectedPrice(t, productID).Cmp(big.NewRat(1999*80, 100*100)))
+
+	// Expiry: announced once with the base price restored
+	testClock.SetTime(start.Add(2*time.Hour + time.Minute))
+	fired, err = testContainer.Scheduler.FireDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fired)
+
+	fired, err = testContainer.Scheduler.FireDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, fired)
+
+	assert.NotNil(t, findEvent(t, productID, "product.discount_expired"))
+	assert.Equal(t, 0, projectedPrice(t, productID).Cmp(big.NewRat(1999, 100)))
+}
@@ -0,0 +1,213 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/product-catalog-service/internal/app/product/queries/export_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_campaign"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// exportStream is a server stream collecting the replies of ExportProducts.
+type exportStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	replies []*pb.ExportProductsReply
+}
+
+func (s *exportStream) Context() context.Context { return s.ctx }
+
+func (s *exportStream) Send(reply *pb.ExportProductsReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+// export runs ExportProducts and returns its replies.
+func export(ctx context.Context, req *pb.ExportProductsRequest) ([]*pb.ExportProductsReply, error) {
+	stream := &exportStream{ctx: ctx}
+	err := testContainer.ProductHandler.ExportProducts(req, stream)
+	return stream.replies, err
+}
+
+// exportedChunks joins the chunks of an encoded export.
+func exportedChunks(replies []*pb.ExportProductsReply) string {
+	var buf bytes.Buffer
+	for _, reply := range replies {
+		buf.Write(reply.GetChunk())
+	}
+	return buf.String()
+}
+
+// TestExportProducts verifies the catalog is streamed at one read timestamp
+func TestExportProducts(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	now := testClock.Now()
+
+	create := func(name, category string, priceNum, priceDenom int64) string {
+		result, err := testContainer.CreateProductUsecase.Execute(ctx, create_product.Request{
+			Name:                 name,
+			Description:          "Exported, with a comma",
+			Category:             category,
+			BasePriceNumerator:   priceNum,
+			BasePriceDenominator: priceDenom,
+		})
+		require.NoError(t, err)
+		return result.Product.ID
+	}
+
+	lamp := create("Lamp", "Lighting", 2450, 100)
+	bulb := create("Bulb", "Lighting", 3, 1)
+	create("Third", "Odd", 1, 3)
+	applyTestDiscount(t, ctx, lamp, 20, now, now.Add(24*time.Hour))
+
+	archived := create("Old lamp", "Lighting", 500, 100)
+	_, err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{ProductID: archived})
+	require.NoError(t, err)
+
+	t.Run("proto messages at one read timestamp", func(t *testing.T) {
+		replies, err := export(ctx, &pb.ExportProductsRequest{Parallelism: 2})
+		require.NoError(t, err)
+		require.NotEmpty(t, replies)
+
+		readTimestamp := replies[0].GetReadTimestamp().AsTime()
+		ids := make(map[string]*pb.Product)
+		for _, reply := range replies {
+			assert.Equal(t, readTimestamp, reply.GetReadTimestamp().AsTime())
+			assert.Empty(t, reply.GetChunk())
+			for _, product := range reply.GetProducts() {
+				ids[product.GetId()] = product
+			}
+		}
+
+		assert.Len(t, ids, 3, "archived products are excluded by default")
+		require.Contains(t, ids, lamp)
+		assert.Equal(t, int64(20), ids[lamp].GetDiscount().GetPercentage())
+		effective := ids[lamp].GetEffectivePrice()
+		assert.Equal(t, 0, big.NewRat(effective.GetNumerator(), effective.GetDenominator()).Cmp(big.NewRat(1960, 100)))
+	})
+
+	t.Run("csv can be read back", func(t *testing.T) {
+		category := "Lighting"
+		replies, err := export(ctx, &pb.ExportProductsRequest{
+			Format:       pb.ExportFormat_EXPORT_FORMAT_CSV,
+			Category:     &category,
+			ArchivedMode: pb.ArchivedMode_ARCHIVED_MODE_INCLUDE,
+		})
+		require.NoError(t, err)
+
+		records, err := csv.NewReader(strings.NewReader(exportedChunks(replies))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		assert.Equal(t, export_products.Columns, records[0])
+
+		prices := make(map[string][]string)
+		for _, record := range records[1:] {
+			prices[record[2]] = []string{record[3], record[6], record[7], record[8]}
+		}
+		assert.Equal(t, []string{"Exported, with a comma", "24.50", "19.60", "20"}, prices["Lamp"])
+		assert.Equal(t, []string{"Exported, with a comma", "3.00", "3.00", ""}, prices["Bulb"])
+		assert.Contains(t, prices, "Old lamp")
+	})
+
+	t.Run("ndjson with price filter", func(t *testing.T) {
+		replies, err := export(ctx, &pb.ExportProductsRequest{
+			Format:       pb.ExportFormat_EXPORT_FORMAT_NDJSON,
+			MaxBasePrice: &pb.Money{Numerator: 1, Denominator: 1},
+		})
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(exportedChunks(replies)), "\n")
+		require.Len(t, lines, 1)
+
+		var product map[string]any
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &product))
+		assert.Equal(t, "Third", product["name"])
+		assert.Equal(t, "0.333333333333", product["price"])
+		assert.NotContains(t, product, "discount_percent")
+	})
+
+	t.Run("effective price filters include campaigns", func(t *testing.T) {
+		_, err := testContainer.CreateCampaignUsecase.Execute(ctx, create_campaign.Request{
+			Name:       "Half-price bulbs",
+			Percentage: 50,
+			StartDate:  now.Add(-time.Hour),
+			EndDate:    now.Add(24 * time.Hour),
+			ProductIDs: []string{bulb},
+		})
+		require.NoError(t, err)
+
+		exportedNames := func(req *pb.ExportProductsRequest) []string {
+			replies, err := export(ctx, req)
+			require.NoError(t, err)
+			var names []string
+			for _, reply := range replies {
+				for _, product := range reply.GetProducts() {
+					names = append(names, product.GetName())
+				}
+			}
+			return names
+		}
+
+		// The lamp's base price is above 20, its discounted price is not
+		assert.ElementsMatch(t, []string{"Lamp"}, exportedNames(&pb.ExportProductsRequest{
+			MinEffectivePrice: &pb.Money{Numerator: 19, Denominator: 1},
+			MaxEffectivePrice: &pb.Money{Numerator: 20, Denominator: 1},
+		}))
+		// The bulb costs 3 but 1.50 in the campaign
+		assert.ElementsMatch(t, []string{"Bulb", "Third"}, exportedNames(&pb.ExportProductsRequest{
+			MaxEffectivePrice: &pb.Money{Numerator: 2, Denominator: 1},
+		}))
+		assert.ElementsMatch(t, []string{"Bulb"}, exportedNames(&pb.ExportProductsRequest{
+			MinEffectivePrice: &pb.Money{Numerator: 3, Denominator: 2},
+			MaxEffectivePrice: &pb.Money{Numerator: 3, Denominator: 2},
+		}))
+	})
+
+	t.Run("empty csv export still has a header", func(t *testing.T) {
+		category := "Nothing here"
+		replies, err := export(ctx, &pb.ExportProductsRequest{
+			Format:   pb.ExportFormat_EXPORT_FORMAT_CSV,
+			Category: &category,
+		})
+		require.NoError(t, err)
+		require.Len(t, replies, 1)
+		assert.NotNil(t, replies[0].GetReadTimestamp())
+		assert.Equal(t, strings.Join(export_products.Columns, ",")+"\n", exportedChunks(replies))
+	})
+
+	t.Run("invalid requests are rejected", func(t *testing.T) {
+		_, err := export(ctx, &pb.ExportProductsRequest{Parallelism: 17})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "INVALID_PARALLELISM", details.info.GetReason())
+
+		_, err = export(ctx, &pb.ExportProductsRequest{Format: pb.ExportFormat(9)})
+		code, details = detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "format", details.info.GetMetadata()["field"])
+
+		_, err = export(ctx, &pb.ExportProductsRequest{
+			MinBasePrice: &pb.Money{Numerator: 5, Denominator: 1},
+			MaxBasePrice: &pb.Money{Numerator: 1, Denominator: 1},
+		})
+		code, details = detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "INVALID_PRICE_RANGE", details.info.GetReason())
+	})
+}
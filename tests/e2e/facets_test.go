@@ -0,0 +1,114 @@
+package e2e
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/queries/get_product_facets"
+	"github.com/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
+)
+
+// TestProductFacets verifies facet counts are aggregated in Spanner over the filtered set
+func TestProductFacets(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	now := testClock.Now()
+
+	create := func(category string, priceCents int64) string {
+		result, err := testContainer.CreateProductUsecase.Execute(ctx, create_product.Request{
+			Name:                 "Facet product",
+			Description:          "Description",
+			Category:             category,
+			BasePriceNumerator:   priceCents,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		return result.Product.ID
+	}
+
+	// Electronics: 10.00 draft, 30.00 active at 50% off (15.00)
+	create("Electronics", 1000)
+	discounted := create("Electronics", 3000)
+	applyTestDiscount(t, ctx, discounted, 50, now, now.Add(24*time.Hour))
+
+	// Books: 60.00 active at 30% off (42.00), plus an archived product
+	book := create("Books", 6000)
+	applyTestDiscount(t, ctx, book, 30, now, now.Add(24*time.Hour))
+
+	archived := create("Books", 500)
+	_, err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{ProductID: archived})
+	require.NoError(t, err)
+
+	values := func(facets []get_product_facets.FacetValueDTO) map[string]int64 {
+		m := make(map[string]int64, len(facets))
+		for _, f := range facets {
+			m[f.Value] = f.Count
+		}
+		return m
+	}
+
+	t.Run("all products", func(t *testing.T) {
+		facets, err := testContainer.GetProductFacetsQuery.Execute(ctx, get_product_facets.Request{})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(3), facets.TotalCount)
+		assert.Equal(t, map[string]int64{"Electronics": 2, "Books": 1}, values(facets.Categories))
+		assert.Equal(t, "Electronics", facets.Categories[0].Value, "most frequent first")
+		assert.Equal(t, map[string]int64{"active": 2, "draft": 1}, values(facets.Statuses))
+		assert.Equal(t, map[string]int64{
+			"none":   1,
+			"1-24":   0,
+			"25-49":  1,
+			"50-74":  1,
+			"75-100": 0,
+		}, values(facets.DiscountBuckets))
+
+		// Default bands: <10, 10-25, 25-50, 50-100, 100-250, >=250
+		require.Len(t, facets.PriceBands, 6)
+		counts := make([]int64, len(facets.PriceBands))
+		for i, b := range facets.PriceBands {
+			counts[i] = b.Count
+		}
+		assert.Equal(t, []int64{0, 2, 1, 0, 0, 0}, counts)
+		assert.Nil(t, facets.PriceBands[0].Min)
+		assert.Nil(t, facets.PriceBands[5].Max)
+	})
+
+	t.Run("honors list filters", func(t *testing.T) {
+		category := "Electronics"
+		facets, err := testContainer.GetProductFacetsQuery.Execute(ctx, get_product_facets.Request{
+			Category:   &category,
+			ActiveOnly: true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1), facets.TotalCount)
+		assert.Equal(t, map[string]int64{"active": 1}, values(facets.Statuses))
+	})
+
+	t.Run("custom price bands", func(t *testing.T) {
+		facets, err := testContainer.GetProductFacetsQuery.Execute(ctx, get_product_facets.Request{
+			PriceBandBoundaries: []*big.Rat{big.NewRat(20, 1)},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, facets.PriceBands, 2)
+		assert.Equal(t, int64(2), facets.PriceBands[0].Count)
+		assert.Equal(t, int64(1), facets.PriceBands[1].Count)
+	})
+
+	t.Run("invalid price bands", func(t *testing.T) {
+		_, err := testContainer.GetProductFacetsQuery.Execute(ctx, get_product_facets.Request{
+			PriceBandBoundaries: []*big.Rat{big.NewRat(50, 1), big.NewRat(20, 1)},
+		})
+
+		assert.ErrorIs(t, err, get_product_facets.ErrInvalidPriceBands)
+	})
+}
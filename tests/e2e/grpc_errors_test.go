@@ -0,0 +1,96 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	grpcHandler "github.com/product-catalog-service/internal/transport/grpc/product"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// errorDetails collects the standard details of a gRPC error.
+type errorDetails struct {
+	info    *errdetails.ErrorInfo
+	request *errdetails.RequestInfo
+	fields  *errdetails.BadRequest
+}
+
+func detailsOf(t *testing.T, err error) (codes.Code, errorDetails) {
+	t.Helper()
+	require.Error(t, err)
+
+	st := status.Convert(err)
+	var details errorDetails
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			details.info = d
+		case *errdetails.RequestInfo:
+			details.request = d
+		case *errdetails.BadRequest:
+			details.fields = d
+		}
+	}
+	require.NotNil(t, details.info, "ErrorInfo detail")
+	require.NotNil(t, details.request, "RequestInfo detail")
+	assert.Equal(t, grpcHandler.ErrorDomain, details.info.GetDomain())
+	return st.Code(), details
+}
+
+// TestGRPCErrorDetails verifies machine-readable error details on gRPC errors
+func TestGRPCErrorDetails(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+
+	t.Run("not found keeps the caller's request id", func(t *testing.T) {
+		callCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(grpcHandler.RequestIDHeader, "req-123"))
+
+		_, err := handler.GetProduct(callCtx, &pb.GetProductRequest{ProductId: "missing"})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.NotFound, code)
+		assert.Equal(t, "PRODUCT_NOT_FOUND", details.info.GetReason())
+		assert.Equal(t, "req-123", details.request.GetRequestId())
+	})
+
+	t.Run("validation error names the field", func(t *testing.T) {
+		_, err := handler.ActivateProduct(ctx, &pb.ActivateProductRequest{})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "MISSING_FIELD", details.info.GetReason())
+		assert.Equal(t, "product_id", details.info.GetMetadata()["field"])
+		require.NotNil(t, details.fields)
+		require.Len(t, details.fields.GetFieldViolations(), 1)
+		assert.Equal(t, "product_id", details.fields.GetFieldViolations()[0].GetField())
+		assert.NotEmpty(t, details.request.GetRequestId())
+	})
+
+	t.Run("shared validation error names the failing field", func(t *testing.T) {
+		_, err := handler.ListProducts(ctx, &pb.ListProductsRequest{
+			MaxEffectivePrice: &pb.Money{Numerator: 1, Denominator: 0},
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "max_effective_price", details.info.GetMetadata()["field"])
+	})
+
+	t.Run("business rule has a stable reason", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+		_, err := handler.ArchiveProduct(ctx, &pb.ArchiveProductRequest{ProductId: productID})
+		require.NoError(t, err)
+
+		_, err = handler.ActivateProduct(ctx, &pb.ActivateProductRequest{ProductId: productID})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.FailedPrecondition, code)
+		assert.Equal(t, "PRODUCT_ARCHIVED", details.info.GetReason())
+		assert.Nil(t, details.fields)
+	})
+}
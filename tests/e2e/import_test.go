@@ -0,0 +1,200 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/import_products"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// importStream is a client stream sending the given messages to ImportProducts.
+type importStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*pb.ImportProductsRequest
+	reply    *pb.ImportProductsReply
+}
+
+func (s *importStream) Context() context.Context { return s.ctx }
+
+func (s *importStream) Recv() (*pb.ImportProductsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importStream) SendAndClose(reply *pb.ImportProductsReply) error {
+	s.reply = reply
+	return nil
+}
+
+// importChunks streams source to ImportProducts split into chunks of size bytes.
+func importChunks(ctx context.Context, format pb.ImportFormat, validateOnly bool, source string, size int) (*pb.ImportProductsReply, error) {
+	stream := &importStream{ctx: ctx}
+	for len(source) > 0 || len(stream.requests) == 0 {
+		n := min(size, len(source))
+		stream.requests = append(stream.requests, &pb.ImportProductsRequest{Chunk: []byte(source[:n])})
+		source = source[n:]
+	}
+	stream.requests[0].Format = format
+	stream.requests[0].ValidateOnly = validateOnly
+
+	if err := testContainer.ProductHandler.ImportProducts(stream); err != nil {
+		return nil, err
+	}
+	return stream.reply, nil
+}
+
+// TestImportProducts verifies bulk import with upsert by external key
+func TestImportProducts(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	t.Run("csv rows are created, then upserted by key", func(t *testing.T) {
+		source := "external_key,name,description,category,price\n" +
+			"SKU-1,Lamp,\"Desk lamp, brass\",Lighting,24.50\n" +
+			"SKU-2,Bulb,,Lighting,3\n" +
+			",Shade,No key,Lighting,9.99\n" +
+			"SKU-3,,Nameless,Lighting,5\n" +
+			"SKU-4,Free,,Lighting,0\n" +
+			"SKU-5,Cheap,,Lighting,abc\n"
+
+		reply, err := importChunks(ctx, pb.ImportFormat_IMPORT_FORMAT_CSV, false, source, 7)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), reply.GetCreated())
+		assert.Equal(t, int32(3), reply.GetFailed())
+		require.Len(t, reply.GetRows(), 6)
+
+		lamp := reply.GetRows()[0]
+		assert.Equal(t, int32(2), lamp.GetLine())
+		assert.Equal(t, "created", lamp.GetAction())
+		product, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: lamp.GetProductId()})
+		require.NoError(t, err)
+		assert.Equal(t, "Desk lamp, brass", product.Description)
+		assert.Equal(t, "SKU-1", product.ExternalKey)
+		assert.Equal(t, int64(49), product.BasePriceNumerator)
+		assert.Equal(t, int64(2), product.BasePriceDenominator)
+
+		assert.Equal(t, "EMPTY_PRODUCT_NAME", reply.GetRows()[3].GetErrorReason())
+		assert.Equal(t, "name", reply.GetRows()[3].GetErrorField())
+		assert.Equal(t, "ZERO_PRICE", reply.GetRows()[4].GetErrorReason())
+		assert.Equal(t, "INVALID_PRICE", reply.GetRows()[5].GetErrorReason())
+		assert.Empty(t, reply.GetRows()[5].GetAction())
+
+		// Importing again updates by key; unchanged rows write nothing
+		source = "external_key,name,category,description,price\n" +
+			"SKU-1,Lamp,Lighting,\"Desk lamp, brass\",26\n" +
+			"SKU-2,Bulb,Lighting,,3.00\n"
+
+		reply, err = importChunks(ctx, pb.ImportFormat_IMPORT_FORMAT_CSV, false, source, 1024)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), reply.GetUpdated())
+		assert.Equal(t, int32(1), reply.GetUnchanged())
+		assert.Equal(t, lamp.GetProductId(), reply.GetRows()[0].GetProductId())
+		assert.Equal(t, "updated", reply.GetRows()[0].GetAction())
+		assert.Equal(t, "unchanged", reply.GetRows()[1].GetAction())
+
+		var types []string
+		for _, e := range getOutboxEvents(t, ctx, lamp.GetProductId()) {
+			types = append(types, e.EventType)
+		}
+		assert.Equal(t, []string{"product.created", "product.base_price_changed"}, types)
+	})
+
+	t.Run("ndjson with a repeated key in one batch", func(t *testing.T) {
+		source := `{"external_key":"NJ-1","name":"Mug","category":"Kitchen","price":"8.00"}` + "\n" +
+			"\n" +
+			`{"external_key":"NJ-1","name":"Mug","category":"Kitchen","price":9}` + "\n" +
+			`{"external_key":"NJ-2",` + "\n"
+
+		reply, err := importChunks(ctx, pb.ImportFormat_IMPORT_FORMAT_NDJSON, false, source, 16)
+		require.NoError(t, err)
+		require.Len(t, reply.GetRows(), 3)
+		assert.Equal(t, "created", reply.GetRows()[0].GetAction())
+		assert.Equal(t, "updated", reply.GetRows()[1].GetAction())
+		assert.Equal(t, int32(3), reply.GetRows()[1].GetLine())
+		assert.Equal(t, reply.GetRows()[0].GetProductId(), reply.GetRows()[1].GetProductId())
+		assert.Equal(t, "MALFORMED_ROW", reply.GetRows()[2].GetErrorReason())
+
+		product, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: reply.GetRows()[0].GetProductId()})
+		require.NoError(t, err)
+		assert.Equal(t, int64(9), product.BasePriceNumerator)
+		assert.Equal(t, int64(1), product.Version)
+	})
+
+	t.Run("validate only writes nothing", func(t *testing.T) {
+		source := `{"external_key":"VO-1","name":"Preview","category":"Previews","price":1}` + "\n"
+
+		reply, err := importChunks(ctx, pb.ImportFormat_IMPORT_FORMAT_NDJSON, true, source, 1024)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), reply.GetCreated())
+
+		_, err = testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: reply.GetRows()[0].GetProductId()})
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	})
+
+	t.Run("category_id assigns a category of the tree", func(t *testing.T) {
+		category, err := testContainer.ProductHandler.CreateCategory(ctx, &pb.CreateCategoryRequest{Slug: "imports", DisplayName: "Imported Goods"})
+		require.NoError(t, err)
+		categoryID := category.GetCategory().GetCategoryId()
+
+		source := "external_key,name,category_id,price\n" +
+			"CT-1,Vase," + categoryID + ",12\n" +
+			"CT-2,Bowl,missing,7\n"
+
+		reply, err := importChunks(ctx, pb.ImportFormat_IMPORT_FORMAT_CSV, false, source, 1024)
+		require.NoError(t, err)
+		require.Len(t, reply.GetRows(), 2)
+		assert.Equal(t, "created", reply.GetRows()[0].GetAction())
+		assert.Equal(t, "CATEGORY_NOT_FOUND", reply.GetRows()[1].GetErrorReason())
+		assert.Equal(t, "category_id", reply.GetRows()[1].GetErrorField())
+
+		product, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: reply.GetRows()[0].GetProductId()})
+		require.NoError(t, err)
+		assert.Equal(t, categoryID, product.CategoryID)
+		assert.Equal(t, "Imported Goods", product.Category)
+	})
+
+	t.Run("large imports list only the failures past the cap", func(t *testing.T) {
+		var source strings.Builder
+		source.WriteString("name,category,price\n")
+		for i := 0; i < import_products.MaxReportedRows+2; i++ {
+			fmt.Fprintf(&source, "Item %d,Bulk,1\n", i)
+		}
+		source.WriteString(",Bulk,1\n")
+
+		reply, err := importChunks(ctx, pb.ImportFormat_IMPORT_FORMAT_CSV, true, source.String(), 64*1024)
+		require.NoError(t, err)
+		assert.Equal(t, int32(import_products.MaxReportedRows+2), reply.GetCreated())
+		assert.Equal(t, int32(1), reply.GetFailed())
+		assert.True(t, reply.GetTruncated())
+		require.Len(t, reply.GetRows(), import_products.MaxReportedRows+1)
+		assert.Equal(t, "EMPTY_PRODUCT_NAME", reply.GetRows()[import_products.MaxReportedRows].GetErrorReason())
+	})
+
+	t.Run("bad source is rejected", func(t *testing.T) {
+		_, err := importChunks(ctx, pb.ImportFormat_IMPORT_FORMAT_UNSPECIFIED, false, "name\n", 1024)
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "format", details.info.GetMetadata()["field"])
+
+		_, err = importChunks(ctx, pb.ImportFormat_IMPORT_FORMAT_CSV, false, "name,price\nLamp,1\n", 1024)
+		code, details = detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "MISSING_CSV_COLUMN", details.info.GetReason())
+	})
+}
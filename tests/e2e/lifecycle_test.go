@@ -0,0 +1,103 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/activate_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/transition_product"
+	"github.com/product-catalog-service/internal/services"
+)
+
+// TestProductLifecycle tests transitions through a configured lifecycle
+func TestProductLifecycle(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	lifecycle, err := domain.NewLifecycle([]domain.Transition{
+		{From: domain.ProductStatusDraft, To: domain.ProductStatusPendingReview},
+		{From: domain.ProductStatusPendingReview, To: domain.ProductStatusActive},
+		{From: domain.ProductStatusActive, To: domain.ProductStatusInactive},
+		{From: domain.ProductStatusActive, To: domain.ProductStatusDiscontinued},
+		{From: domain.ProductStatusDiscontinued, To: domain.ProductStatusArchived},
+	})
+	require.NoError(t, err)
+
+	container := services.NewContainerWithOptions(testClient, services.Options{
+		Clock:     testClock,
+		Lifecycle: lifecycle,
+	})
+
+	getStatus := func(t *testing.T, productID string) string {
+		t.Helper()
+		product, err := container.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		return product.Status
+	}
+
+	t.Run("default lifecycle rejects configured statuses", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+
+		_, err := testContainer.TransitionProductUsecase.Execute(ctx, transition_product.Request{
+			ProductID:    productID,
+			TargetStatus: "pending_review",
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidStatusTransition)
+		assert.Equal(t, "draft", getStatus(t, productID))
+	})
+
+	t.Run("review before activation", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+
+		// The shortcut follows the configured lifecycle too
+		_, err := container.ActivateProductUsecase.Execute(ctx, activate_product.Request{ProductID: productID})
+		assert.ErrorIs(t, err, domain.ErrInvalidStatusTransition)
+
+		_, err = container.TransitionProductUsecase.Execute(ctx, transition_product.Request{
+			ProductID:    productID,
+			TargetStatus: "pending_review",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "pending_review", getStatus(t, productID))
+
+		_, err = container.ActivateProductUsecase.Execute(ctx, activate_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, "active", getStatus(t, productID))
+
+		for _, target := range []string{"discontinued", "archived"} {
+			_, err = container.TransitionProductUsecase.Execute(ctx, transition_product.Request{
+				ProductID:    productID,
+				TargetStatus: target,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, target, getStatus(t, productID))
+		}
+
+		var eventTypes []string
+		for _, e := range getOutboxEvents(t, ctx, productID) {
+			eventTypes = append(eventTypes, e.EventType)
+		}
+		assert.ElementsMatch(t, []string{
+			"product.created",
+			"product.status_changed",
+			"product.activated",
+			"product.status_changed",
+			"product.archived",
+		}, eventTypes)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+
+		_, err := container.TransitionProductUsecase.Execute(ctx, transition_product.Request{
+			ProductID:    productID,
+			TargetStatus: "retired",
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidProductStatus)
+	})
+}
@@ -3,6 +3,7 @@ package e2e
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"os"
 	"testing"
 	"time"
@@ -12,7 +13,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/api/iterator"
 
+	"github.com/product-catalog-service/internal/app/product/contracts"
 	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/queries/batch_get_products"
 	"github.com/product-catalog-service/internal/app/product/queries/get_product"
 	"github.com/product-catalog-service/internal/app/product/queries/list_products"
 	"github.com/product-catalog-service/internal/app/product/usecases/activate_product"
@@ -21,6 +24,7 @@ import (
 	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
 	"github.com/product-catalog-service/internal/app/product/usecases/deactivate_product"
 	"github.com/product-catalog-service/internal/app/product/usecases/remove_discount"
+	"github.com/product-catalog-service/internal/app/product/usecases/restore_product"
 	"github.com/product-catalog-service/internal/app/product/usecases/update_product"
 	"github.com/product-catalog-service/internal/models/m_outbox"
 	"github.com/product-catalog-service/internal/pkg/clock"
@@ -81,6 +85,11 @@ func cleanupDatabase(t *testing.T, ctx context.Context) {
 	_, err := testClient.Apply(ctx, []*spanner.Mutation{
 		spanner.Delete("products", spanner.AllKeys()),
 		spanner.Delete("outbox_events", spanner.AllKeys()),
+		spanner.Delete("product_views", spanner.AllKeys()),
+		spanner.Delete("bulk_operations", spanner.AllKeys()),
+		spanner.Delete("campaigns", spanner.AllKeys()),
+		spanner.Delete("coupons", spanner.AllKeys()),
+		spanner.Delete("categories", spanner.AllKeys()),
 	})
 	require.NoError(t, err)
 }
@@ -145,9 +154,12 @@ func TestProductCreationFlow(t *testing.T) {
 		BasePriceDenominator: 100,
 	}
 
-	productID, err := testContainer.CreateProductUsecase.Execute(ctx, req)
+	created, err := testContainer.CreateProductUsecase.Execute(ctx, req)
 	require.NoError(t, err)
-	require.NotEmpty(t, productID)
+	require.NotEmpty(t, created.Product.ID)
+	assert.Equal(t, int64(1), created.Product.Version)
+	assert.Equal(t, string(domain.ProductStatusDraft), created.Product.Status)
+	productID := created.Product.ID
 
 	// Verify: Query returns correct data
 	product, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
@@ -177,7 +189,7 @@ func TestProductUpdateFlow(t *testing.T) {
 	productID := createTestProduct(t, ctx)
 
 	// Update product
-	err := testContainer.UpdateProductUsecase.Execute(ctx, update_product.Request{
+	_, err := testContainer.UpdateProductUsecase.Execute(ctx, update_product.Request{
 		ProductID:   productID,
 		Name:        "Updated Product Name",
 		Description: "Updated description",
@@ -216,7 +228,7 @@ func TestProductActivationDeactivationFlow(t *testing.T) {
 	productID := createTestProduct(t, ctx)
 
 	// Activate
-	err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
+	_, err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
 		ProductID: productID,
 	})
 	require.NoError(t, err)
@@ -225,7 +237,7 @@ func TestProductActivationDeactivationFlow(t *testing.T) {
 	assert.Equal(t, "active", product.Status)
 
 	// Deactivate
-	err = testContainer.DeactivateProductUsecase.Execute(ctx, deactivate_product.Request{
+	_, err = testContainer.DeactivateProductUsecase.Execute(ctx, deactivate_product.Request{
 		ProductID: productID,
 	})
 	require.NoError(t, err)
@@ -263,7 +275,7 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	endDate := now.Add(7 * 24 * time.Hour)
 
 	// Apply 20% discount
-	err := testContainer.ApplyDiscountUsecase.Execute(ctx, apply_discount.Request{
+	_, err := testContainer.ApplyDiscountUsecase.Execute(ctx, apply_discount.Request{
 		ProductID:  productID,
 		Percentage: 20,
 		StartDate:  startDate,
@@ -306,7 +318,7 @@ func TestDiscountRemoval(t *testing.T) {
 	productID := createProductWithDiscount(t, ctx)
 
 	// Remove discount
-	err := testContainer.RemoveDiscountUsecase.Execute(ctx, remove_discount.Request{
+	_, err := testContainer.RemoveDiscountUsecase.Execute(ctx, remove_discount.Request{
 		ProductID: productID,
 	})
 	require.NoError(t, err)
@@ -329,7 +341,7 @@ func TestProductArchiving(t *testing.T) {
 	productID := createTestProduct(t, ctx)
 
 	// Archive
-	err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{
+	_, err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{
 		ProductID: productID,
 	})
 	require.NoError(t, err)
@@ -338,6 +350,8 @@ func TestProductArchiving(t *testing.T) {
 	product, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
 	require.NoError(t, err)
 	assert.Equal(t, "archived", product.Status)
+	require.NotNil(t, product.ArchivedAt)
+	assert.True(t, product.ArchivedAt.Equal(testClock.Now()))
 
 	// Verify doesn't appear in active listings
 	result, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
@@ -351,6 +365,134 @@ func TestProductArchiving(t *testing.T) {
 	}
 }
 
+// TestProductRestore tests restoring archived products within the retention window
+func TestProductRestore(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	archive := func(t *testing.T) string {
+		t.Helper()
+		productID := createTestProduct(t, ctx)
+		_, err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{
+			ProductID: productID,
+		})
+		require.NoError(t, err)
+		return productID
+	}
+
+	t.Run("restores to inactive", func(t *testing.T) {
+		productID := archive(t)
+
+		_, err := testContainer.RestoreProductUsecase.Execute(ctx, restore_product.Request{
+			ProductID: productID,
+		})
+		require.NoError(t, err)
+
+		product, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, "inactive", product.Status)
+		assert.Nil(t, product.ArchivedAt)
+
+		var hasRestoredEvent bool
+		for _, e := range getOutboxEvents(t, ctx, productID) {
+			if e.EventType == "product.restored" {
+				hasRestoredEvent = true
+			}
+		}
+		assert.True(t, hasRestoredEvent, "should have product.restored event")
+	})
+
+	t.Run("not archived", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+
+		_, err := testContainer.RestoreProductUsecase.Execute(ctx, restore_product.Request{
+			ProductID: productID,
+		})
+		assert.ErrorIs(t, err, domain.ErrProductNotArchived)
+	})
+
+	t.Run("retention elapsed", func(t *testing.T) {
+		productID := archive(t)
+
+		start := testClock.Now()
+		testClock.Advance(restore_product.DefaultRetention + time.Hour)
+		defer testClock.SetTime(start)
+
+		_, err := testContainer.RestoreProductUsecase.Execute(ctx, restore_product.Request{
+			ProductID: productID,
+		})
+		assert.ErrorIs(t, err, domain.ErrRestoreWindowExpired)
+	})
+}
+
+// TestProductListingArchivedModes tests listing with each archived mode
+func TestProductListingArchivedModes(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	liveID := createTestProduct(t, ctx)
+	archivedID := createTestProduct(t, ctx)
+	_, err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{
+		ProductID: archivedID,
+	})
+	require.NoError(t, err)
+
+	listIDs := func(t *testing.T, req list_products.Request) []string {
+		t.Helper()
+		req.Limit = 100
+		result, err := testContainer.ListProductsQuery.Execute(ctx, req)
+		require.NoError(t, err)
+
+		ids := make([]string, len(result.Products))
+		for i, p := range result.Products {
+			ids[i] = p.ID
+		}
+		return ids
+	}
+
+	t.Run("excluded by default", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{liveID}, listIDs(t, list_products.Request{}))
+	})
+
+	t.Run("include lists all products", func(t *testing.T) {
+		ids := listIDs(t, list_products.Request{ArchivedMode: contracts.ArchivedModeInclude})
+		assert.ElementsMatch(t, []string{liveID, archivedID}, ids)
+	})
+
+	t.Run("only lists archived products", func(t *testing.T) {
+		result, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+			ArchivedMode: contracts.ArchivedModeOnly,
+			Limit:        100,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Products, 1)
+		assert.Equal(t, archivedID, result.Products[0].ID)
+		require.NotNil(t, result.Products[0].ArchivedAt)
+	})
+
+	t.Run("archived status implies only", func(t *testing.T) {
+		status := "archived"
+		ids := listIDs(t, list_products.Request{Status: &status})
+		assert.ElementsMatch(t, []string{archivedID}, ids)
+	})
+
+	t.Run("explicit exclude with archived status is empty", func(t *testing.T) {
+		status := "archived"
+		ids := listIDs(t, list_products.Request{
+			Status:       &status,
+			ArchivedMode: contracts.ArchivedModeExclude,
+		})
+		assert.Empty(t, ids)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		_, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+			ArchivedMode: contracts.ArchivedMode("sometimes"),
+		})
+		assert.ErrorIs(t, err, contracts.ErrInvalidArchivedMode)
+	})
+}
+
 // TestBusinessRuleValidation tests domain error handling
 func TestBusinessRuleValidation(t *testing.T) {
 	ctx := context.Background()
@@ -360,7 +502,7 @@ func TestBusinessRuleValidation(t *testing.T) {
 		productID := createTestProduct(t, ctx) // draft status
 
 		now := testClock.Now()
-		err := testContainer.ApplyDiscountUsecase.Execute(ctx, apply_discount.Request{
+		_, err := testContainer.ApplyDiscountUsecase.Execute(ctx, apply_discount.Request{
 			ProductID:  productID,
 			Percentage: 20,
 			StartDate:  now,
@@ -374,7 +516,7 @@ func TestBusinessRuleValidation(t *testing.T) {
 		cleanupDatabase(t, ctx)
 		productID := createAndActivateProduct(t, ctx)
 
-		err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
+		_, err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
 			ProductID: productID,
 		})
 
@@ -385,7 +527,7 @@ func TestBusinessRuleValidation(t *testing.T) {
 		cleanupDatabase(t, ctx)
 		productID := createAndActivateProduct(t, ctx)
 
-		err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{
+		_, err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{
 			ProductID: productID,
 		})
 
@@ -396,7 +538,7 @@ func TestBusinessRuleValidation(t *testing.T) {
 		cleanupDatabase(t, ctx)
 		productID := createAndActivateProduct(t, ctx)
 
-		err := testContainer.RemoveDiscountUsecase.Execute(ctx, remove_discount.Request{
+		_, err := testContainer.RemoveDiscountUsecase.Execute(ctx, remove_discount.Request{
 			ProductID: productID,
 		})
 
@@ -464,6 +606,289 @@ func TestProductListing(t *testing.T) {
 		assert.Len(t, result.Products, 1)
 		assert.False(t, result.HasMore)
 	})
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		// All products share the mock clock's created_at, so the
+		// product_id tiebreaker alone keeps pages disjoint.
+		seen := make(map[string]bool)
+		pageToken := ""
+
+		for page := 0; page < 3; page++ {
+			result, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+				ActiveOnly: true,
+				Limit:      1,
+				PageToken:  pageToken,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Products, 1)
+
+			id := result.Products[0].ID
+			assert.False(t, seen[id], "product %s returned twice", id)
+			seen[id] = true
+
+			pageToken = result.NextPageToken
+		}
+
+		assert.Empty(t, pageToken)
+		assert.Len(t, seen, 3)
+	})
+
+	t.Run("skip total count", func(t *testing.T) {
+		result, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+			ActiveOnly:     true,
+			Limit:          2,
+			SkipTotalCount: true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(0), result.TotalCount)
+		assert.Len(t, result.Products, 2)
+		assert.True(t, result.HasMore)
+		assert.NotEmpty(t, result.NextPageToken)
+	})
+
+	t.Run("invalid page token", func(t *testing.T) {
+		_, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+			PageToken: "not-a-token",
+		})
+
+		assert.ErrorIs(t, err, list_products.ErrInvalidPageToken)
+	})
+}
+
+// TestProductListingSort tests order_by and its interaction with page tokens
+func TestProductListingSort(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	charlie := createNamedProduct(t, ctx, "Charlie", 3000)
+	alpha := createNamedProduct(t, ctx, "Alpha", 1000)
+	bravo := createNamedProduct(t, ctx, "Bravo", 2000)
+
+	// 90% off makes Charlie the cheapest by effective price
+	_, err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
+		ProductID: charlie,
+	})
+	require.NoError(t, err)
+
+	now := testClock.Now()
+	_, err = testContainer.ApplyDiscountUsecase.Execute(ctx, apply_discount.Request{
+		ProductID:  charlie,
+		Percentage: 90,
+		StartDate:  now,
+		EndDate:    now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	listIDs := func(t *testing.T, orderBy string) []string {
+		result, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+			OrderBy: orderBy,
+			Limit:   100,
+		})
+		require.NoError(t, err)
+
+		ids := make([]string, len(result.Products))
+		for i, p := range result.Products {
+			ids[i] = p.ID
+		}
+		return ids
+	}
+
+	t.Run("name ascending", func(t *testing.T) {
+		assert.Equal(t, []string{alpha, bravo, charlie}, listIDs(t, "name"))
+	})
+
+	t.Run("name descending", func(t *testing.T) {
+		assert.Equal(t, []string{charlie, bravo, alpha}, listIDs(t, "name desc"))
+	})
+
+	t.Run("base price descending", func(t *testing.T) {
+		assert.Equal(t, []string{charlie, bravo, alpha}, listIDs(t, "base_price desc"))
+	})
+
+	t.Run("effective price ascending", func(t *testing.T) {
+		assert.Equal(t, []string{charlie, alpha, bravo}, listIDs(t, "effective_price asc"))
+	})
+
+	t.Run("cursor pagination follows order", func(t *testing.T) {
+		var ids []string
+		pageToken := ""
+
+		for {
+			result, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+				OrderBy:   "effective_price asc",
+				Limit:     2,
+				PageToken: pageToken,
+			})
+			require.NoError(t, err)
+
+			for _, p := range result.Products {
+				ids = append(ids, p.ID)
+			}
+			pageToken = result.NextPageToken
+			if pageToken == "" {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{charlie, alpha, bravo}, ids)
+	})
+
+	t.Run("page token bound to order", func(t *testing.T) {
+		result, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+			OrderBy: "name asc",
+			Limit:   1,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, result.NextPageToken)
+
+		_, err = testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+			OrderBy:   "name desc",
+			Limit:     1,
+			PageToken: result.NextPageToken,
+		})
+		assert.ErrorIs(t, err, list_products.ErrInvalidPageToken)
+	})
+
+	t.Run("invalid order_by", func(t *testing.T) {
+		for _, orderBy := range []string{"price", "name sideways", "name asc extra"} {
+			_, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+				OrderBy: orderBy,
+			})
+			assert.ErrorIs(t, err, list_products.ErrInvalidOrderBy, orderBy)
+		}
+	})
+}
+
+// TestProductListingFilters tests price and discount filters evaluated by Spanner
+func TestProductListingFilters(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	now := testClock.Now()
+
+	alpha := createNamedProduct(t, ctx, "Alpha", 1000)
+	bravo := createNamedProduct(t, ctx, "Bravo", 2000)
+	charlie := createNamedProduct(t, ctx, "Charlie", 3000)
+	delta := createNamedProduct(t, ctx, "Delta", 4000)
+
+	// Charlie: 50% off now (effective 15.00); Delta: 10% off from tomorrow
+	applyTestDiscount(t, ctx, charlie, 50, now.Add(-time.Hour), now.Add(12*time.Hour))
+	applyTestDiscount(t, ctx, delta, 10, now.Add(24*time.Hour), now.Add(48*time.Hour))
+
+	list := func(t *testing.T, req list_products.Request) *list_products.ListResultDTO {
+		req.OrderBy = "name asc"
+		if req.Limit == 0 {
+			req.Limit = 100
+		}
+		result, err := testContainer.ListProductsQuery.Execute(ctx, req)
+		require.NoError(t, err)
+		return result
+	}
+
+	ids := func(result *list_products.ListResultDTO) []string {
+		out := make([]string, len(result.Products))
+		for i, p := range result.Products {
+			out[i] = p.ID
+		}
+		return out
+	}
+
+	t.Run("base price range", func(t *testing.T) {
+		result := list(t, list_products.Request{
+			MinBasePrice: big.NewRat(15, 1),
+			MaxBasePrice: big.NewRat(30, 1),
+		})
+
+		assert.Equal(t, []string{bravo, charlie}, ids(result))
+		assert.Equal(t, int64(2), result.TotalCount)
+	})
+
+	t.Run("effective price range", func(t *testing.T) {
+		result := list(t, list_products.Request{
+			MaxEffectivePrice: big.NewRat(20, 1),
+		})
+
+		assert.Equal(t, []string{alpha, bravo, charlie}, ids(result))
+	})
+
+	t.Run("count and has_more follow filters", func(t *testing.T) {
+		result := list(t, list_products.Request{
+			MinEffectivePrice: big.NewRat(15, 1),
+			MaxEffectivePrice: big.NewRat(25, 1),
+			Limit:             1,
+		})
+
+		assert.Equal(t, []string{bravo}, ids(result))
+		assert.Equal(t, int64(2), result.TotalCount)
+		assert.True(t, result.HasMore)
+	})
+
+	t.Run("on sale now", func(t *testing.T) {
+		result := list(t, list_products.Request{OnSaleAt: &now})
+
+		assert.Equal(t, []string{charlie}, ids(result))
+	})
+
+	t.Run("on sale later", func(t *testing.T) {
+		later := now.Add(36 * time.Hour)
+		result := list(t, list_products.Request{OnSaleAt: &later})
+
+		assert.Equal(t, []string{delta}, ids(result))
+	})
+
+	t.Run("minimum discount", func(t *testing.T) {
+		minDiscount := int64(30)
+		result := list(t, list_products.Request{MinDiscountPercent: &minDiscount})
+
+		assert.Equal(t, []string{charlie}, ids(result))
+	})
+
+	t.Run("inverted range", func(t *testing.T) {
+		_, err := testContainer.ListProductsQuery.Execute(ctx, list_products.Request{
+			MinBasePrice: big.NewRat(50, 1),
+			MaxBasePrice: big.NewRat(10, 1),
+		})
+
+		assert.ErrorIs(t, err, contracts.ErrInvalidPriceRange)
+	})
+}
+
+// TestBatchGetProducts tests fetching several products in one read
+func TestBatchGetProducts(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	first := createTestProduct(t, ctx)
+	second := createProductWithDiscount(t, ctx)
+	third := createTestProduct(t, ctx)
+
+	t.Run("request order with missing IDs", func(t *testing.T) {
+		result, err := testContainer.BatchGetProductsQuery.Execute(ctx, batch_get_products.Request{
+			ProductIDs: []string{third, "missing-1", first, second, first, "missing-2"},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Products, 3)
+		assert.Equal(t, third, result.Products[0].ID)
+		assert.Equal(t, first, result.Products[1].ID)
+		assert.Equal(t, second, result.Products[2].ID)
+		assert.Equal(t, []string{"missing-1", "missing-2"}, result.MissingIDs)
+
+		// Same pricing as GetProduct
+		require.NotNil(t, result.Products[2].DiscountPercent)
+		assert.Equal(t, int64(20), *result.Products[2].DiscountPercent)
+	})
+
+	t.Run("too many IDs", func(t *testing.T) {
+		ids := make([]string, batch_get_products.MaxBatchSize+1)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("id-%d", i)
+		}
+
+		_, err := testContainer.BatchGetProductsQuery.Execute(ctx, batch_get_products.Request{ProductIDs: ids})
+		assert.ErrorIs(t, err, batch_get_products.ErrTooManyProductIDs)
+	})
 }
 
 // TestOutboxEventPayload verifies event payload structure
@@ -489,7 +914,7 @@ func TestOutboxEventPayload(t *testing.T) {
 // Helper functions
 
 func createTestProduct(t *testing.T, ctx context.Context) string {
-	productID, err := testContainer.CreateProductUsecase.Execute(ctx, create_product.Request{
+	result, err := testContainer.CreateProductUsecase.Execute(ctx, create_product.Request{
 		Name:                 fmt.Sprintf("Test Product %d", time.Now().UnixNano()),
 		Description:          "Test description",
 		Category:             "Test Category",
@@ -497,12 +922,12 @@ func createTestProduct(t *testing.T, ctx context.Context) string {
 		BasePriceDenominator: 100,
 	})
 	require.NoError(t, err)
-	return productID
+	return result.Product.ID
 }
 
 func createAndActivateProduct(t *testing.T, ctx context.Context) string {
 	productID := createTestProduct(t, ctx)
-	err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
+	_, err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
 		ProductID: productID,
 	})
 	require.NoError(t, err)
@@ -512,7 +937,7 @@ func createAndActivateProduct(t *testing.T, ctx context.Context) string {
 func createProductWithDiscount(t *testing.T, ctx context.Context) string {
 	productID := createAndActivateProduct(t, ctx)
 	now := testClock.Now()
-	err := testContainer.ApplyDiscountUsecase.Execute(ctx, apply_discount.Request{
+	_, err := testContainer.ApplyDiscountUsecase.Execute(ctx, apply_discount.Request{
 		ProductID:  productID,
 		Percentage: 20,
 		StartDate:  now,
@@ -523,7 +948,7 @@ func createProductWithDiscount(t *testing.T, ctx context.Context) string {
 }
 
 func createProductInCategory(t *testing.T, ctx context.Context, category string, activate bool) string {
-	productID, err := testContainer.CreateProductUsecase.Execute(ctx, create_product.Request{
+	result, err := testContainer.CreateProductUsecase.Execute(ctx, create_product.Request{
 		Name:                 fmt.Sprintf("Product %d", time.Now().UnixNano()),
 		Description:          "Description",
 		Category:             category,
@@ -533,11 +958,38 @@ func createProductInCategory(t *testing.T, ctx context.Context, category string,
 	require.NoError(t, err)
 
 	if activate {
-		err = testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
-			ProductID: productID,
+		_, err = testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
+			ProductID: result.Product.ID,
 		})
 		require.NoError(t, err)
 	}
 
-	return productID
+	return result.Product.ID
+}
+
+func createNamedProduct(t *testing.T, ctx context.Context, name string, priceCents int64) string {
+	result, err := testContainer.CreateProductUsecase.Execute(ctx, create_product.Request{
+		Name:                 name,
+		Description:          "Description",
+		Category:             "Sorting",
+		BasePriceNumerator:   priceCents,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	return result.Product.ID
+}
+
+func applyTestDiscount(t *testing.T, ctx context.Context, productID string, percentage int64, start, end time.Time) {
+	_, err := testContainer.ActivateProductUsecase.Execute(ctx, activate_product.Request{
+		ProductID: productID,
+	})
+	require.NoError(t, err)
+
+	_, err = testContainer.ApplyDiscountUsecase.Execute(ctx, apply_discount.Request{
+		ProductID:  productID,
+		Percentage: percentage,
+		StartDate:  start,
+		EndDate:    end,
+	})
+	require.NoError(t, err)
 }
@@ -0,0 +1,113 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/contracts"
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/repo"
+	"github.com/product-catalog-service/internal/app/product/usecases/update_product"
+	"github.com/product-catalog-service/internal/models/m_outbox"
+)
+
+func newViewReadModel() *repo.ReadModelRepo {
+	return repo.NewReadModelRepo(testClient, testClock, repo.ReadModelSourceProductViews)
+}
+
+// TestProductViewProjection verifies that the projector mirrors products into product_views
+func TestProductViewProjection(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	productID := createProductWithDiscount(t, ctx)
+	views := newViewReadModel()
+
+	// Not visible until projected
+	_, err := views.GetByID(ctx, productID)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	lag, err := testContainer.ProjectionDispatcher.Lag(ctx)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(lag), int64(0))
+
+	processed, err := testContainer.ProjectionDispatcher.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, processed, "created, activated and discount_applied events")
+
+	view, err := views.GetByID(ctx, productID)
+	require.NoError(t, err)
+	assert.Equal(t, "active", view.Status)
+	require.NotNil(t, view.DiscountPercent)
+	assert.Equal(t, int64(20), *view.DiscountPercent)
+
+	// Same prices as the write-side read model
+	direct, err := testContainer.ReadModelRepo.GetByID(ctx, productID)
+	require.NoError(t, err)
+	assert.Equal(t, direct.EffectivePriceNum, view.EffectivePriceNum)
+	assert.Equal(t, direct.EffectivePriceDenom, view.EffectivePriceDenom)
+
+	// Events stay pending for the publisher and lag drops to zero
+	for _, e := range getOutboxEvents(t, ctx, productID) {
+		assert.Equal(t, m_outbox.StatusPending, e.Status)
+	}
+	lag, err = testContainer.ProjectionDispatcher.Lag(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, lag)
+
+	// Later changes flow through
+	_, err = testContainer.UpdateProductUsecase.Execute(ctx, update_product.Request{
+		ProductID:   productID,
+		Name:        "Projected Name",
+		Description: "Projected description",
+		Category:    "Projected",
+	})
+	require.NoError(t, err)
+
+	_, err = testContainer.ProjectionDispatcher.Drain(ctx)
+	require.NoError(t, err)
+
+	view, err = views.GetByID(ctx, productID)
+	require.NoError(t, err)
+	assert.Equal(t, "Projected Name", view.Name)
+
+	result, err := views.List(ctx, contracts.ProductListFilters{ActiveOnly: true}, contracts.Pagination{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.TotalCount)
+}
+
+// TestProductViewReplay verifies that a replay rebuilds the view from the products table
+func TestProductViewReplay(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	keptID := createTestProduct(t, ctx)
+	goneID := createTestProduct(t, ctx)
+
+	_, err := testContainer.ProjectionDispatcher.Drain(ctx)
+	require.NoError(t, err)
+
+	// Simulate drift: a product disappears behind the projector's back
+	_, err = testClient.Apply(ctx, []*spanner.Mutation{
+		spanner.Delete("products", spanner.Key{goneID}),
+	})
+	require.NoError(t, err)
+
+	testClock.Advance(time.Second)
+	count, err := testContainer.ProductViewProjection.Replay(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	views := newViewReadModel()
+
+	_, err = views.GetByID(ctx, keptID)
+	assert.NoError(t, err)
+
+	_, err = views.GetByID(ctx, goneID)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
@@ -0,0 +1,116 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/usecases/archive_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/purge_product"
+)
+
+// TestProductPurge tests permanent deletion of archived products
+func TestProductPurge(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	archive := func(t *testing.T) string {
+		t.Helper()
+		productID := createTestProduct(t, ctx)
+		_, err := testContainer.ArchiveProductUsecase.Execute(ctx, archive_product.Request{
+			ProductID: productID,
+		})
+		require.NoError(t, err)
+		return productID
+	}
+
+	// Runs fn with the clock moved past the purge retention
+	afterRetention := func(fn func()) {
+		start := testClock.Now()
+		testClock.Advance(purge_product.DefaultRetention + time.Hour)
+		defer testClock.SetTime(start)
+		fn()
+	}
+
+	t.Run("retention not elapsed", func(t *testing.T) {
+		productID := archive(t)
+
+		_, err := testContainer.PurgeProductUsecase.Execute(ctx, purge_product.Request{
+			ProductID: productID,
+		})
+		assert.ErrorIs(t, err, domain.ErrPurgeRetentionNotElapsed)
+	})
+
+	t.Run("not archived", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+
+		afterRetention(func() {
+			_, err := testContainer.PurgeProductUsecase.Execute(ctx, purge_product.Request{
+				ProductID: productID,
+			})
+			assert.ErrorIs(t, err, domain.ErrProductNotArchived)
+		})
+	})
+
+	t.Run("purges and emits event", func(t *testing.T) {
+		productID := archive(t)
+
+		afterRetention(func() {
+			_, err := testContainer.PurgeProductUsecase.Execute(ctx, purge_product.Request{
+				ProductID: productID,
+			})
+			require.NoError(t, err)
+		})
+
+		_, err := testContainer.ProductRepo.GetByID(ctx, productID)
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+		var hasPurgedEvent bool
+		for _, e := range getOutboxEvents(t, ctx, productID) {
+			if e.EventType == "product.purged" {
+				hasPurgedEvent = true
+			}
+		}
+		assert.True(t, hasPurgedEvent, "should have product.purged event")
+
+		// The projector drops the view row
+		_, err = testContainer.ProjectionDispatcher.Drain(ctx)
+		require.NoError(t, err)
+		_, err = newViewReadModel().GetByID(ctx, productID)
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	})
+
+	t.Run("purger dry run reports without deleting", func(t *testing.T) {
+		cleanupDatabase(t, ctx)
+		dueID := archive(t)
+		liveID := createTestProduct(t, ctx)
+
+		afterRetention(func() {
+			report, err := testContainer.Purger.PurgeDue(ctx, true)
+			require.NoError(t, err)
+			assert.True(t, report.DryRun)
+			require.Len(t, report.Candidates, 1)
+			assert.Equal(t, dueID, report.Candidates[0].ProductID)
+			assert.Zero(t, report.Purged)
+
+			_, err = testContainer.ProductRepo.GetByID(ctx, dueID)
+			require.NoError(t, err)
+
+			report, err = testContainer.Purger.PurgeDue(ctx, false)
+			require.NoError(t, err)
+			assert.Equal(t, 1, report.Purged)
+			assert.Zero(t, report.Skipped)
+			require.Len(t, report.Candidates, 1)
+			assert.False(t, report.Candidates[0].Skipped)
+		})
+
+		_, err := testContainer.ProductRepo.GetByID(ctx, dueID)
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+		_, err = testContainer.ProductRepo.GetByID(ctx, liveID)
+		assert.NoError(t, err)
+	})
+}
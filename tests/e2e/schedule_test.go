@@ -0,0 +1,106 @@
+package e2e
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	"github.com/product-catalog-service/internal/app/product/usecases/schedule_product"
+)
+
+// TestProductSchedule tests scheduled activation and deactivation
+func TestProductSchedule(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	start := testClock.Now()
+	defer testClock.SetTime(start)
+
+	countEvents := func(t *testing.T, productID, eventType string) int {
+		t.Helper()
+		n := 0
+		for _, e := range getOutboxEvents(t, ctx, productID) {
+			if e.EventType == eventType {
+				n++
+			}
+		}
+		return n
+	}
+
+	t.Run("rejects unpublish before publish", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+		publishAt := start.Add(2 * time.Hour)
+		unpublishAt := start.Add(time.Hour)
+
+		_, err := testContainer.ScheduleProductUsecase.Execute(ctx, schedule_product.Request{
+			ProductID:   productID,
+			PublishAt:   &publishAt,
+			UnpublishAt: &unpublishAt,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidSchedule)
+	})
+
+	t.Run("publishes and unpublishes once", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+		publishAt := start.Add(time.Hour)
+		unpublishAt := start.Add(2 * time.Hour)
+
+		_, err := testContainer.ScheduleProductUsecase.Execute(ctx, schedule_product.Request{
+			ProductID:   productID,
+			PublishAt:   &publishAt,
+			UnpublishAt: &unpublishAt,
+		})
+		require.NoError(t, err)
+
+		product, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		require.NotNil(t, product.PublishAt)
+		assert.True(t, product.PublishAt.Equal(publishAt))
+
+		// Nothing due yet
+		fired, err := testContainer.Scheduler.FireDue(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, fired)
+
+		// Two replicas race on the due publish time
+		testClock.SetTime(publishAt)
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := testContainer.Scheduler.FireDue(ctx)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		product, err = testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, "active", product.Status)
+		assert.Nil(t, product.PublishAt)
+		assert.Equal(t, 1, countEvents(t, productID, "product.activated"))
+
+		// A restart re-runs the scan without firing again
+		fired, err = testContainer.Scheduler.FireDue(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, fired)
+
+		testClock.SetTime(unpublishAt.Add(time.Minute))
+		fired, err = testContainer.Scheduler.FireDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, fired)
+
+		product, err = testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, "inactive", product.Status)
+		assert.Nil(t, product.UnpublishAt)
+		assert.Equal(t, 1, countEvents(t, productID, "product.deactivated"))
+	})
+}
@@ -0,0 +1,93 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/product-catalog-service/internal/app/product/queries/search_products"
+	"github.com/product-catalog-service/internal/app/product/usecases/create_product"
+)
+
+// TestProductSearch verifies keyword search over the projected read model
+func TestProductSearch(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	create := func(name, description, category string) string {
+		result, err := testContainer.CreateProductUsecase.Execute(ctx, create_product.Request{
+			Name:                 name,
+			Description:          description,
+			Category:             category,
+			BasePriceNumerator:   1000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		return result.Product.ID
+	}
+
+	laptop := create("Gaming Laptop", "Fast machine with a great keyboard", "Electronics")
+	sleeve := create("Sleeve", "Protective sleeve for any laptop", "Accessories")
+	novel := create("Mystery Novel", "A page-turner", "Books")
+
+	// Search reads product_views, which the projector maintains
+	_, err := testContainer.ProjectionDispatcher.Drain(ctx)
+	require.NoError(t, err)
+
+	search := func(t *testing.T, req search_products.Request) []string {
+		result, err := testContainer.SearchProductsQuery.Execute(ctx, req)
+		require.NoError(t, err)
+
+		ids := make([]string, len(result.Results))
+		for i, hit := range result.Results {
+			ids[i] = hit.ID
+		}
+		return ids
+	}
+
+	t.Run("name match ranks above description match", func(t *testing.T) {
+		ids := search(t, search_products.Request{Query: "laptop"})
+
+		assert.Equal(t, []string{laptop, sleeve}, ids)
+	})
+
+	t.Run("prefix match", func(t *testing.T) {
+		ids := search(t, search_products.Request{Query: "myst"})
+
+		assert.Equal(t, []string{novel}, ids)
+	})
+
+	t.Run("short words match whole words only", func(t *testing.T) {
+		assert.Equal(t, []string{novel}, search(t, search_products.Request{Query: "a page"}))
+		assert.Empty(t, search(t, search_products.Request{Query: "my"}))
+	})
+
+	t.Run("typo tolerance", func(t *testing.T) {
+		ids := search(t, search_products.Request{Query: "laptpo"})
+
+		assert.Contains(t, ids, laptop)
+	})
+
+	t.Run("matches category", func(t *testing.T) {
+		ids := search(t, search_products.Request{Query: "books"})
+
+		assert.Equal(t, []string{novel}, ids)
+	})
+
+	t.Run("combined with category filter", func(t *testing.T) {
+		category := "Accessories"
+		ids := search(t, search_products.Request{Query: "laptop", Category: &category})
+
+		assert.Equal(t, []string{sleeve}, ids)
+	})
+
+	t.Run("operators are stripped", func(t *testing.T) {
+		_, err := testContainer.SearchProductsQuery.Execute(ctx, search_products.Request{Query: `"-" OR`})
+		require.NoError(t, err)
+
+		_, err = testContainer.SearchProductsQuery.Execute(ctx, search_products.Request{Query: `"-"`})
+		assert.ErrorIs(t, err, search_products.ErrEmptySearchQuery)
+	})
+}
@@ -0,0 +1,74 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// TestPartialUpdateWithFieldMask verifies that UpdateProduct only changes masked fields
+func TestPartialUpdateWithFieldMask(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+
+	t.Run("only masked fields change", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+		before, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+
+		_, err = handler.UpdateProduct(ctx, &pb.UpdateProductRequest{
+			ProductId:   productID,
+			Description: "Only the description changes",
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		})
+		require.NoError(t, err)
+
+		product, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, "Only the description changes", product.Description)
+		assert.Equal(t, before.Name, product.Name)
+		assert.Equal(t, "Test Category", product.Category)
+
+		var updated int
+		for _, e := range getOutboxEvents(t, ctx, productID) {
+			if e.EventType == "product.updated" {
+				updated++
+			}
+		}
+		assert.Equal(t, 1, updated)
+	})
+
+	t.Run("masked field is still validated", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+
+		_, err := handler.UpdateProduct(ctx, &pb.UpdateProductRequest{
+			ProductId:  productID,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "EMPTY_PRODUCT_NAME", details.info.GetReason())
+	})
+
+	t.Run("unknown path is rejected", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+
+		_, err := handler.UpdateProduct(ctx, &pb.UpdateProductRequest{
+			ProductId:  productID,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"base_price"}},
+		})
+		code, details := detailsOf(t, err)
+		assert.Equal(t, codes.InvalidArgument, code)
+		assert.Equal(t, "INVALID_UPDATE_MASK", details.info.GetReason())
+		assert.Equal(t, "update_mask", details.info.GetMetadata()["field"])
+	})
+}
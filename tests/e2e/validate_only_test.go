@@ -0,0 +1,82 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/product-catalog-service/internal/app/product/domain"
+	"github.com/product-catalog-service/internal/app/product/queries/get_product"
+	pb "github.com/product-catalog-service/proto/product/v1"
+)
+
+// TestValidateOnlyCommands verifies that validate_only previews a command without committing it
+func TestValidateOnlyCommands(t *testing.T) {
+	ctx := context.Background()
+	cleanupDatabase(t, ctx)
+
+	handler := testContainer.ProductHandler
+
+	t.Run("activation preview leaves the product untouched", func(t *testing.T) {
+		productID := createTestProduct(t, ctx)
+
+		reply, err := handler.ActivateProduct(ctx, &pb.ActivateProductRequest{
+			ProductId:    productID,
+			ValidateOnly: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "active", reply.GetProduct().GetStatus())
+		assert.Equal(t, int64(2), reply.GetProduct().GetVersion())
+		require.Len(t, reply.GetEvents(), 1)
+		event := reply.GetEvents()[0]
+		assert.Equal(t, "product.activated", event.GetEventType())
+		assert.Equal(t, productID, event.GetAggregateId())
+		assert.Equal(t, "product.activated", event.GetPayload().GetFields()["event_type"].GetStringValue())
+
+		stored, err := testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: productID})
+		require.NoError(t, err)
+		assert.Equal(t, "draft", stored.Status)
+		assert.Equal(t, int64(1), stored.Version)
+
+		events := getOutboxEvents(t, ctx, productID)
+		require.Len(t, events, 1)
+		assert.Equal(t, "product.created", events[0].EventType)
+	})
+
+	t.Run("created product is not persisted", func(t *testing.T) {
+		reply, err := handler.CreateProduct(ctx, &pb.CreateProductRequest{
+			Name:         "Preview",
+			Category:     "Previews",
+			BasePrice:    &pb.Money{Numerator: 500, Denominator: 100},
+			ValidateOnly: true,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, reply.GetProductId())
+		assert.Equal(t, int64(1), reply.GetProduct().GetVersion())
+		require.Len(t, reply.GetEvents(), 1)
+		assert.Equal(t, "product.created", reply.GetEvents()[0].GetEventType())
+
+		_, err = testContainer.GetProductQuery.Execute(ctx, get_product.Request{ProductID: reply.GetProductId()})
+		assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	})
+
+	t.Run("business rules are still enforced", func(t *testing.T) {
+		productID := createAndActivateProduct(t, ctx)
+
+		_, err := handler.ArchiveProduct(ctx, &pb.ArchiveProductRequest{
+			ProductId:    productID,
+			ValidateOnly: true,
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = handler.PurgeProduct(ctx, &pb.PurgeProductRequest{
+			ProductId:    productID,
+			ValidateOnly: true,
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}